@@ -55,6 +55,8 @@ type Client struct {
 	metadataHeaders map[string]string
 
 	collCache *CollectionCache
+	// sessionTs tracks the write timestamps for Session consistency.
+	sessionTs sessionTimestamps
 }
 
 func New(ctx context.Context, config *ClientConfig) (*Client, error) {
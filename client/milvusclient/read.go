@@ -40,6 +40,7 @@ func (c *Client) Search(ctx context.Context, option SearchOption, callOptions ..
 	if err != nil {
 		return nil, err
 	}
+	req.GuaranteeTimestamp = c.sessionGuaranteeTs(req.GetCollectionName(), req.GetGuaranteeTimestamp())
 
 	var resultSets []ResultSet
 
@@ -180,6 +181,7 @@ func (c *Client) Query(ctx context.Context, option QueryOption, callOptions ...g
 	if err != nil {
 		return resultSet, err
 	}
+	req.GuaranteeTimestamp = c.sessionGuaranteeTs(req.GetCollectionName(), req.GetGuaranteeTimestamp())
 
 	err = c.callService(func(milvusService milvuspb.MilvusServiceClient) error {
 		resp, err := milvusService.Query(ctx, req, callOptions...)
@@ -219,6 +221,7 @@ func (c *Client) HybridSearch(ctx context.Context, option HybridSearchOption, ca
 	if err != nil {
 		return nil, err
 	}
+	req.GuaranteeTimestamp = c.sessionGuaranteeTs(req.GetCollectionName(), req.GetGuaranteeTimestamp())
 
 	var resultSets []ResultSet
 
@@ -62,6 +62,7 @@ type searchOption struct {
 	outputFields               []string
 	consistencyLevel           entity.ConsistencyLevel
 	useDefaultConsistencyLevel bool
+	consistencyToken           string
 }
 
 type AnnRequest struct {
@@ -288,6 +289,10 @@ func (opt *searchOption) Request() (*milvuspb.SearchRequest, error) {
 	request.ConsistencyLevel = commonpb.ConsistencyLevel(opt.consistencyLevel)
 	request.UseDefaultConsistency = opt.useDefaultConsistencyLevel
 	request.OutputFields = opt.outputFields
+	request.GuaranteeTimestamp, err = parseConsistencyToken(opt.consistencyToken, opt.collectionName)
+	if err != nil {
+		return nil, err
+	}
 
 	return request, nil
 }
@@ -323,6 +328,13 @@ func (opt *searchOption) WithConsistencyLevel(consistencyLevel entity.Consistenc
 	return opt
 }
 
+// WithConsistencyToken makes the search see the writes of the client which generated the token,
+// works with Session consistency level.
+func (opt *searchOption) WithConsistencyToken(token string) *searchOption {
+	opt.consistencyToken = token
+	return opt
+}
+
 func (opt *searchOption) WithANNSField(annsField string) *searchOption {
 	opt.annRequest.WithANNSField(annsField)
 	return opt
@@ -429,6 +441,7 @@ type hybridSearchOption struct {
 	outputFields          []string
 	useDefaultConsistency bool
 	consistencyLevel      entity.ConsistencyLevel
+	consistencyToken      string
 
 	limit    int
 	offset   int
@@ -441,6 +454,13 @@ func (opt *hybridSearchOption) WithConsistencyLevel(cl entity.ConsistencyLevel)
 	return opt
 }
 
+// WithConsistencyToken makes the search see the writes of the client which generated the token,
+// works with Session consistency level.
+func (opt *hybridSearchOption) WithConsistencyToken(token string) *hybridSearchOption {
+	opt.consistencyToken = token
+	return opt
+}
+
 // Deprecated: typo, use WithPartitions instead
 func (opt *hybridSearchOption) WithPartitons(partitions ...string) *hybridSearchOption {
 	return opt.WithPartitions(partitions...)
@@ -484,6 +504,10 @@ func (opt *hybridSearchOption) HybridRequest() (*milvuspb.HybridSearchRequest, e
 	if opt.offset > 0 {
 		params = append(params, &commonpb.KeyValuePair{Key: spOffset, Value: strconv.FormatInt(int64(opt.offset), 10)})
 	}
+	guaranteeTs, err := parseConsistencyToken(opt.consistencyToken, opt.collectionName)
+	if err != nil {
+		return nil, err
+	}
 
 	return &milvuspb.HybridSearchRequest{
 		CollectionName:        opt.collectionName,
@@ -493,6 +517,7 @@ func (opt *hybridSearchOption) HybridRequest() (*milvuspb.HybridSearchRequest, e
 		ConsistencyLevel:      commonpb.ConsistencyLevel(opt.consistencyLevel),
 		OutputFields:          opt.outputFields,
 		RankParams:            params,
+		GuaranteeTimestamp:    guaranteeTs,
 	}, nil
 }
 
@@ -518,6 +543,7 @@ type queryOption struct {
 	useDefaultConsistencyLevel bool
 	expr                       string
 	templateParams             map[string]any
+	consistencyToken           string
 }
 
 func (opt *queryOption) Request() (*milvuspb.QueryRequest, error) {
@@ -532,6 +558,12 @@ func (opt *queryOption) Request() (*milvuspb.QueryRequest, error) {
 		UseDefaultConsistency: opt.useDefaultConsistencyLevel,
 	}
 
+	var err error
+	req.GuaranteeTimestamp, err = parseConsistencyToken(opt.consistencyToken, opt.collectionName)
+	if err != nil {
+		return nil, err
+	}
+
 	req.ExprTemplateValues = make(map[string]*schemapb.TemplateValue)
 	for key, value := range opt.templateParams {
 		tmplVal, err := any2TmplValue(value)
@@ -581,6 +613,13 @@ func (opt *queryOption) WithConsistencyLevel(consistencyLevel entity.Consistency
 	return opt
 }
 
+// WithConsistencyToken makes the query see the writes of the client which generated the token,
+// works with Session consistency level.
+func (opt *queryOption) WithConsistencyToken(token string) *queryOption {
+	opt.consistencyToken = token
+	return opt
+}
+
 func (opt *queryOption) WithPartitions(partitionNames ...string) *queryOption {
 	opt.partitionNames = partitionNames
 	return opt
@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+// sessionTimestamps records the latest write timestamp of every collection written by the client,
+// which is used as the guarantee timestamp of the reads to provide read-your-writes with Session consistency.
+type sessionTimestamps struct {
+	mut        sync.RWMutex
+	timestamps map[string]uint64
+}
+
+func sessionKey(dbName, collectionName string) string {
+	return dbName + "." + collectionName
+}
+
+func (s *sessionTimestamps) update(dbName, collectionName string, ts uint64) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	if s.timestamps == nil {
+		s.timestamps = make(map[string]uint64)
+	}
+	key := sessionKey(dbName, collectionName)
+	if ts > s.timestamps[key] {
+		s.timestamps[key] = ts
+	}
+}
+
+func (s *sessionTimestamps) get(dbName, collectionName string) uint64 {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+	return s.timestamps[sessionKey(dbName, collectionName)]
+}
+
+func (c *Client) currentDBName() string {
+	c.stateMut.RLock()
+	defer c.stateMut.RUnlock()
+	return c.currentDB
+}
+
+// observeWriteTs records the timestamp returned by a write request.
+func (c *Client) observeWriteTs(collectionName string, ts uint64) {
+	c.sessionTs.update(c.currentDBName(), collectionName, ts)
+}
+
+// sessionGuaranteeTs returns the guarantee timestamp of a read request,
+// which is the later one of the client's own writes and the one carried by the request.
+func (c *Client) sessionGuaranteeTs(collectionName string, requestTs uint64) uint64 {
+	ts := c.sessionTs.get(c.currentDBName(), collectionName)
+	if requestTs > ts {
+		return requestTs
+	}
+	return ts
+}
+
+// consistencyToken is the content of an encoded consistency token.
+type consistencyToken struct {
+	CollectionName string `json:"collection"`
+	Timestamp      uint64 `json:"ts"`
+}
+
+// GetConsistencyToken returns an opaque token carrying the latest write timestamp of the collection in this client.
+// Another client could attach the token to its reads with `WithConsistencyToken` to see the writes of this client,
+// when the reads use Session consistency level.
+func (c *Client) GetConsistencyToken(collectionName string) string {
+	data, _ := json.Marshal(consistencyToken{
+		CollectionName: collectionName,
+		Timestamp:      c.sessionTs.get(c.currentDBName(), collectionName),
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// parseConsistencyToken decodes the token and returns the timestamp it carries,
+// the token must be generated for the same collection.
+func parseConsistencyToken(token string, collectionName string) (uint64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid consistency token")
+	}
+	ct := consistencyToken{}
+	if err := json.Unmarshal(data, &ct); err != nil {
+		return 0, errors.Wrap(err, "invalid consistency token")
+	}
+	if ct.CollectionName != collectionName {
+		return 0, errors.Newf("consistency token of collection %s cannot be used for collection %s", ct.CollectionName, collectionName)
+	}
+	return ct.Timestamp, nil
+}
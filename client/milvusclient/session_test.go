@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+type SessionSuite struct {
+	MockSuiteBase
+
+	schema *entity.Schema
+}
+
+func (s *SessionSuite) SetupSuite() {
+	s.MockSuiteBase.SetupSuite()
+	s.schema = entity.NewSchema().
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(128))
+}
+
+func (s *SessionSuite) TestReadYourWrites() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+	otherCollection := fmt.Sprintf("coll_%s", s.randString(6))
+	s.setupCache(collectionName, s.schema)
+	s.setupCache(otherCollection, s.schema)
+
+	s.mock.EXPECT().Delete(mock.Anything, mock.Anything).Return(&milvuspb.MutationResult{
+		Status:    merr.Success(),
+		DeleteCnt: 1,
+		Timestamp: 100,
+	}, nil).Once()
+	_, err := s.client.Delete(ctx, NewDeleteOption(collectionName).WithInt64IDs("id", []int64{1}))
+	s.Require().NoError(err)
+
+	s.Run("session_timestamp", func() {
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			s.EqualValues(100, qr.GetGuaranteeTimestamp())
+			return &milvuspb.QueryResults{}, nil
+		}).Once()
+		_, err := s.client.Query(ctx, NewQueryOption(collectionName).WithConsistencyLevel(entity.ClSession))
+		s.NoError(err)
+
+		// the writes of other collections don't affect the reads
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			s.EqualValues(0, qr.GetGuaranteeTimestamp())
+			return &milvuspb.QueryResults{}, nil
+		}).Once()
+		_, err = s.client.Query(ctx, NewQueryOption(otherCollection).WithConsistencyLevel(entity.ClSession))
+		s.NoError(err)
+	})
+
+	s.Run("consistency_token", func() {
+		token := s.client.GetConsistencyToken(collectionName)
+
+		req, err := NewQueryOption(collectionName).WithConsistencyToken(token).Request()
+		s.NoError(err)
+		s.EqualValues(100, req.GetGuaranteeTimestamp())
+
+		_, err = NewQueryOption(otherCollection).WithConsistencyToken(token).Request()
+		s.Error(err)
+
+		_, err = NewQueryOption(collectionName).WithConsistencyToken("invalid!").Request()
+		s.Error(err)
+	})
+}
+
+func TestSession(t *testing.T) {
+	suite.Run(t, new(SessionSuite))
+}
@@ -54,6 +54,7 @@ func (c *Client) Insert(ctx context.Context, option InsertOption, callOptions ..
 			}
 
 			result.InsertCount = resp.GetInsertCnt()
+			c.observeWriteTs(req.GetCollectionName(), resp.GetTimestamp())
 			result.IDs, err = column.IDColumns(collection.Schema, resp.GetIDs(), 0, -1)
 			if err != nil {
 				return err
@@ -81,6 +82,7 @@ func (c *Client) Delete(ctx context.Context, option DeleteOption, callOptions ..
 			return err
 		}
 		result.DeleteCount = resp.GetDeleteCnt()
+		c.observeWriteTs(req.GetCollectionName(), resp.GetTimestamp())
 		return nil
 	})
 	return result, err
@@ -109,6 +111,7 @@ func (c *Client) Upsert(ctx context.Context, option UpsertOption, callOptions ..
 				return err
 			}
 			result.UpsertCount = resp.GetUpsertCnt()
+			c.observeWriteTs(req.GetCollectionName(), resp.GetTimestamp())
 			result.IDs, err = column.IDColumns(collection.Schema, resp.GetIDs(), 0, -1)
 			if err != nil {
 				return err
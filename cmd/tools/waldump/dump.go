@@ -0,0 +1,292 @@
+package main
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+const (
+	tsPrintFormat = "2006-01-02 15:04:05.999 -0700"
+)
+
+// dumpedMessage is the json form of a wal message.
+type dumpedMessage struct {
+	MessageID string           `json:"messageID,omitempty"`
+	Type      string           `json:"type"`
+	Version   string           `json:"version"`
+	VChannel  string           `json:"vchannel,omitempty"`
+	TimeTick  uint64           `json:"timetick"`
+	Time      string           `json:"time"`
+	Txn       *dumpedTxn       `json:"txn,omitempty"`
+	Broadcast *dumpedBroadcast `json:"broadcast,omitempty"`
+	Header    json.RawMessage  `json:"header,omitempty"`
+	Body      json.RawMessage  `json:"body,omitempty"`
+	Messages  []*dumpedMessage `json:"messages,omitempty"`
+	Error     string           `json:"error,omitempty"`
+}
+
+type dumpedTxn struct {
+	TxnID     int64  `json:"txnID"`
+	Keepalive string `json:"keepalive"`
+}
+
+type dumpedBroadcast struct {
+	BroadcastID  uint64   `json:"broadcastID"`
+	VChannels    []string `json:"vchannels"`
+	ResourceKeys []string `json:"resourceKeys,omitempty"`
+}
+
+// filter decides which messages are dumped, the zero value accepts all messages.
+type filter struct {
+	vchannel     string
+	collectionID int64
+	types        map[message.MessageType]struct{}
+	startTs      uint64
+	endTs        uint64
+}
+
+// parseMessageTypes parses the comma separated message type names, e.g. "INSERT,DELETE".
+func parseMessageTypes(s string) (map[message.MessageType]struct{}, error) {
+	if s == "" {
+		return nil, nil
+	}
+	names := make(map[string]message.MessageType)
+	for t := message.MessageTypeUnknown; t <= message.MessageTypeSchemaChange; t++ {
+		if t.Valid() {
+			names[t.String()] = t
+		}
+	}
+	types := make(map[message.MessageType]struct{})
+	for _, name := range strings.Split(s, ",") {
+		t, ok := names[strings.ToUpper(strings.TrimSpace(name))]
+		if !ok {
+			return nil, errors.Errorf("unknown message type %s", name)
+		}
+		types[t] = struct{}{}
+	}
+	return types, nil
+}
+
+// match checks whether the message should be dumped.
+func (f *filter) match(msg message.ImmutableMessage) bool {
+	if f.startTs > 0 && msg.TimeTick() < f.startTs {
+		return false
+	}
+	if f.endTs > 0 && msg.TimeTick() > f.endTs {
+		return false
+	}
+	if f.vchannel != "" && msg.VChannel() != f.vchannel {
+		return false
+	}
+	if f.types != nil {
+		if _, ok := f.types[msg.MessageType()]; !ok {
+			return false
+		}
+	}
+	if f.collectionID > 0 {
+		if collectionID, ok := getCollectionID(msg); !ok || collectionID != f.collectionID {
+			return false
+		}
+	}
+	return true
+}
+
+// getCollectionID returns the collection id in the message header if the header has one.
+// The txn message takes the collection of its first body message.
+func getCollectionID(msg message.ImmutableMessage) (int64, bool) {
+	if msg.MessageType() == message.MessageTypeTxn {
+		var collectionID int64
+		var found bool
+		message.AsImmutableTxnMessage(msg).RangeOver(func(body message.ImmutableMessage) error {
+			collectionID, found = getCollectionID(body)
+			return errors.New("stop")
+		})
+		return collectionID, found
+	}
+	header, _, err := decodeMessage(msg, false)
+	if err != nil || header == nil {
+		return 0, false
+	}
+	h, ok := header.(interface{ GetCollectionId() int64 })
+	if !ok {
+		return 0, false
+	}
+	return h.GetCollectionId(), true
+}
+
+// specializedMessage is the specialized immutable message with typed header and body.
+type specializedMessage[H proto.Message, B proto.Message] interface {
+	Header() H
+	Body() (B, error)
+}
+
+// decodeAs decodes the header and body of the message with the specialized decoder.
+func decodeAs[H proto.Message, B proto.Message, M specializedMessage[H, B]](
+	msg message.ImmutableMessage,
+	as func(message.ImmutableMessage) (M, error),
+	withBody bool,
+) (proto.Message, proto.Message, error) {
+	m, err := as(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !withBody {
+		return m.Header(), nil, nil
+	}
+	body, err := m.Body()
+	if err != nil {
+		return m.Header(), nil, err
+	}
+	return m.Header(), body, nil
+}
+
+// decodeMessage decodes the header and body of the message by its message type.
+func decodeMessage(msg message.ImmutableMessage, withBody bool) (proto.Message, proto.Message, error) {
+	switch msg.MessageType() {
+	case message.MessageTypeTimeTick:
+		return decodeAs[*message.TimeTickMessageHeader, *msgpb.TimeTickMsg](msg, message.AsImmutableTimeTickMessageV1, withBody)
+	case message.MessageTypeInsert:
+		return decodeAs[*message.InsertMessageHeader, *msgpb.InsertRequest](msg, message.AsImmutableInsertMessageV1, withBody)
+	case message.MessageTypeDelete:
+		return decodeAs[*message.DeleteMessageHeader, *msgpb.DeleteRequest](msg, message.AsImmutableDeleteMessageV1, withBody)
+	case message.MessageTypeCreateCollection:
+		return decodeAs[*message.CreateCollectionMessageHeader, *msgpb.CreateCollectionRequest](msg, message.AsImmutableCreateCollectionMessageV1, withBody)
+	case message.MessageTypeDropCollection:
+		return decodeAs[*message.DropCollectionMessageHeader, *msgpb.DropCollectionRequest](msg, message.AsImmutableDropCollectionMessageV1, withBody)
+	case message.MessageTypeCreatePartition:
+		return decodeAs[*message.CreatePartitionMessageHeader, *msgpb.CreatePartitionRequest](msg, message.AsImmutableCreatePartitionMessageV1, withBody)
+	case message.MessageTypeDropPartition:
+		return decodeAs[*message.DropPartitionMessageHeader, *msgpb.DropPartitionRequest](msg, message.AsImmutableDropPartitionMessageV1, withBody)
+	case message.MessageTypeImport:
+		return decodeAs[*message.ImportMessageHeader, *msgpb.ImportMsg](msg, message.AsImmutableImportMessageV1, withBody)
+	case message.MessageTypeCreateSegment:
+		return decodeAs[*message.CreateSegmentMessageHeader, *message.CreateSegmentMessageBody](msg, message.AsImmutableCreateSegmentMessageV2, withBody)
+	case message.MessageTypeFlush:
+		return decodeAs[*message.FlushMessageHeader, *message.FlushMessageBody](msg, message.AsImmutableFlushMessageV2, withBody)
+	case message.MessageTypeManualFlush:
+		return decodeAs[*message.ManualFlushMessageHeader, *message.ManualFlushMessageBody](msg, message.AsImmutableManualFlushMessageV2, withBody)
+	case message.MessageTypeBeginTxn:
+		return decodeAs[*message.BeginTxnMessageHeader, *message.BeginTxnMessageBody](msg, message.AsImmutableBeginTxnMessageV2, withBody)
+	case message.MessageTypeCommitTxn:
+		return decodeAs[*message.CommitTxnMessageHeader, *message.CommitTxnMessageBody](msg, message.AsImmutableCommitTxnMessageV2, withBody)
+	case message.MessageTypeRollbackTxn:
+		return decodeAs[*message.RollbackTxnMessageHeader, *message.RollbackTxnMessageBody](msg, message.AsImmutableRollbackTxnMessageV2, withBody)
+	case message.MessageTypeSchemaChange:
+		return decodeAs[*message.SchemaChangeMessageHeader, *message.SchemaChangeMessageBody](msg, message.AsImmutableCollectionSchemaChangeV2, withBody)
+	default:
+		return nil, nil, errors.Errorf("unsupported message type %s", msg.MessageType())
+	}
+}
+
+// dumpMessage converts the message into its json form, the body is decoded only if withBody is set,
+// the body messages of a txn message are always dumped.
+func dumpMessage(msg message.ImmutableMessage, withBody bool) *dumpedMessage {
+	d := &dumpedMessage{
+		Type:     msg.MessageType().String(),
+		Version:  msg.Version().String(),
+		VChannel: msg.VChannel(),
+		TimeTick: msg.TimeTick(),
+		Time:     tsoutil.PhysicalTime(msg.TimeTick()).Format(tsPrintFormat),
+	}
+	if id := msg.MessageID(); id != nil {
+		d.MessageID = id.Marshal()
+	}
+	if txn := msg.TxnContext(); txn != nil {
+		d.Txn = &dumpedTxn{
+			TxnID:     int64(txn.TxnID),
+			Keepalive: txn.Keepalive.String(),
+		}
+	}
+	if bh := msg.BroadcastHeader(); bh != nil {
+		d.Broadcast = &dumpedBroadcast{
+			BroadcastID: bh.BroadcastID,
+			VChannels:   bh.VChannels,
+		}
+		for key := range bh.ResourceKeys {
+			d.Broadcast.ResourceKeys = append(d.Broadcast.ResourceKeys, key.Domain.String()+":"+key.Key)
+		}
+		sort.Strings(d.Broadcast.ResourceKeys)
+	}
+
+	if msg.MessageType() == message.MessageTypeTxn {
+		txnMsg := message.AsImmutableTxnMessage(msg)
+		d.Messages = append(d.Messages, dumpMessage(txnMsg.Begin(), withBody))
+		txnMsg.RangeOver(func(body message.ImmutableMessage) error {
+			d.Messages = append(d.Messages, dumpMessage(body, withBody))
+			return nil
+		})
+		d.Messages = append(d.Messages, dumpMessage(txnMsg.Commit(), withBody))
+		return d
+	}
+
+	header, body, err := decodeMessage(msg, withBody)
+	if err != nil {
+		d.Error = err.Error()
+	}
+	if header != nil {
+		d.Header, _ = protojson.Marshal(header)
+	}
+	if body != nil {
+		d.Body, _ = protojson.Marshal(body)
+	}
+	return d
+}
+
+// txnAssembler groups the body messages of a txn into one txn message on commit.
+type txnAssembler struct {
+	builders map[message.TxnID]*message.ImmutableTxnMessageBuilder
+}
+
+func newTxnAssembler() *txnAssembler {
+	return &txnAssembler{
+		builders: make(map[message.TxnID]*message.ImmutableTxnMessageBuilder),
+	}
+}
+
+// push consumes a raw wal message, returns the message that is ready to dump, nil if the message is buffered.
+func (a *txnAssembler) push(msg message.ImmutableMessage) (message.ImmutableMessage, error) {
+	txn := msg.TxnContext()
+	if txn == nil {
+		return msg, nil
+	}
+	switch msg.MessageType() {
+	case message.MessageTypeBeginTxn:
+		begin, err := message.AsImmutableBeginTxnMessageV2(msg)
+		if err != nil {
+			return nil, err
+		}
+		a.builders[txn.TxnID] = message.NewImmutableTxnMessageBuilder(begin)
+		return nil, nil
+	case message.MessageTypeCommitTxn:
+		builder, ok := a.builders[txn.TxnID]
+		if !ok {
+			// the begin message is before the start position, dump the commit message as it is.
+			return msg, nil
+		}
+		delete(a.builders, txn.TxnID)
+		commit, err := message.AsImmutableCommitTxnMessageV2(msg)
+		if err != nil {
+			return nil, err
+		}
+		return builder.Build(commit)
+	case message.MessageTypeRollbackTxn:
+		delete(a.builders, txn.TxnID)
+		return msg, nil
+	default:
+		builder, ok := a.builders[txn.TxnID]
+		if !ok {
+			return msg, nil
+		}
+		builder.Add(msg)
+		return nil, nil
+	}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/walimplstest"
+)
+
+var testTxnCtx = message.TxnContext{TxnID: 1, Keepalive: time.Second}
+
+func newTestInsertMessage(id int64, collectionID int64, txn bool) message.ImmutableMessage {
+	msg := message.NewInsertMessageBuilderV1().
+		WithVChannel("v1").
+		WithHeader(&message.InsertMessageHeader{CollectionId: collectionID}).
+		WithBody(&msgpb.InsertRequest{CollectionName: "test", NumRows: 1}).
+		MustBuildMutable().
+		WithTimeTick(uint64(id))
+	if txn {
+		msg = msg.WithTxnContext(testTxnCtx)
+	}
+	return msg.WithLastConfirmedUseMessageID().IntoImmutableMessage(walimplstest.NewTestMessageID(id))
+}
+
+func newTestBeginTxnMessage(id int64) message.ImmutableMessage {
+	return message.NewBeginTxnMessageBuilderV2().
+		WithVChannel("v1").
+		WithHeader(&message.BeginTxnMessageHeader{}).
+		WithBody(&message.BeginTxnMessageBody{}).
+		MustBuildMutable().
+		WithTxnContext(testTxnCtx).
+		WithTimeTick(uint64(id)).
+		WithLastConfirmedUseMessageID().
+		IntoImmutableMessage(walimplstest.NewTestMessageID(id))
+}
+
+func newTestCommitTxnMessage(id int64) message.ImmutableMessage {
+	return message.NewCommitTxnMessageBuilderV2().
+		WithVChannel("v1").
+		WithHeader(&message.CommitTxnMessageHeader{}).
+		WithBody(&message.CommitTxnMessageBody{}).
+		MustBuildMutable().
+		WithTxnContext(testTxnCtx).
+		WithTimeTick(uint64(id)).
+		WithLastConfirmedUseMessageID().
+		IntoImmutableMessage(walimplstest.NewTestMessageID(id))
+}
+
+func TestParseMessageTypes(t *testing.T) {
+	types, err := parseMessageTypes("")
+	assert.NoError(t, err)
+	assert.Nil(t, types)
+
+	types, err = parseMessageTypes("insert, DELETE")
+	assert.NoError(t, err)
+	assert.Len(t, types, 2)
+	assert.Contains(t, types, message.MessageTypeInsert)
+	assert.Contains(t, types, message.MessageTypeDelete)
+
+	_, err = parseMessageTypes("INSERT,UNKNOWN")
+	assert.Error(t, err)
+}
+
+func TestFilter(t *testing.T) {
+	msg := newTestInsertMessage(10, 1, false)
+	assert.True(t, (&filter{}).match(msg))
+	assert.True(t, (&filter{vchannel: "v1", collectionID: 1, startTs: 10, endTs: 10}).match(msg))
+	assert.False(t, (&filter{vchannel: "v2"}).match(msg))
+	assert.False(t, (&filter{collectionID: 2}).match(msg))
+	assert.False(t, (&filter{startTs: 11}).match(msg))
+	assert.False(t, (&filter{endTs: 9}).match(msg))
+	assert.False(t, (&filter{types: map[message.MessageType]struct{}{message.MessageTypeDelete: {}}}).match(msg))
+	// the begin message has no collection.
+	assert.False(t, (&filter{collectionID: 1}).match(newTestBeginTxnMessage(1)))
+}
+
+func TestDumpMessage(t *testing.T) {
+	d := dumpMessage(newTestInsertMessage(10, 1, false), false)
+	assert.Equal(t, "INSERT", d.Type)
+	assert.Equal(t, "v1", d.VChannel)
+	assert.EqualValues(t, 10, d.TimeTick)
+	assert.Equal(t, walimplstest.NewTestMessageID(10).Marshal(), d.MessageID)
+	assert.JSONEq(t, `{"collectionId":"1"}`, string(d.Header))
+	assert.Nil(t, d.Body)
+	assert.Nil(t, d.Txn)
+	assert.Empty(t, d.Error)
+
+	d = dumpMessage(newTestInsertMessage(10, 1, false), true)
+	assert.JSONEq(t, `{"collectionName":"test","numRows":"1"}`, string(d.Body))
+}
+
+func TestTxnAssembler(t *testing.T) {
+	a := newTxnAssembler()
+	msg, err := a.push(newTestBeginTxnMessage(1))
+	assert.NoError(t, err)
+	assert.Nil(t, msg)
+	msg, err = a.push(newTestInsertMessage(2, 1, true))
+	assert.NoError(t, err)
+	assert.Nil(t, msg)
+	msg, err = a.push(newTestInsertMessage(3, 1, false))
+	assert.NoError(t, err)
+	assert.NotNil(t, msg)
+	msg, err = a.push(newTestCommitTxnMessage(4))
+	assert.NoError(t, err)
+	assert.Equal(t, message.MessageTypeTxn, msg.MessageType())
+	assert.Empty(t, a.builders)
+	assert.True(t, (&filter{collectionID: 1}).match(msg))
+
+	d := dumpMessage(msg, false)
+	assert.Equal(t, "TXN", d.Type)
+	assert.EqualValues(t, 1, d.Txn.TxnID)
+	assert.Len(t, d.Messages, 3)
+	assert.Equal(t, "BEGIN_TXN", d.Messages[0].Type)
+	assert.Equal(t, "INSERT", d.Messages[1].Type)
+	assert.Equal(t, "COMMIT_TXN", d.Messages[2].Type)
+
+	// the commit message without begin is dumped as it is.
+	msg, err = a.push(newTestCommitTxnMessage(5))
+	assert.NoError(t, err)
+	assert.Equal(t, message.MessageTypeCommitTxn, msg.MessageType())
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/util/streamingutil/util"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/mq/mqimpl/rocksmq/server"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	_ "github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/kafka"
+	_ "github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/nats"
+	_ "github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/pulsar"
+	_ "github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/rmq"
+	_ "github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/wp"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+var (
+	walName  = flag.String("wal", "", "WAL implementation to open, use the configured one if empty")
+	pchannel = flag.String("pchannel", "", "Physical channel to scan")
+	vchannel = flag.String("vchannel", "", "Virtual channel to filter with")
+
+	startID = flag.String("start-id", "", "Marshaled message id to start scanning from, scan from the earliest message if empty")
+	startTs = flag.Uint64("start-ts", 0, "Seek to the first message with timetick not less than the timestamp, the messages before it are skipped while scanning if the wal can't seek by timetick")
+	endTs   = flag.Uint64("end-ts", 0, "Stop scanning at the first message with timetick greater than the timestamp")
+
+	collectionID = flag.Int64("collection", 0, "Collection ID to filter with")
+	messageTypes = flag.String("type", "", "Comma separated message types to filter with, e.g. INSERT,DELETE")
+	detail       = flag.Bool("detail", false, "Display the decoded message body")
+	groupTxn     = flag.Bool("group-txn", false, "Display the messages of a transaction as one TXN message on commit")
+	limit        = flag.Int("limit", 0, "Max count of messages to display, 0 means no limit")
+	follow       = flag.Bool("follow", false, "Keep waiting for the new messages until interrupted")
+	idle         = flag.Duration("idle", 5*time.Second, "Stop scanning if no message arrives in the duration when not following")
+)
+
+func main() {
+	flag.Parse()
+	if *pchannel == "" {
+		fmt.Fprintln(os.Stderr, "pchannel is required")
+		flag.Usage()
+		os.Exit(1)
+	}
+	msgTypes, err := parseMessageTypes(*messageTypes)
+	if err != nil {
+		log.Fatal("invalid message type", zap.Error(err))
+	}
+	f := &filter{
+		vchannel:     *vchannel,
+		collectionID: *collectionID,
+		types:        msgTypes,
+		startTs:      *startTs,
+		endTs:        *endTs,
+	}
+
+	paramtable.Init()
+	name := *walName
+	if name == "" {
+		name = util.MustSelectWALName()
+	}
+	if name == util.WALTypeRocksmq {
+		if err := server.InitRocksMQ(paramtable.Get().RocksmqCfg.Path.GetValue()); err != nil {
+			log.Fatal("failed to init rocksmq", zap.Error(err))
+		}
+		defer server.CloseRocksMQ()
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	if err := dump(ctx, name, f); err != nil {
+		log.Fatal("failed to dump wal", zap.Error(err))
+	}
+}
+
+// seekStartTs returns the deliver policy starting from the first message with timetick not less than ts,
+// the origin deliver policy is returned if the wal can't seek by timetick.
+func seekStartTs(ctx context.Context, wal walimpls.WALImpls, name string, ts uint64, deliverPolicy options.DeliverPolicy) (options.DeliverPolicy, error) {
+	encode, ok := sequenceWALs[name]
+	if !ok {
+		log.Warn("the wal can't seek by timetick, scan from the start position", zap.String("wal", name))
+		return deliverPolicy, nil
+	}
+	first := int64(-1)
+	if *startID != "" {
+		id, err := message.UnmarshalMessageID(name, *startID)
+		if err != nil {
+			return nil, err
+		}
+		if first, err = strconv.ParseInt(id.String(), 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	seq, err := seekTimeTick(ctx, first, ts, newWALProbe(wal, name, encode, *idle))
+	if err != nil {
+		return nil, err
+	}
+	if seq < 0 {
+		return deliverPolicy, nil
+	}
+	id, err := message.UnmarshalMessageID(name, encode(seq))
+	if err != nil {
+		return nil, err
+	}
+	log.Info("seek to the start timestamp", zap.Uint64("startTs", ts), zap.Stringer("messageID", id))
+	return options.DeliverPolicyStartFrom(id), nil
+}
+
+// dump scans the pchannel in read-only mode and prints the matched messages as json lines.
+func dump(ctx context.Context, name string, f *filter) error {
+	deliverPolicy := options.DeliverPolicyAll()
+	if *startID != "" {
+		id, err := message.UnmarshalMessageID(name, *startID)
+		if err != nil {
+			return err
+		}
+		deliverPolicy = options.DeliverPolicyStartFrom(id)
+	}
+
+	opener, err := registry.MustGetBuilder(name).Build()
+	if err != nil {
+		return err
+	}
+	defer opener.Close()
+
+	// the wal is opened in read-only mode, so it never fences the running streaming node.
+	wal, err := opener.Open(ctx, &walimpls.OpenOption{
+		Channel: types.PChannelInfo{
+			Name:       *pchannel,
+			Term:       0,
+			AccessMode: types.AccessModeRO,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	defer wal.Close()
+
+	if f.startTs > 0 {
+		if deliverPolicy, err = seekStartTs(ctx, wal, name, f.startTs, deliverPolicy); err != nil {
+			return err
+		}
+	}
+
+	scanner, err := wal.Read(ctx, walimpls.ReadOption{
+		Name:          "waldump",
+		DeliverPolicy: deliverPolicy,
+	})
+	if err != nil {
+		return err
+	}
+	defer scanner.Close()
+
+	encoder := json.NewEncoder(os.Stdout)
+	assembler := newTxnAssembler()
+	count := 0
+	timer := time.NewTimer(*idle)
+	defer timer.Stop()
+	for {
+		var msg message.ImmutableMessage
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			if !*follow {
+				return nil
+			}
+			timer.Reset(*idle)
+			continue
+		case msg, ok = <-scanner.Chan():
+			if !ok {
+				return scanner.Error()
+			}
+		}
+		if !timer.Stop() {
+			<-timer.C
+		}
+		timer.Reset(*idle)
+
+		if f.endTs > 0 && msg.TimeTick() > f.endTs {
+			return nil
+		}
+		if *groupTxn {
+			if msg, err = assembler.push(msg); err != nil {
+				return err
+			}
+			if msg == nil {
+				continue
+			}
+		}
+		if !f.match(msg) {
+			continue
+		}
+		if err := encoder.Encode(dumpMessage(msg, *detail)); err != nil {
+			return err
+		}
+		count++
+		if *limit > 0 && count >= *limit {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/streamingutil/util"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+)
+
+// sequenceWALs are the wals whose message id is the sequence of message in the pchannel,
+// the value marshals the sequence into the message id.
+var sequenceWALs = map[string]func(int64) string{
+	util.WALTypeKafka:   message.EncodeInt64,
+	util.WALTypeRocksmq: message.EncodeInt64,
+	util.WALTypeNats: func(seq int64) string {
+		return message.EncodeUint64(uint64(seq))
+	},
+}
+
+// probeFunc returns the sequence and timetick of the first message at or after the sequence,
+// the negative sequence means the earliest message, found is false if there's no such message.
+type probeFunc func(ctx context.Context, seq int64) (next int64, timetick uint64, found bool, err error)
+
+// seekTimeTick returns the sequence of the first message whose timetick is not less than ts.
+// The timetick of messages in a pchannel is increasing, so the sequence is found by exponential and binary search
+// starting from the first message, every step probes the wal by a new scanner.
+func seekTimeTick(ctx context.Context, first int64, ts uint64, probe probeFunc) (int64, error) {
+	lo, timetick, found, err := probe(ctx, first)
+	if err != nil || !found || timetick >= ts {
+		return first, err
+	}
+
+	// the message at lo is before ts, find a hi whose message is not before ts or beyond the last message.
+	hi := lo + 1
+	for step := int64(2); ; step *= 2 {
+		next, timetick, found, err := probe(ctx, hi)
+		if err != nil {
+			return 0, err
+		}
+		if !found || timetick >= ts {
+			break
+		}
+		lo = next
+		hi = next + step
+	}
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		next, timetick, found, err := probe(ctx, mid)
+		if err != nil {
+			return 0, err
+		}
+		if found && timetick < ts {
+			lo = next
+		} else {
+			hi = mid
+		}
+	}
+	return hi, nil
+}
+
+// newWALProbe returns a probe which reads the first message at or after the sequence from the wal,
+// the message is treated as not found if it doesn't arrive in the timeout.
+func newWALProbe(wal walimpls.WALImpls, name string, encode func(int64) string, timeout time.Duration) probeFunc {
+	return func(ctx context.Context, seq int64) (int64, uint64, bool, error) {
+		deliverPolicy := options.DeliverPolicyAll()
+		if seq >= 0 {
+			id, err := message.UnmarshalMessageID(name, encode(seq))
+			if err != nil {
+				return 0, 0, false, err
+			}
+			deliverPolicy = options.DeliverPolicyStartFrom(id)
+		}
+		scanner, err := wal.Read(ctx, walimpls.ReadOption{
+			Name:          "waldump-seek",
+			DeliverPolicy: deliverPolicy,
+		})
+		if err != nil {
+			return 0, 0, false, err
+		}
+		defer scanner.Close()
+
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return 0, 0, false, ctx.Err()
+		case <-timer.C:
+			return 0, 0, false, nil
+		case msg, ok := <-scanner.Chan():
+			if !ok {
+				return 0, 0, false, scanner.Error()
+			}
+			next, err := strconv.ParseInt(msg.MessageID().String(), 10, 64)
+			if err != nil {
+				return 0, 0, false, err
+			}
+			return next, msg.TimeTick(), true, nil
+		}
+	}
+}
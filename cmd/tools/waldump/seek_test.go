@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeekTimeTick(t *testing.T) {
+	ctx := context.Background()
+	// the sequences are not continuous, the timetick of the message at sequence i is i*10.
+	seqs := []int64{3, 4, 7, 8, 9, 15, 20, 21, 40, 41, 42, 100}
+	probes := 0
+	probe := func(ctx context.Context, seq int64) (int64, uint64, bool, error) {
+		probes++
+		i := sort.Search(len(seqs), func(i int) bool { return seqs[i] >= seq })
+		if i == len(seqs) {
+			return 0, 0, false, nil
+		}
+		return seqs[i], uint64(seqs[i] * 10), true, nil
+	}
+
+	for _, seq := range seqs {
+		probes = 0
+		got, err := seekTimeTick(ctx, -1, uint64(seq*10), probe)
+		assert.NoError(t, err)
+		if seq == seqs[0] {
+			assert.EqualValues(t, -1, got)
+			continue
+		}
+		// the first message at or after the sequence is the target one.
+		next, _, found, _ := probe(ctx, got)
+		assert.True(t, found)
+		assert.Equal(t, seq, next)
+		assert.Less(t, probes, len(seqs)*2)
+
+		// the timestamp between two messages seeks to the later one.
+		got, err = seekTimeTick(ctx, -1, uint64(seq*10-1), probe)
+		assert.NoError(t, err)
+		next, _, _, _ = probe(ctx, got)
+		assert.Equal(t, seq, next)
+	}
+
+	// seek from the start sequence.
+	got, err := seekTimeTick(ctx, 20, 420, probe)
+	assert.NoError(t, err)
+	next, _, _, _ := probe(ctx, got)
+	assert.EqualValues(t, 42, next)
+
+	// the timestamp after the last message seeks to the end.
+	got, err = seekTimeTick(ctx, -1, 2000, probe)
+	assert.NoError(t, err)
+	_, _, found, _ := probe(ctx, got)
+	assert.False(t, found)
+}
@@ -37,6 +37,8 @@ const (
 	ResourceGroupCategory   = "/resource_groups/"
 	SegmentCategory         = "/segments/"
 	QuotaCenterCategory     = "/quotacenter/"
+	ChangeStreamCategory    = "/changestream/"
+	TransactionCategory     = "/transactions/"
 
 	ListAction           = "list"
 	HasAction            = "has"
@@ -76,6 +78,10 @@ const (
 	AddPrivilegesToGroupAction      = "add_privileges_to_group"
 	RemovePrivilegesFromGroupAction = "remove_privileges_from_group"
 	TransferReplicaAction           = "transfer_replica"
+	SubscribeAction                 = "subscribe"
+	BeginAction                     = "begin"
+	CommitAction                    = "commit"
+	RollbackAction                  = "rollback"
 )
 
 const (
@@ -120,6 +126,7 @@ const (
 	HTTPHeaderAllowInt64     = "Accept-Type-Allow-Int64"
 	HTTPHeaderDBName         = "DB-Name"
 	HTTPHeaderRequestTimeout = "Request-Timeout"
+	HTTPHeaderTransactionID  = "Transaction-Id"
 	HTTPReturnCode           = "code"
 	HTTPReturnMessage        = "message"
 	HTTPReturnData           = "data"
@@ -131,6 +138,9 @@ const (
 
 	HTTPReturnHas = "has"
 
+	HTTPReturnTransactionID = "transactionId"
+	HTTPReturnTimestamp     = "timestamp"
+
 	HTTPReturnFieldName             = "name"
 	HTTPReturnFieldID               = "id"
 	HTTPReturnFieldType             = "type"
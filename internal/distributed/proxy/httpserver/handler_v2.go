@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/gin-gonic/gin"
@@ -209,6 +210,14 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	// segment group
 	router.POST(SegmentCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &GetSegmentsInfoReq{} }, wrapperTraceLog(h.getSegmentsInfo))))
 	router.POST(QuotaCenterCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &GetQuotaMetricsReq{} }, wrapperTraceLog(h.getQuotaMetrics))))
+
+	// change stream is a long-lived server-sent events response, so it's not wrapped by the timeout middleware
+	router.POST(ChangeStreamCategory+SubscribeAction, wrapperPost(func() any { return &ChangeStreamReq{} }, wrapperTraceLog(h.subscribeChangeStream)))
+
+	// the dml requests with the Transaction-Id header are buffered into the transaction until it's committed
+	router.POST(TransactionCategory+BeginAction, timeoutMiddleware(wrapperPost(func() any { return &BeginTransactionReq{} }, wrapperTraceLog(h.beginTransaction))))
+	router.POST(TransactionCategory+CommitAction, timeoutMiddleware(wrapperPost(func() any { return &TransactionIDReq{} }, wrapperTraceLog(h.commitTransaction))))
+	router.POST(TransactionCategory+RollbackAction, timeoutMiddleware(wrapperPost(func() any { return &TransactionIDReq{} }, wrapperTraceLog(h.rollbackTransaction))))
 }
 
 type (
@@ -257,6 +266,7 @@ func wrapperPost(newReq newReqFunc, v2 handlerFuncV2) gin.HandlerFunc {
 		defer span.End()
 		username, _ := gCtx.Get(ContextUsername)
 		ctx = proxy.NewContextWithMetadata(ctx, username.(string), dbName)
+		ctx = proxy.NewContextWithTransaction(ctx, gCtx.Request.Header.Get(HTTPHeaderTransactionID))
 		traceID := span.SpanContext().TraceID().String()
 		ctx = log.WithTraceID(ctx, traceID)
 		gCtx.Keys["traceID"] = traceID
@@ -1143,8 +1153,10 @@ func generatePlaceholderGroup(ctx context.Context, body string, collSchema *sche
 
 	if vectorField.GetIsFunctionOutput() {
 		for _, function := range collSchema.Functions {
-			if function.Type == schemapb.FunctionType_BM25 || function.Type == schemapb.FunctionType_TextEmbedding {
-				// TODO: currently only BM25 & text embedding function is supported, thus guarantees one input field to one output field
+			if function.Type == schemapb.FunctionType_BM25 || function.Type == schemapb.FunctionType_TextEmbedding || function.Type == schemapb.FunctionType_MinHash ||
+				function.Type == schemapb.FunctionType_MultimodalEmbedding {
+				// TODO: currently only BM25, text embedding, MinHash & multimodal embedding function is supported, thus guarantees one output field
+				// the multimodal embedding function searches the images by the text queries
 				if function.OutputFieldNames[0] == vectorField.Name {
 					dataType = schemapb.DataType_VarChar
 				}
@@ -2739,3 +2751,87 @@ func (h *HandlersV2) getQuotaMetrics(ctx context.Context, c *gin.Context, anyReq
 
 	return resp, err
 }
+
+// subscribeChangeStream streams the row level change events of the collection as server-sent events,
+// every event carries a checkpoint, which could be used to resume the subscription.
+func (h *HandlersV2) subscribeChangeStream(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*ChangeStreamReq)
+	// subscribing the changes requires the same privilege as query
+	req := &milvuspb.QueryRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+	}
+	c.Set(ContextRequest, req)
+	if h.checkAuth {
+		if err := checkAuthorizationV2(ctx, c, false, req); err != nil {
+			return nil, err
+		}
+	}
+
+	subscription, err := proxy.SubscribeChangeStream(ctx, dbName, httpReq.CollectionName, httpReq.Checkpoint)
+	if err != nil {
+		log.Ctx(ctx).Warn("high level restful api, fail to subscribe change stream", zap.Error(err))
+		HTTPAbortReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return nil, err
+	}
+	defer subscription.Close()
+
+	c.Stream(func(w io.Writer) bool {
+		event, ok := <-subscription.Events()
+		if !ok {
+			err := subscription.Error()
+			c.SSEvent("error", gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+			return false
+		}
+		c.SSEvent(string(event.Type), event)
+		return true
+	})
+	return nil, nil
+}
+
+func (h *HandlersV2) beginTransaction(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*BeginTransactionReq)
+	// beginning a transaction requires the same privilege as insert
+	req := &milvuspb.InsertRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+	}
+	c.Set(ContextRequest, req)
+	if h.checkAuth {
+		if err := checkAuthorizationV2(ctx, c, false, req); err != nil {
+			return nil, err
+		}
+	}
+
+	txnID, err := proxy.BeginTransaction(ctx, dbName, httpReq.CollectionName, time.Duration(httpReq.Timeout)*time.Second)
+	if err != nil {
+		log.Ctx(ctx).Warn("high level restful api, fail to begin transaction", zap.Error(err))
+		HTTPAbortReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return nil, err
+	}
+	HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: gin.H{HTTPReturnTransactionID: txnID}})
+	return txnID, nil
+}
+
+func (h *HandlersV2) commitTransaction(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*TransactionIDReq)
+	timestamp, err := proxy.CommitTransaction(ctx, httpReq.TransactionID)
+	if err != nil {
+		log.Ctx(ctx).Warn("high level restful api, fail to commit transaction", zap.String("transactionID", httpReq.TransactionID), zap.Error(err))
+		HTTPAbortReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return nil, err
+	}
+	HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: gin.H{HTTPReturnTimestamp: timestamp}})
+	return timestamp, nil
+}
+
+func (h *HandlersV2) rollbackTransaction(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*TransactionIDReq)
+	if err := proxy.RollbackTransaction(ctx, httpReq.TransactionID); err != nil {
+		log.Ctx(ctx).Warn("high level restful api, fail to rollback transaction", zap.String("transactionID", httpReq.TransactionID), zap.Error(err))
+		HTTPAbortReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return nil, err
+	}
+	HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: gin.H{}})
+	return nil, nil
+}
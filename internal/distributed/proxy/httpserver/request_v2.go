@@ -764,3 +764,36 @@ func (req *GetSegmentsInfoReq) GetSegmentIDs() []int64 {
 }
 
 type GetQuotaMetricsReq struct{}
+
+type ChangeStreamReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" binding:"required"`
+	Checkpoint     string `json:"checkpoint"`
+}
+
+func (req *ChangeStreamReq) GetDbName() string {
+	return req.DbName
+}
+
+func (req *ChangeStreamReq) GetCollectionName() string {
+	return req.CollectionName
+}
+
+type BeginTransactionReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" binding:"required"`
+	// Timeout is the lifetime of the transaction in seconds, capped by proxy.transaction.timeout.
+	Timeout int64 `json:"timeout"`
+}
+
+func (req *BeginTransactionReq) GetDbName() string {
+	return req.DbName
+}
+
+func (req *BeginTransactionReq) GetCollectionName() string {
+	return req.CollectionName
+}
+
+type TransactionIDReq struct {
+	TransactionID string `json:"transactionId" binding:"required"`
+}
@@ -1121,6 +1121,48 @@ func (s *Server) RunAnalyzer(ctx context.Context, req *milvuspb.RunAnalyzerReque
 	return s.proxy.RunAnalyzer(ctx, req)
 }
 
+// SubscribeChangeStream is a server streaming rpc, which isn't covered by the unary interceptors,
+// so the authentication, database and privilege are checked here before subscribing.
+func (s *Server) SubscribeChangeStream(req *milvuspb.SubscribeChangeStreamRequest, stream milvuspb.MilvusService_SubscribeChangeStreamServer) error {
+	if enableCustomInterceptor {
+		ctx, err := proxy.AuthenticationInterceptor(stream.Context())
+		if err != nil {
+			return err
+		}
+		if req.GetDbName() == "" {
+			req.DbName = proxy.GetCurDBNameFromContextOrDefault(ctx)
+		}
+		ctx, err = proxy.PrivilegeInterceptor(ctx, req)
+		if err != nil {
+			return err
+		}
+		stream = &changeStreamServer{MilvusService_SubscribeChangeStreamServer: stream, ctx: ctx}
+	}
+	return s.proxy.SubscribeChangeStream(req, stream)
+}
+
+// changeStreamServer overrides the context of stream with the authenticated one.
+type changeStreamServer struct {
+	milvuspb.MilvusService_SubscribeChangeStreamServer
+	ctx context.Context
+}
+
+func (s *changeStreamServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *Server) BeginTransaction(ctx context.Context, req *milvuspb.BeginTransactionRequest) (*milvuspb.BeginTransactionResponse, error) {
+	return s.proxy.BeginTransaction(ctx, req)
+}
+
+func (s *Server) CommitTransaction(ctx context.Context, req *milvuspb.CommitTransactionRequest) (*milvuspb.CommitTransactionResponse, error) {
+	return s.proxy.CommitTransaction(ctx, req)
+}
+
+func (s *Server) RollbackTransaction(ctx context.Context, req *milvuspb.RollbackTransactionRequest) (*commonpb.Status, error) {
+	return s.proxy.RollbackTransaction(ctx, req)
+}
+
 func (s *Server) GetSegmentsInfo(ctx context.Context, req *internalpb.GetSegmentsInfoRequest) (*internalpb.GetSegmentsInfoResponse, error) {
 	return s.proxy.GetSegmentsInfo(ctx, req)
 }
@@ -683,6 +683,36 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("SubscribeChangeStream", func(t *testing.T) {
+		// the stream without authorization metadata is rejected before reaching proxy
+		err := server.SubscribeChangeStream(&milvuspb.SubscribeChangeStreamRequest{}, &changeStreamServer{ctx: ctx})
+		assert.Error(t, err)
+
+		enableCustomInterceptor = false
+		defer func() { enableCustomInterceptor = true }()
+		mockProxy.EXPECT().SubscribeChangeStream(mock.Anything, mock.Anything).Return(nil)
+		err = server.SubscribeChangeStream(&milvuspb.SubscribeChangeStreamRequest{}, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("BeginTransaction", func(t *testing.T) {
+		mockProxy.EXPECT().BeginTransaction(mock.Anything, mock.Anything).Return(nil, nil)
+		_, err := server.BeginTransaction(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CommitTransaction", func(t *testing.T) {
+		mockProxy.EXPECT().CommitTransaction(mock.Anything, mock.Anything).Return(nil, nil)
+		_, err := server.CommitTransaction(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("RollbackTransaction", func(t *testing.T) {
+		mockProxy.EXPECT().RollbackTransaction(mock.Anything, mock.Anything).Return(nil, nil)
+		_, err := server.RollbackTransaction(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("Run with different config", func(t *testing.T) {
 		mockProxy.EXPECT().Init().Return(nil)
 		mockProxy.EXPECT().Start().Return(nil)
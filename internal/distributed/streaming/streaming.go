@@ -50,6 +50,11 @@ type TxnOption struct {
 	// Only make sense when keepalive is greater than 1ms.
 	// The default value is 0, which means the keepalive is setted by the wal at streaming node.
 	Keepalive time.Duration
+
+	// TxnContext is the context of an in-flight transaction which is began by Txn before.
+	// If it's set, the transaction is resumed without sending the begin message,
+	// so the transaction prepared by another process can be committed or rollbacked.
+	TxnContext *message.TxnContext
 }
 
 type ReadOption struct {
@@ -161,4 +166,7 @@ type Txn interface {
 	// TODO: Manually rollback is make no sense for current single wal txn.
 	// It is preserved for future cross-wal txn.
 	Rollback(ctx context.Context) error
+
+	// TxnContext returns the context of the transaction, it can be used to resume the transaction by TxnOption.
+	TxnContext() message.TxnContext
 }
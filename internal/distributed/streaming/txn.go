@@ -101,3 +101,8 @@ func (t *txnImpl) Rollback(ctx context.Context) error {
 	_, err = t.appendToWAL(ctx, rollback.WithTxnContext(*t.txnCtx))
 	return err
 }
+
+// TxnContext returns the context of the transaction.
+func (t *txnImpl) TxnContext() message.TxnContext {
+	return *t.txnCtx
+}
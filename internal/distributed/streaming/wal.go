@@ -132,6 +132,17 @@ func (w *walAccesserImpl) Txn(ctx context.Context, opts TxnOption) (Txn, error)
 		return nil, status.NewInvaildArgument("ttl must be greater than or equal to 1ms")
 	}
 
+	// Resume the in-flight transaction.
+	if opts.TxnContext != nil {
+		return &txnImpl{
+			mu:              sync.Mutex{},
+			state:           message.TxnStateInFlight,
+			opts:            opts,
+			txnCtx:          opts.TxnContext,
+			walAccesserImpl: w,
+		}, nil
+	}
+
 	// Create a new transaction, send the begin txn message.
 	beginTxn, err := message.NewBeginTxnMessageBuilderV2().
 		WithVChannel(opts.VChannel).
@@ -135,6 +135,27 @@ func TestWAL(t *testing.T) {
 	err = txn.Rollback(ctx)
 	assert.NoError(t, err)
 
+	// Test resumed txn.
+	txn, err = w.Txn(ctx, TxnOption{
+		VChannel:  vChannel1,
+		Keepalive: 10 * time.Second,
+	})
+	assert.NoError(t, err)
+	err = txn.Append(ctx, newInsertMessage(vChannel1))
+	assert.NoError(t, err)
+	txnCtx := txn.TxnContext()
+	assert.EqualValues(t, 1, txnCtx.TxnID)
+
+	resumed, err := w.Txn(ctx, TxnOption{
+		VChannel:   vChannel1,
+		TxnContext: &txnCtx,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, txnCtx, resumed.TxnContext())
+	result, err = resumed.Commit(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+
 	resp := w.AppendMessages(ctx,
 		newInsertMessage(vChannel1),
 		newInsertMessage(vChannel2),
@@ -54,6 +54,7 @@ const (
 	RouteSuspendQueryCoordBalance = "/management/querycoord/balance/suspend"
 	RouteResumeQueryCoordBalance  = "/management/querycoord/balance/resume"
 	RouteQueryCoordBalanceStatus  = "/management/querycoord/balance/status"
+	RouteQueryCoordBalancePlan    = "/management/querycoord/balance/plan"
 	RouteTransferSegment          = "/management/querycoord/transfer/segment"
 	RouteTransferChannel          = "/management/querycoord/transfer/channel"
 
@@ -62,6 +63,21 @@ const (
 	RouteListQueryNode              = "/management/querycoord/node/list"
 	RouteGetQueryNodeDistribution   = "/management/querycoord/distribution/get"
 	RouteCheckQueryNodeDistribution = "/management/querycoord/distribution/check"
+
+	RouteDrainStreamingNode       = "/management/streamingcoord/node/drain"
+	RouteUndrainStreamingNode     = "/management/streamingcoord/node/undrain"
+	RouteStreamingNodeDrainStatus = "/management/streamingcoord/node/drain/status"
+
+	RouteListBroadcastTasks   = "/management/streamingcoord/broadcast/task/list"
+	RouteRetryBroadcastTask   = "/management/streamingcoord/broadcast/task/retry"
+	RouteAbandonBroadcastTask = "/management/streamingcoord/broadcast/task/abandon"
+
+	RouteWALRetention = "/management/streamingnode/wal/retention"
+
+	RouteCreateIngestionConnector = "/management/proxy/ingestion/connector/create"
+	RouteDropIngestionConnector   = "/management/proxy/ingestion/connector/drop"
+	RouteListIngestionConnectors  = "/management/proxy/ingestion/connector/list"
+	RouteIngestionConnectorStatus = "/management/proxy/ingestion/connector/status"
 )
 
 // for WebUI restful api root path
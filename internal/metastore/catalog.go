@@ -226,6 +226,16 @@ type StreamingCoordCataLog interface {
 	// Make the task recoverable after restart.
 	// When broadcast task is done, it will be removed from metastore.
 	SaveBroadcastTask(ctx context.Context, broadcastID uint64, task *streamingpb.BroadcastTask) error
+
+	// ListDrainingNode list all streaming nodes drained by operator.
+	// Used to recovery the draining nodes of balancer.
+	ListDrainingNode(ctx context.Context) ([]*streamingpb.StreamingNodeDrainMeta, error)
+
+	// SaveDrainingNode save the drain meta of streaming node to metastore.
+	SaveDrainingNode(ctx context.Context, meta *streamingpb.StreamingNodeDrainMeta) error
+
+	// RemoveDrainingNode remove the drain meta of streaming node from metastore when it's undrained.
+	RemoveDrainingNode(ctx context.Context, serverID int64) error
 }
 
 // StreamingNodeCataLog is the interface for streamingnode catalog
@@ -5,4 +5,5 @@ const (
 	PChannelMetaPrefix  = MetaPrefix + "pchannel/"
 	BroadcastTaskPrefix = MetaPrefix + "broadcast-task/"
 	VersionPrefix       = MetaPrefix + "version/"
+	DrainingNodePrefix  = MetaPrefix + "draining-node/"
 )
@@ -128,6 +128,38 @@ func (c *catalog) SaveBroadcastTask(ctx context.Context, broadcastID uint64, tas
 	return c.metaKV.Save(ctx, key, string(v))
 }
 
+// ListDrainingNode returns all draining streaming nodes
+func (c *catalog) ListDrainingNode(ctx context.Context) ([]*streamingpb.StreamingNodeDrainMeta, error) {
+	keys, values, err := c.metaKV.LoadWithPrefix(ctx, DrainingNodePrefix)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]*streamingpb.StreamingNodeDrainMeta, 0, len(values))
+	for k, value := range values {
+		info := &streamingpb.StreamingNodeDrainMeta{}
+		err = proto.Unmarshal([]byte(value), info)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unmarshal draining node %s failed", keys[k])
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// SaveDrainingNode saves a draining streaming node
+func (c *catalog) SaveDrainingNode(ctx context.Context, meta *streamingpb.StreamingNodeDrainMeta) error {
+	v, err := proto.Marshal(meta)
+	if err != nil {
+		return errors.Wrapf(err, "marshal draining node %d failed", meta.GetServerId())
+	}
+	return c.metaKV.Save(ctx, buildDrainingNodePath(meta.GetServerId()), string(v))
+}
+
+// RemoveDrainingNode removes a draining streaming node
+func (c *catalog) RemoveDrainingNode(ctx context.Context, serverID int64) error {
+	return c.metaKV.Remove(ctx, buildDrainingNodePath(serverID))
+}
+
 // buildPChannelInfoPath builds the path for pchannel info.
 func buildPChannelInfoPath(name string) string {
 	return PChannelMetaPrefix + name
@@ -137,3 +169,8 @@ func buildPChannelInfoPath(name string) string {
 func buildBroadcastTaskPath(id uint64) string {
 	return BroadcastTaskPrefix + strconv.FormatUint(id, 10)
 }
+
+// buildDrainingNodePath builds the path for draining streaming node.
+func buildDrainingNodePath(serverID int64) string {
+	return DrainingNodePrefix + strconv.FormatInt(serverID, 10)
+}
@@ -106,6 +106,23 @@ func TestCatalog(t *testing.T) {
 		assert.Equal(t, streamingpb.BroadcastTaskState_BROADCAST_TASK_STATE_PENDING, task.State)
 	}
 
+	// DrainingNode test
+	err = catalog.SaveDrainingNode(context.Background(), &streamingpb.StreamingNodeDrainMeta{ServerId: 1, TotalChannels: 2})
+	assert.NoError(t, err)
+	err = catalog.SaveDrainingNode(context.Background(), &streamingpb.StreamingNodeDrainMeta{ServerId: 2, TotalChannels: 1})
+	assert.NoError(t, err)
+	nodes, err := catalog.ListDrainingNode(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, nodes, 2)
+
+	err = catalog.RemoveDrainingNode(context.Background(), 1)
+	assert.NoError(t, err)
+	nodes, err = catalog.ListDrainingNode(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, nodes, 1)
+	assert.Equal(t, int64(2), nodes[0].GetServerId())
+	assert.Equal(t, int64(1), nodes[0].GetTotalChannels())
+
 	// error path.
 	kv.EXPECT().LoadWithPrefix(mock.Anything, mock.Anything).Unset()
 	kv.EXPECT().LoadWithPrefix(mock.Anything, mock.Anything).Return(nil, nil, errors.New("load error"))
@@ -117,6 +134,10 @@ func TestCatalog(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, tasks)
 
+	nodes, err = catalog.ListDrainingNode(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, nodes)
+
 	kv.EXPECT().MultiSave(mock.Anything, mock.Anything).Unset()
 	kv.EXPECT().MultiSave(mock.Anything, mock.Anything).Return(errors.New("save error"))
 	kv.EXPECT().Save(mock.Anything, mock.Anything, mock.Anything).Unset()
@@ -128,4 +149,6 @@ func TestCatalog(t *testing.T) {
 	assert.Error(t, err)
 	err = catalog.SaveBroadcastTask(context.Background(), 1, &streamingpb.BroadcastTask{})
 	assert.Error(t, err)
+	err = catalog.SaveDrainingNode(context.Background(), &streamingpb.StreamingNodeDrainMeta{ServerId: 1})
+	assert.Error(t, err)
 }
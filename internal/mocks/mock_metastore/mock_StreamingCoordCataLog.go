@@ -139,6 +139,64 @@ func (_c *MockStreamingCoordCataLog_ListBroadcastTask_Call) RunAndReturn(run fun
 	return _c
 }
 
+// ListDrainingNode provides a mock function with given fields: ctx
+func (_m *MockStreamingCoordCataLog) ListDrainingNode(ctx context.Context) ([]*streamingpb.StreamingNodeDrainMeta, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDrainingNode")
+	}
+
+	var r0 []*streamingpb.StreamingNodeDrainMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*streamingpb.StreamingNodeDrainMeta, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*streamingpb.StreamingNodeDrainMeta); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*streamingpb.StreamingNodeDrainMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordCataLog_ListDrainingNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDrainingNode'
+type MockStreamingCoordCataLog_ListDrainingNode_Call struct {
+	*mock.Call
+}
+
+// ListDrainingNode is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStreamingCoordCataLog_Expecter) ListDrainingNode(ctx interface{}) *MockStreamingCoordCataLog_ListDrainingNode_Call {
+	return &MockStreamingCoordCataLog_ListDrainingNode_Call{Call: _e.mock.On("ListDrainingNode", ctx)}
+}
+
+func (_c *MockStreamingCoordCataLog_ListDrainingNode_Call) Run(run func(ctx context.Context)) *MockStreamingCoordCataLog_ListDrainingNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_ListDrainingNode_Call) Return(_a0 []*streamingpb.StreamingNodeDrainMeta, _a1 error) *MockStreamingCoordCataLog_ListDrainingNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_ListDrainingNode_Call) RunAndReturn(run func(context.Context) ([]*streamingpb.StreamingNodeDrainMeta, error)) *MockStreamingCoordCataLog_ListDrainingNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListPChannel provides a mock function with given fields: ctx
 func (_m *MockStreamingCoordCataLog) ListPChannel(ctx context.Context) ([]*streamingpb.PChannelMeta, error) {
 	ret := _m.Called(ctx)
@@ -197,6 +255,53 @@ func (_c *MockStreamingCoordCataLog_ListPChannel_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// RemoveDrainingNode provides a mock function with given fields: ctx, serverID
+func (_m *MockStreamingCoordCataLog) RemoveDrainingNode(ctx context.Context, serverID int64) error {
+	ret := _m.Called(ctx, serverID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveDrainingNode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, serverID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_RemoveDrainingNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveDrainingNode'
+type MockStreamingCoordCataLog_RemoveDrainingNode_Call struct {
+	*mock.Call
+}
+
+// RemoveDrainingNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serverID int64
+func (_e *MockStreamingCoordCataLog_Expecter) RemoveDrainingNode(ctx interface{}, serverID interface{}) *MockStreamingCoordCataLog_RemoveDrainingNode_Call {
+	return &MockStreamingCoordCataLog_RemoveDrainingNode_Call{Call: _e.mock.On("RemoveDrainingNode", ctx, serverID)}
+}
+
+func (_c *MockStreamingCoordCataLog_RemoveDrainingNode_Call) Run(run func(ctx context.Context, serverID int64)) *MockStreamingCoordCataLog_RemoveDrainingNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_RemoveDrainingNode_Call) Return(_a0 error) *MockStreamingCoordCataLog_RemoveDrainingNode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_RemoveDrainingNode_Call) RunAndReturn(run func(context.Context, int64) error) *MockStreamingCoordCataLog_RemoveDrainingNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveBroadcastTask provides a mock function with given fields: ctx, broadcastID, task
 func (_m *MockStreamingCoordCataLog) SaveBroadcastTask(ctx context.Context, broadcastID uint64, task *streamingpb.BroadcastTask) error {
 	ret := _m.Called(ctx, broadcastID, task)
@@ -245,6 +350,53 @@ func (_c *MockStreamingCoordCataLog_SaveBroadcastTask_Call) RunAndReturn(run fun
 	return _c
 }
 
+// SaveDrainingNode provides a mock function with given fields: ctx, meta
+func (_m *MockStreamingCoordCataLog) SaveDrainingNode(ctx context.Context, meta *streamingpb.StreamingNodeDrainMeta) error {
+	ret := _m.Called(ctx, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveDrainingNode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.StreamingNodeDrainMeta) error); ok {
+		r0 = rf(ctx, meta)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_SaveDrainingNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDrainingNode'
+type MockStreamingCoordCataLog_SaveDrainingNode_Call struct {
+	*mock.Call
+}
+
+// SaveDrainingNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - meta *streamingpb.StreamingNodeDrainMeta
+func (_e *MockStreamingCoordCataLog_Expecter) SaveDrainingNode(ctx interface{}, meta interface{}) *MockStreamingCoordCataLog_SaveDrainingNode_Call {
+	return &MockStreamingCoordCataLog_SaveDrainingNode_Call{Call: _e.mock.On("SaveDrainingNode", ctx, meta)}
+}
+
+func (_c *MockStreamingCoordCataLog_SaveDrainingNode_Call) Run(run func(ctx context.Context, meta *streamingpb.StreamingNodeDrainMeta)) *MockStreamingCoordCataLog_SaveDrainingNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.StreamingNodeDrainMeta))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveDrainingNode_Call) Return(_a0 error) *MockStreamingCoordCataLog_SaveDrainingNode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveDrainingNode_Call) RunAndReturn(run func(context.Context, *streamingpb.StreamingNodeDrainMeta) error) *MockStreamingCoordCataLog_SaveDrainingNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SavePChannels provides a mock function with given fields: ctx, info
 func (_m *MockStreamingCoordCataLog) SavePChannels(ctx context.Context, info []*streamingpb.PChannelMeta) error {
 	ret := _m.Called(ctx, info)
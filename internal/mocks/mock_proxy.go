@@ -565,6 +565,65 @@ func (_c *MockProxy_BackupRBAC_Call) RunAndReturn(run func(context.Context, *mil
 	return _c
 }
 
+// BeginTransaction provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) BeginTransaction(_a0 context.Context, _a1 *milvuspb.BeginTransactionRequest) (*milvuspb.BeginTransactionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginTransaction")
+	}
+
+	var r0 *milvuspb.BeginTransactionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.BeginTransactionRequest) (*milvuspb.BeginTransactionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.BeginTransactionRequest) *milvuspb.BeginTransactionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.BeginTransactionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.BeginTransactionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_BeginTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginTransaction'
+type MockProxy_BeginTransaction_Call struct {
+	*mock.Call
+}
+
+// BeginTransaction is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.BeginTransactionRequest
+func (_e *MockProxy_Expecter) BeginTransaction(_a0 interface{}, _a1 interface{}) *MockProxy_BeginTransaction_Call {
+	return &MockProxy_BeginTransaction_Call{Call: _e.mock.On("BeginTransaction", _a0, _a1)}
+}
+
+func (_c *MockProxy_BeginTransaction_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.BeginTransactionRequest)) *MockProxy_BeginTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.BeginTransactionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_BeginTransaction_Call) Return(_a0 *milvuspb.BeginTransactionResponse, _a1 error) *MockProxy_BeginTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_BeginTransaction_Call) RunAndReturn(run func(context.Context, *milvuspb.BeginTransactionRequest) (*milvuspb.BeginTransactionResponse, error)) *MockProxy_BeginTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CalcDistance provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CalcDistance(_a0 context.Context, _a1 *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	ret := _m.Called(_a0, _a1)
@@ -683,6 +742,65 @@ func (_c *MockProxy_CheckHealth_Call) RunAndReturn(run func(context.Context, *mi
 	return _c
 }
 
+// CommitTransaction provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CommitTransaction(_a0 context.Context, _a1 *milvuspb.CommitTransactionRequest) (*milvuspb.CommitTransactionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitTransaction")
+	}
+
+	var r0 *milvuspb.CommitTransactionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CommitTransactionRequest) (*milvuspb.CommitTransactionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CommitTransactionRequest) *milvuspb.CommitTransactionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.CommitTransactionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CommitTransactionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CommitTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitTransaction'
+type MockProxy_CommitTransaction_Call struct {
+	*mock.Call
+}
+
+// CommitTransaction is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.CommitTransactionRequest
+func (_e *MockProxy_Expecter) CommitTransaction(_a0 interface{}, _a1 interface{}) *MockProxy_CommitTransaction_Call {
+	return &MockProxy_CommitTransaction_Call{Call: _e.mock.On("CommitTransaction", _a0, _a1)}
+}
+
+func (_c *MockProxy_CommitTransaction_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.CommitTransactionRequest)) *MockProxy_CommitTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.CommitTransactionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CommitTransaction_Call) Return(_a0 *milvuspb.CommitTransactionResponse, _a1 error) *MockProxy_CommitTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CommitTransaction_Call) RunAndReturn(run func(context.Context, *milvuspb.CommitTransactionRequest) (*milvuspb.CommitTransactionResponse, error)) *MockProxy_CommitTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Connect provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Connect(_a0 context.Context, _a1 *milvuspb.ConnectRequest) (*milvuspb.ConnectResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -6362,6 +6480,65 @@ func (_c *MockProxy_RestoreRBAC_Call) RunAndReturn(run func(context.Context, *mi
 	return _c
 }
 
+// RollbackTransaction provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) RollbackTransaction(_a0 context.Context, _a1 *milvuspb.RollbackTransactionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackTransaction")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.RollbackTransactionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.RollbackTransactionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.RollbackTransactionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_RollbackTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackTransaction'
+type MockProxy_RollbackTransaction_Call struct {
+	*mock.Call
+}
+
+// RollbackTransaction is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.RollbackTransactionRequest
+func (_e *MockProxy_Expecter) RollbackTransaction(_a0 interface{}, _a1 interface{}) *MockProxy_RollbackTransaction_Call {
+	return &MockProxy_RollbackTransaction_Call{Call: _e.mock.On("RollbackTransaction", _a0, _a1)}
+}
+
+func (_c *MockProxy_RollbackTransaction_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.RollbackTransactionRequest)) *MockProxy_RollbackTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.RollbackTransactionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_RollbackTransaction_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_RollbackTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_RollbackTransaction_Call) RunAndReturn(run func(context.Context, *milvuspb.RollbackTransactionRequest) (*commonpb.Status, error)) *MockProxy_RollbackTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunAnalyzer provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) RunAnalyzer(_a0 context.Context, _a1 *milvuspb.RunAnalyzerRequest) (*milvuspb.RunAnalyzerResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -7056,6 +7233,53 @@ func (_c *MockProxy_Stop_Call) RunAndReturn(run func() error) *MockProxy_Stop_Ca
 	return _c
 }
 
+// SubscribeChangeStream provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) SubscribeChangeStream(_a0 *milvuspb.SubscribeChangeStreamRequest, _a1 milvuspb.MilvusService_SubscribeChangeStreamServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeChangeStream")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*milvuspb.SubscribeChangeStreamRequest, milvuspb.MilvusService_SubscribeChangeStreamServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxy_SubscribeChangeStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeChangeStream'
+type MockProxy_SubscribeChangeStream_Call struct {
+	*mock.Call
+}
+
+// SubscribeChangeStream is a helper method to define mock.On call
+//   - _a0 *milvuspb.SubscribeChangeStreamRequest
+//   - _a1 milvuspb.MilvusService_SubscribeChangeStreamServer
+func (_e *MockProxy_Expecter) SubscribeChangeStream(_a0 interface{}, _a1 interface{}) *MockProxy_SubscribeChangeStream_Call {
+	return &MockProxy_SubscribeChangeStream_Call{Call: _e.mock.On("SubscribeChangeStream", _a0, _a1)}
+}
+
+func (_c *MockProxy_SubscribeChangeStream_Call) Run(run func(_a0 *milvuspb.SubscribeChangeStreamRequest, _a1 milvuspb.MilvusService_SubscribeChangeStreamServer)) *MockProxy_SubscribeChangeStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*milvuspb.SubscribeChangeStreamRequest), args[1].(milvuspb.MilvusService_SubscribeChangeStreamServer))
+	})
+	return _c
+}
+
+func (_c *MockProxy_SubscribeChangeStream_Call) Return(_a0 error) *MockProxy_SubscribeChangeStream_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxy_SubscribeChangeStream_Call) RunAndReturn(run func(*milvuspb.SubscribeChangeStreamRequest, milvuspb.MilvusService_SubscribeChangeStreamServer) error) *MockProxy_SubscribeChangeStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TransferNode provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) TransferNode(_a0 context.Context, _a1 *milvuspb.TransferNodeRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
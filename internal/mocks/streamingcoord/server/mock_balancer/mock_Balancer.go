@@ -3,6 +3,8 @@
 package mock_balancer
 
 import (
+	balancer "github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+
 	context "context"
 
 	syncutil "github.com/milvus-io/milvus/pkg/v2/util/syncutil"
@@ -58,6 +60,111 @@ func (_c *MockBalancer_Close_Call) RunAndReturn(run func()) *MockBalancer_Close_
 	return _c
 }
 
+// DrainStreamingNode provides a mock function with given fields: ctx, serverID
+func (_m *MockBalancer) DrainStreamingNode(ctx context.Context, serverID int64) error {
+	ret := _m.Called(ctx, serverID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DrainStreamingNode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, serverID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBalancer_DrainStreamingNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrainStreamingNode'
+type MockBalancer_DrainStreamingNode_Call struct {
+	*mock.Call
+}
+
+// DrainStreamingNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serverID int64
+func (_e *MockBalancer_Expecter) DrainStreamingNode(ctx interface{}, serverID interface{}) *MockBalancer_DrainStreamingNode_Call {
+	return &MockBalancer_DrainStreamingNode_Call{Call: _e.mock.On("DrainStreamingNode", ctx, serverID)}
+}
+
+func (_c *MockBalancer_DrainStreamingNode_Call) Run(run func(ctx context.Context, serverID int64)) *MockBalancer_DrainStreamingNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_DrainStreamingNode_Call) Return(_a0 error) *MockBalancer_DrainStreamingNode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBalancer_DrainStreamingNode_Call) RunAndReturn(run func(context.Context, int64) error) *MockBalancer_DrainStreamingNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDrainStatus provides a mock function with given fields: ctx
+func (_m *MockBalancer) GetDrainStatus(ctx context.Context) ([]balancer.DrainStatus, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDrainStatus")
+	}
+
+	var r0 []balancer.DrainStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]balancer.DrainStatus, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []balancer.DrainStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]balancer.DrainStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_GetDrainStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDrainStatus'
+type MockBalancer_GetDrainStatus_Call struct {
+	*mock.Call
+}
+
+// GetDrainStatus is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockBalancer_Expecter) GetDrainStatus(ctx interface{}) *MockBalancer_GetDrainStatus_Call {
+	return &MockBalancer_GetDrainStatus_Call{Call: _e.mock.On("GetDrainStatus", ctx)}
+}
+
+func (_c *MockBalancer_GetDrainStatus_Call) Run(run func(ctx context.Context)) *MockBalancer_GetDrainStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_GetDrainStatus_Call) Return(_a0 []balancer.DrainStatus, _a1 error) *MockBalancer_GetDrainStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_GetDrainStatus_Call) RunAndReturn(run func(context.Context) ([]balancer.DrainStatus, error)) *MockBalancer_GetDrainStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLatestWALLocated provides a mock function with given fields: ctx, pchannel
 func (_m *MockBalancer) GetLatestWALLocated(ctx context.Context, pchannel string) (int64, bool) {
 	ret := _m.Called(ctx, pchannel)
@@ -241,6 +348,53 @@ func (_c *MockBalancer_Trigger_Call) RunAndReturn(run func(context.Context) erro
 	return _c
 }
 
+// UndrainStreamingNode provides a mock function with given fields: ctx, serverID
+func (_m *MockBalancer) UndrainStreamingNode(ctx context.Context, serverID int64) error {
+	ret := _m.Called(ctx, serverID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UndrainStreamingNode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, serverID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBalancer_UndrainStreamingNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndrainStreamingNode'
+type MockBalancer_UndrainStreamingNode_Call struct {
+	*mock.Call
+}
+
+// UndrainStreamingNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serverID int64
+func (_e *MockBalancer_Expecter) UndrainStreamingNode(ctx interface{}, serverID interface{}) *MockBalancer_UndrainStreamingNode_Call {
+	return &MockBalancer_UndrainStreamingNode_Call{Call: _e.mock.On("UndrainStreamingNode", ctx, serverID)}
+}
+
+func (_c *MockBalancer_UndrainStreamingNode_Call) Run(run func(ctx context.Context, serverID int64)) *MockBalancer_UndrainStreamingNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_UndrainStreamingNode_Call) Return(_a0 error) *MockBalancer_UndrainStreamingNode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBalancer_UndrainStreamingNode_Call) RunAndReturn(run func(context.Context, int64) error) *MockBalancer_UndrainStreamingNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WatchChannelAssignments provides a mock function with given fields: ctx, cb
 func (_m *MockBalancer) WatchChannelAssignments(ctx context.Context, cb func(typeutil.VersionInt64Pair, []types.PChannelInfoAssigned) error) error {
 	ret := _m.Called(ctx, cb)
@@ -148,9 +148,9 @@ func (_c *MockRecoveryStorage_ObserveMessage_Call) RunAndReturn(run func(context
 	return _c
 }
 
-// UpdateFlusherCheckpoint provides a mock function with given fields: checkpoint
-func (_m *MockRecoveryStorage) UpdateFlusherCheckpoint(checkpoint *recovery.WALCheckpoint) {
-	_m.Called(checkpoint)
+// UpdateFlusherCheckpoint provides a mock function with given fields: vchannel, checkpoint
+func (_m *MockRecoveryStorage) UpdateFlusherCheckpoint(vchannel string, checkpoint *recovery.WALCheckpoint) {
+	_m.Called(vchannel, checkpoint)
 }
 
 // MockRecoveryStorage_UpdateFlusherCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFlusherCheckpoint'
@@ -159,14 +159,15 @@ type MockRecoveryStorage_UpdateFlusherCheckpoint_Call struct {
 }
 
 // UpdateFlusherCheckpoint is a helper method to define mock.On call
+//   - vchannel string
 //   - checkpoint *recovery.WALCheckpoint
-func (_e *MockRecoveryStorage_Expecter) UpdateFlusherCheckpoint(checkpoint interface{}) *MockRecoveryStorage_UpdateFlusherCheckpoint_Call {
-	return &MockRecoveryStorage_UpdateFlusherCheckpoint_Call{Call: _e.mock.On("UpdateFlusherCheckpoint", checkpoint)}
+func (_e *MockRecoveryStorage_Expecter) UpdateFlusherCheckpoint(vchannel interface{}, checkpoint interface{}) *MockRecoveryStorage_UpdateFlusherCheckpoint_Call {
+	return &MockRecoveryStorage_UpdateFlusherCheckpoint_Call{Call: _e.mock.On("UpdateFlusherCheckpoint", vchannel, checkpoint)}
 }
 
-func (_c *MockRecoveryStorage_UpdateFlusherCheckpoint_Call) Run(run func(checkpoint *recovery.WALCheckpoint)) *MockRecoveryStorage_UpdateFlusherCheckpoint_Call {
+func (_c *MockRecoveryStorage_UpdateFlusherCheckpoint_Call) Run(run func(vchannel string, checkpoint *recovery.WALCheckpoint)) *MockRecoveryStorage_UpdateFlusherCheckpoint_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*recovery.WALCheckpoint))
+		run(args[0].(string), args[1].(*recovery.WALCheckpoint))
 	})
 	return _c
 }
@@ -176,7 +177,7 @@ func (_c *MockRecoveryStorage_UpdateFlusherCheckpoint_Call) Return() *MockRecove
 	return _c
 }
 
-func (_c *MockRecoveryStorage_UpdateFlusherCheckpoint_Call) RunAndReturn(run func(*recovery.WALCheckpoint)) *MockRecoveryStorage_UpdateFlusherCheckpoint_Call {
+func (_c *MockRecoveryStorage_UpdateFlusherCheckpoint_Call) RunAndReturn(run func(string, *recovery.WALCheckpoint)) *MockRecoveryStorage_UpdateFlusherCheckpoint_Call {
 	_c.Run(run)
 	return _c
 }
@@ -76,6 +76,12 @@ func handleInternal(exprStr string) (ast planparserv2.IExprContext, err error) {
 	return
 }
 
+// ParseExprAST parses the expression into the syntax tree without translating it into a plan,
+// it's used to evaluate the expressions out of segcore, e.g. the arithmetic formula of rerank functions.
+func ParseExprAST(exprStr string) (planparserv2.IExprContext, error) {
+	return handleInternal(exprStr)
+}
+
 func handleExpr(schema *typeutil.SchemaHelper, exprStr string) (result interface{}) {
 	defer func() {
 		if r := recover(); r != nil {
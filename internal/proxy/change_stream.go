@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/proxy/changestream"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// SubscribeChangeStream sends the change events of the collection to the grpc stream one by one,
+// the stream is finished by an event carrying the status of the error which stops the subscription.
+func (node *Proxy) SubscribeChangeStream(req *milvuspb.SubscribeChangeStreamRequest, stream milvuspb.MilvusService_SubscribeChangeStreamServer) error {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(stream.Context(), "Proxy-SubscribeChangeStream")
+	defer sp.End()
+	log := log.Ctx(ctx).With(
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()))
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return stream.Send(&milvuspb.ChangeStreamEvent{Status: merr.Status(err)})
+	}
+
+	subscription, err := SubscribeChangeStream(ctx, req.GetDbName(), req.GetCollectionName(), req.GetCheckpoint())
+	if err != nil {
+		log.Warn("fail to subscribe change stream", zap.Error(err))
+		return stream.Send(&milvuspb.ChangeStreamEvent{Status: merr.Status(err)})
+	}
+	defer subscription.Close()
+
+	log.Info("change stream is subscribed", zap.String("checkpoint", req.GetCheckpoint()))
+	for event := range subscription.Events() {
+		resp := event.ToProto()
+		resp.Status = merr.Success()
+		if err := stream.Send(resp); err != nil {
+			log.Info("change stream is closed by client", zap.Error(err))
+			return err
+		}
+	}
+	err = subscription.Error()
+	log.Info("change stream is finished", zap.Error(err))
+	return stream.Send(&milvuspb.ChangeStreamEvent{Status: merr.Status(err)})
+}
+
+// SubscribeChangeStream subscribes the row level change events of the collection from the streaming wal,
+// the subscription starts from the latest message if checkpoint is empty.
+func SubscribeChangeStream(ctx context.Context, dbName string, collectionName string, checkpoint string) (*changestream.Subscription, error) {
+	if !streamingutil.IsStreamingServiceEnabled() {
+		return nil, merr.WrapErrServiceUnavailable("change stream requires the streaming service")
+	}
+	if globalMetaCache == nil {
+		return nil, merr.WrapErrServiceNotReady(paramtable.GetRole(), paramtable.GetNodeID(), "initialization")
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	return changestream.Subscribe(ctx, streaming.WAL(), changestream.SubscribeOption{
+		CollectionName: collectionName,
+		Schema:         collection.schema.CollectionSchema,
+		VChannels:      collection.vChannels,
+		Checkpoint:     checkpoint,
+	})
+}
@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+type changeStreamServerForTest struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*milvuspb.ChangeStreamEvent
+}
+
+func (s *changeStreamServerForTest) Context() context.Context {
+	return s.ctx
+}
+
+func (s *changeStreamServerForTest) Send(event *milvuspb.ChangeStreamEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestProxy_SubscribeChangeStream(t *testing.T) {
+	req := &milvuspb.SubscribeChangeStreamRequest{
+		DbName:         "default",
+		CollectionName: "test",
+	}
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		stream := &changeStreamServerForTest{ctx: context.Background()}
+		err := node.SubscribeChangeStream(req, stream)
+		assert.NoError(t, err)
+		assert.Len(t, stream.events, 1)
+		assert.ErrorIs(t, merr.Error(stream.events[0].GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	t.Run("streaming service disabled", func(t *testing.T) {
+		streamingutil.UnsetStreamingServiceEnabled()
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		stream := &changeStreamServerForTest{ctx: context.Background()}
+		err := node.SubscribeChangeStream(req, stream)
+		assert.NoError(t, err)
+		assert.Len(t, stream.events, 1)
+		assert.ErrorIs(t, merr.Error(stream.events[0].GetStatus()), merr.ErrServiceUnavailable)
+	})
+}
@@ -0,0 +1,90 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changestream
+
+import (
+	"encoding/base64"
+
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// Checkpoint records the last delivered message of every vchannel of a subscription,
+// it's encoded into an opaque string for users to resume the subscription.
+type Checkpoint struct {
+	WALName string `json:"wal"`
+	// Positions maps the vchannel to the marshaled id of the last delivered message.
+	Positions map[string]string `json:"positions"`
+}
+
+// NewCheckpoint creates an empty checkpoint of the wal.
+func NewCheckpoint(walName string) *Checkpoint {
+	return &Checkpoint{
+		WALName:   walName,
+		Positions: make(map[string]string),
+	}
+}
+
+// DecodeCheckpoint parses the checkpoint, the wal of checkpoint must be the same as the running one.
+func DecodeCheckpoint(walName string, s string) (*Checkpoint, error) {
+	if s == "" {
+		return NewCheckpoint(walName), nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid checkpoint: %s", err.Error())
+	}
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid checkpoint: %s", err.Error())
+	}
+	if checkpoint.WALName != walName {
+		return nil, merr.WrapErrParameterInvalidMsg("checkpoint of wal %s cannot be used by wal %s", checkpoint.WALName, walName)
+	}
+	if checkpoint.Positions == nil {
+		checkpoint.Positions = make(map[string]string)
+	}
+	return checkpoint, nil
+}
+
+// Encode encodes the checkpoint into an opaque string.
+func (c *Checkpoint) Encode() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// MessageID returns the last delivered message id of the vchannel, nil if not delivered yet.
+func (c *Checkpoint) MessageID(vchannel string) (message.MessageID, error) {
+	position, ok := c.Positions[vchannel]
+	if !ok {
+		return nil, nil
+	}
+	id, err := message.UnmarshalMessageID(c.WALName, position)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid checkpoint of vchannel %s: %s", vchannel, err.Error())
+	}
+	return id, nil
+}
+
+// Advance moves the position of the vchannel to the message id.
+func (c *Checkpoint) Advance(vchannel string, id message.MessageID) {
+	c.Positions[vchannel] = id.Marshal()
+}
@@ -0,0 +1,291 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changestream
+
+import (
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// EventType is the type of change event.
+type EventType string
+
+const (
+	EventTypeInsert EventType = "insert"
+	EventTypeDelete EventType = "delete"
+	EventTypeDDL    EventType = "ddl"
+)
+
+// Event is a row level change event of the collection.
+type Event struct {
+	Type       EventType      `json:"type"`
+	Collection string         `json:"collection"`
+	Partition  string         `json:"partition,omitempty"`
+	VChannel   string         `json:"vchannel"`
+	Timestamp  uint64         `json:"timestamp"`
+	PrimaryKey any            `json:"primary_key,omitempty"`
+	Fields     map[string]any `json:"fields,omitempty"`
+	// DDL is the message type of ddl event, e.g. DropPartition.
+	DDL string `json:"ddl,omitempty"`
+	// Checkpoint is the position to resume the subscription after this event.
+	Checkpoint string `json:"checkpoint"`
+
+	// fieldsData and row locate the inserted row in the message,
+	// they're kept to encode the event into protobuf without losing the field types.
+	fieldsData []*schemapb.FieldData
+	row        int
+}
+
+var eventTypes = map[EventType]milvuspb.ChangeEventType{
+	EventTypeInsert: milvuspb.ChangeEventType_ChangeEventInsert,
+	EventTypeDelete: milvuspb.ChangeEventType_ChangeEventDelete,
+	EventTypeDDL:    milvuspb.ChangeEventType_ChangeEventDDL,
+}
+
+// ToProto encodes the event into the protobuf message sent by the grpc change stream.
+func (e *Event) ToProto() *milvuspb.ChangeStreamEvent {
+	event := &milvuspb.ChangeStreamEvent{
+		Type:           eventTypes[e.Type],
+		CollectionName: e.Collection,
+		PartitionName:  e.Partition,
+		Vchannel:       e.VChannel,
+		Timestamp:      e.Timestamp,
+		PrimaryKey:     primaryKeyIDs(e.PrimaryKey),
+		Ddl:            e.DDL,
+		Checkpoint:     e.Checkpoint,
+	}
+	if len(e.fieldsData) > 0 {
+		event.FieldsData = make([]*schemapb.FieldData, len(e.fieldsData))
+		typeutil.AppendFieldData(event.FieldsData, e.fieldsData, int64(e.row))
+	}
+	return event
+}
+
+func primaryKeyIDs(pk any) *schemapb.IDs {
+	switch pk := pk.(type) {
+	case int64:
+		return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{pk}}}}
+	case string:
+		return &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{pk}}}}
+	default:
+		return nil
+	}
+}
+
+// messageTypes are the message types which are decoded into change events.
+var messageTypes = []message.MessageType{
+	message.MessageTypeInsert,
+	message.MessageTypeDelete,
+	message.MessageTypeDropCollection,
+	message.MessageTypeCreatePartition,
+	message.MessageTypeDropPartition,
+	message.MessageTypeSchemaChange,
+	message.MessageTypeTxn,
+}
+
+// decoder decodes the wal messages into the row level change events.
+type decoder struct {
+	collectionName string
+	schema         *schemapb.CollectionSchema
+	pkField        *schemapb.FieldSchema
+}
+
+func newDecoder(collectionName string, schema *schemapb.CollectionSchema) (*decoder, error) {
+	d := &decoder{collectionName: collectionName}
+	if err := d.setSchema(schema); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *decoder) setSchema(schema *schemapb.CollectionSchema) error {
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return err
+	}
+	d.schema = schema
+	d.pkField = pkField
+	return nil
+}
+
+// decode decodes the message, returns nil if the message is not a change of the collection.
+func (d *decoder) decode(msg message.ImmutableMessage) ([]*Event, error) {
+	switch msg.MessageType() {
+	case message.MessageTypeInsert:
+		return d.decodeInsert(msg)
+	case message.MessageTypeDelete:
+		return d.decodeDelete(msg)
+	case message.MessageTypeTxn:
+		events := make([]*Event, 0)
+		err := message.AsImmutableTxnMessage(msg).RangeOver(func(im message.ImmutableMessage) error {
+			txnEvents, err := d.decode(im)
+			if err != nil {
+				return err
+			}
+			events = append(events, txnEvents...)
+			return nil
+		})
+		return events, err
+	case message.MessageTypeSchemaChange:
+		schemaChangeMsg, err := message.AsImmutableCollectionSchemaChangeV2(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := schemaChangeMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		// the following inserts are encoded with the new schema
+		if err := d.setSchema(body.GetSchema()); err != nil {
+			return nil, err
+		}
+		return []*Event{d.newDDLEvent(msg)}, nil
+	case message.MessageTypeDropCollection, message.MessageTypeCreatePartition, message.MessageTypeDropPartition:
+		return []*Event{d.newDDLEvent(msg)}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (d *decoder) decodeInsert(msg message.ImmutableMessage) ([]*Event, error) {
+	insertMsg, err := message.AsImmutableInsertMessageV1(msg)
+	if err != nil {
+		return nil, err
+	}
+	body, err := insertMsg.Body()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(body.GetFieldsData()))
+	iterators := make([]func(int) any, 0, len(body.GetFieldsData()))
+	for _, field := range body.GetFieldsData() {
+		names = append(names, field.GetFieldName())
+		iterators = append(iterators, fieldIterator(field))
+	}
+
+	events := make([]*Event, 0, body.GetNumRows())
+	for i := 0; i < int(body.GetNumRows()); i++ {
+		fields := make(map[string]any, len(names))
+		for j, name := range names {
+			fields[name] = iterators[j](i)
+		}
+		events = append(events, &Event{
+			Type:       EventTypeInsert,
+			Collection: d.collectionName,
+			Partition:  body.GetPartitionName(),
+			VChannel:   msg.VChannel(),
+			Timestamp:  msg.TimeTick(),
+			PrimaryKey: fields[d.pkField.GetName()],
+			Fields:     fields,
+			fieldsData: body.GetFieldsData(),
+			row:        i,
+		})
+	}
+	return events, nil
+}
+
+func (d *decoder) decodeDelete(msg message.ImmutableMessage) ([]*Event, error) {
+	deleteMsg, err := message.AsImmutableDeleteMessageV1(msg)
+	if err != nil {
+		return nil, err
+	}
+	body, err := deleteMsg.Body()
+	if err != nil {
+		return nil, err
+	}
+
+	size := typeutil.GetSizeOfIDs(body.GetPrimaryKeys())
+	events := make([]*Event, 0, size)
+	for i := 0; i < size; i++ {
+		events = append(events, &Event{
+			Type:       EventTypeDelete,
+			Collection: d.collectionName,
+			Partition:  body.GetPartitionName(),
+			VChannel:   msg.VChannel(),
+			Timestamp:  msg.TimeTick(),
+			PrimaryKey: typeutil.GetPK(body.GetPrimaryKeys(), int64(i)),
+		})
+	}
+	return events, nil
+}
+
+func (d *decoder) newDDLEvent(msg message.ImmutableMessage) *Event {
+	return &Event{
+		Type:       EventTypeDDL,
+		Collection: d.collectionName,
+		VChannel:   msg.VChannel(),
+		Timestamp:  msg.TimeTick(),
+		DDL:        msg.MessageType().String(),
+	}
+}
+
+// fieldIterator returns the row value getter of the field data,
+// json and array which are not covered by typeutil.GetDataIterator are handled here.
+// The null values are filled by proxy before the insert is written into wal,
+// so the data of nullable field is aligned with the rows.
+func fieldIterator(field *schemapb.FieldData) func(int) any {
+	var getter func(int) any
+	switch field.GetType() {
+	case schemapb.DataType_JSON:
+		getter = func(idx int) any {
+			return json.RawMessage(field.GetScalars().GetJsonData().GetData()[idx])
+		}
+	case schemapb.DataType_Array:
+		getter = func(idx int) any {
+			return arrayValue(field.GetScalars().GetArrayData().GetData()[idx])
+		}
+	default:
+		// typeutil.GetDataIterator treats the data of nullable field as compacted valid rows
+		getter = typeutil.GetDataIterator(&schemapb.FieldData{
+			Type:  field.GetType(),
+			Field: field.GetField(),
+		})
+	}
+
+	validData := field.GetValidData()
+	if validData == nil {
+		return getter
+	}
+	return func(idx int) any {
+		if !validData[idx] {
+			return nil
+		}
+		return getter(idx)
+	}
+}
+
+func arrayValue(field *schemapb.ScalarField) any {
+	switch data := field.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		return data.BoolData.GetData()
+	case *schemapb.ScalarField_IntData:
+		return data.IntData.GetData()
+	case *schemapb.ScalarField_LongData:
+		return data.LongData.GetData()
+	case *schemapb.ScalarField_FloatData:
+		return data.FloatData.GetData()
+	case *schemapb.ScalarField_DoubleData:
+		return data.DoubleData.GetData()
+	case *schemapb.ScalarField_StringData:
+		return data.StringData.GetData()
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changestream
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/options"
+)
+
+// SubscribeOption is the option to subscribe the change events of a collection.
+type SubscribeOption struct {
+	CollectionName string
+	Schema         *schemapb.CollectionSchema
+	VChannels      []string
+	// Checkpoint is the opaque position returned by the previous subscription,
+	// the subscription starts from the latest message if it's empty.
+	Checkpoint string
+}
+
+// Subscription follows the change events of a collection from the streaming wal.
+// The events are delivered at least once, a subscription resumed from the checkpoint of an event
+// may receive the events which are decoded from the same message again.
+type Subscription struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	// decoders keeps a decoder per vchannel, the schema change is applied on every vchannel
+	// at its own position, so the decoding schema of one vchannel must not be shared with the others.
+	decoders   map[string]*decoder
+	checkpoint *Checkpoint
+	scanners   []streaming.Scanner
+	messages   chan message.ImmutableMessage
+	events     chan *Event
+	done       chan struct{}
+	err        error
+}
+
+// Subscribe starts to subscribe the change events of a collection.
+func Subscribe(ctx context.Context, wal streaming.WALAccesser, opt SubscribeOption) (*Subscription, error) {
+	decoders := make(map[string]*decoder, len(opt.VChannels))
+	for _, vchannel := range opt.VChannels {
+		decoder, err := newDecoder(opt.CollectionName, opt.Schema)
+		if err != nil {
+			return nil, err
+		}
+		decoders[vchannel] = decoder
+	}
+	checkpoint, err := DecodeCheckpoint(wal.WALName(), opt.Checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Subscription{
+		ctx:        ctx,
+		cancel:     cancel,
+		decoders:   decoders,
+		checkpoint: checkpoint,
+		messages:   make(chan message.ImmutableMessage),
+		events:     make(chan *Event),
+		done:       make(chan struct{}),
+	}
+	for _, vchannel := range opt.VChannels {
+		policy := options.DeliverPolicyLatest()
+		id, err := checkpoint.MessageID(vchannel)
+		if err != nil {
+			s.Close()
+			return nil, err
+		}
+		if id != nil {
+			policy = options.DeliverPolicyStartAfter(id)
+		}
+		s.scanners = append(s.scanners, wal.Read(ctx, streaming.ReadOption{
+			VChannel:       vchannel,
+			DeliverPolicy:  policy,
+			DeliverFilters: []options.DeliverFilter{options.DeliverFilterMessageType(messageTypes...)},
+			MessageHandler: messageHandler(s.messages),
+		}))
+	}
+	go s.execute()
+	return s, nil
+}
+
+// Events returns the channel of change events, it's closed when the subscription is finished.
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+// Error returns the error which stops the subscription, should be called after the events channel is closed.
+func (s *Subscription) Error() error {
+	<-s.done
+	return s.err
+}
+
+// Close stops the subscription and releases the underlying scanners.
+func (s *Subscription) Close() {
+	s.cancel()
+	for _, scanner := range s.scanners {
+		scanner.Close()
+	}
+}
+
+func (s *Subscription) execute() {
+	defer func() {
+		close(s.events)
+		close(s.done)
+	}()
+
+	scannerErr := make(chan error, len(s.scanners))
+	for _, scanner := range s.scanners {
+		go func(scanner streaming.Scanner) {
+			<-scanner.Done()
+			scannerErr <- scanner.Error()
+		}(scanner)
+	}
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			s.err = s.ctx.Err()
+			return
+		case err := <-scannerErr:
+			if err == nil {
+				err = context.Canceled
+			}
+			log.Warn("change stream scanner is stopped", zap.Error(err))
+			s.err = err
+			return
+		case msg := <-s.messages:
+			if err := s.deliver(msg); err != nil {
+				s.err = err
+				return
+			}
+		}
+	}
+}
+
+// deliver decodes the message and sends the events, the checkpoint is advanced
+// only on the last event of the message, so the resumed subscription never loses a row.
+func (s *Subscription) deliver(msg message.ImmutableMessage) error {
+	events, err := s.decoders[msg.VChannel()].decode(msg)
+	if err != nil {
+		log.Warn("failed to decode change stream message",
+			zap.String("vchannel", msg.VChannel()),
+			zap.Stringer("messageID", msg.MessageID()),
+			zap.Error(err))
+		return err
+	}
+	before := s.checkpoint.Encode()
+	s.checkpoint.Advance(msg.VChannel(), msg.MessageID())
+	after := s.checkpoint.Encode()
+	for i, event := range events {
+		event.Checkpoint = before
+		if i == len(events)-1 {
+			event.Checkpoint = after
+		}
+		select {
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		case s.events <- event:
+		}
+	}
+	return nil
+}
+
+// messageHandler forwards the messages of all scanners into one channel,
+// unlike adaptor.ChanMessageHandler, the channel is shared so it's never closed by the scanner.
+type messageHandler chan message.ImmutableMessage
+
+func (h messageHandler) Handle(param message.HandleParam) message.HandleResult {
+	var sendingCh chan message.ImmutableMessage
+	if param.Message != nil {
+		sendingCh = h
+	}
+	select {
+	case <-param.Ctx.Done():
+		return message.HandleResult{Error: param.Ctx.Err()}
+	case msg, ok := <-param.Upstream:
+		if !ok {
+			panic("unreachable code: upstream should never closed")
+		}
+		return message.HandleResult{Incoming: msg}
+	case sendingCh <- param.Message:
+		return message.HandleResult{MessageHandled: true}
+	}
+}
+
+func (h messageHandler) Close() {}
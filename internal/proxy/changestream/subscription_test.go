@@ -0,0 +1,259 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changestream
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/mocks/distributed/mock_streaming"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/walimplstest"
+)
+
+var testSchema = &schemapb.CollectionSchema{
+	Name: "test",
+	Fields: []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 102, Name: "meta", DataType: schemapb.DataType_JSON, Nullable: true},
+	},
+}
+
+func newTestInsertMessage(vchannel string, id int64) message.ImmutableMessage {
+	return message.NewInsertMessageBuilderV1().
+		WithVChannel(vchannel).
+		WithHeader(&message.InsertMessageHeader{CollectionId: 1}).
+		WithBody(&msgpb.InsertRequest{
+			CollectionName: "test",
+			PartitionName:  "_default",
+			NumRows:        2,
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "id",
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+					}},
+				},
+				{
+					Type:      schemapb.DataType_FloatVector,
+					FieldName: "vec",
+					Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+						Dim:  2,
+						Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: []float32{0.1, 0.2, 0.3, 0.4}}},
+					}},
+				},
+				{
+					Type:      schemapb.DataType_JSON,
+					FieldName: "meta",
+					ValidData: []bool{false, true},
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: [][]byte{nil, []byte(`{"a":1}`)}}},
+					}},
+				},
+			},
+		}).
+		MustBuildMutable().
+		WithTimeTick(10).
+		IntoImmutableMessage(walimplstest.NewTestMessageID(id))
+}
+
+func newTestDeleteMessage(vchannel string, id int64) message.ImmutableMessage {
+	return message.NewDeleteMessageBuilderV1().
+		WithVChannel(vchannel).
+		WithHeader(&message.DeleteMessageHeader{CollectionId: 1}).
+		WithBody(&msgpb.DeleteRequest{
+			CollectionName: "test",
+			PrimaryKeys: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{2}}},
+			},
+		}).
+		MustBuildMutable().
+		WithTimeTick(20).
+		IntoImmutableMessage(walimplstest.NewTestMessageID(id))
+}
+
+func newTestSchemaChangeMessage(vchannel string, id int64, schema *schemapb.CollectionSchema) message.ImmutableMessage {
+	return message.NewSchemaChangeMessageBuilderV2().
+		WithVChannel(vchannel).
+		WithHeader(&message.SchemaChangeMessageHeader{CollectionId: 1}).
+		WithBody(&message.SchemaChangeMessageBody{Schema: schema}).
+		MustBuildMutable().
+		WithTimeTick(30).
+		WithLastConfirmedUseMessageID().
+		IntoImmutableMessage(walimplstest.NewTestMessageID(id))
+}
+
+func TestCheckpoint(t *testing.T) {
+	checkpoint, err := DecodeCheckpoint(walimplstest.WALName, "")
+	assert.NoError(t, err)
+	id, err := checkpoint.MessageID("v1")
+	assert.NoError(t, err)
+	assert.Nil(t, id)
+
+	checkpoint.Advance("v1", walimplstest.NewTestMessageID(10))
+	checkpoint, err = DecodeCheckpoint(walimplstest.WALName, checkpoint.Encode())
+	assert.NoError(t, err)
+	id, err = checkpoint.MessageID("v1")
+	assert.NoError(t, err)
+	assert.True(t, id.EQ(walimplstest.NewTestMessageID(10)))
+
+	_, err = DecodeCheckpoint("other", checkpoint.Encode())
+	assert.Error(t, err)
+	_, err = DecodeCheckpoint(walimplstest.WALName, "invalid!")
+	assert.Error(t, err)
+}
+
+func TestDecoder(t *testing.T) {
+	d, err := newDecoder("test", testSchema)
+	assert.NoError(t, err)
+
+	events, err := d.decode(newTestInsertMessage("v1", 1))
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, EventTypeInsert, events[0].Type)
+	assert.Equal(t, "_default", events[0].Partition)
+	assert.EqualValues(t, 10, events[0].Timestamp)
+	assert.EqualValues(t, 1, events[0].PrimaryKey)
+	assert.Equal(t, []float32{0.1, 0.2}, events[0].Fields["vec"])
+	assert.Nil(t, events[0].Fields["meta"])
+	assert.EqualValues(t, 2, events[1].PrimaryKey)
+	assert.Equal(t, json.RawMessage(`{"a":1}`), events[1].Fields["meta"])
+
+	events, err = d.decode(newTestDeleteMessage("v1", 2))
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, EventTypeDelete, events[0].Type)
+	assert.EqualValues(t, 2, events[0].PrimaryKey)
+
+	_, err = newDecoder("test", &schemapb.CollectionSchema{})
+	assert.Error(t, err)
+}
+
+func TestEventToProto(t *testing.T) {
+	d, err := newDecoder("test", testSchema)
+	assert.NoError(t, err)
+
+	events, err := d.decode(newTestInsertMessage("v1", 1))
+	assert.NoError(t, err)
+	event := events[1].ToProto()
+	assert.Equal(t, milvuspb.ChangeEventType_ChangeEventInsert, event.GetType())
+	assert.Equal(t, "_default", event.GetPartitionName())
+	assert.Equal(t, []int64{2}, event.GetPrimaryKey().GetIntId().GetData())
+	assert.Len(t, event.GetFieldsData(), 3)
+	assert.Equal(t, []float32{0.3, 0.4}, event.GetFieldsData()[1].GetVectors().GetFloatVector().GetData())
+	assert.Equal(t, []bool{true}, event.GetFieldsData()[2].GetValidData())
+	assert.Equal(t, [][]byte{[]byte(`{"a":1}`)}, event.GetFieldsData()[2].GetScalars().GetJsonData().GetData())
+
+	events, err = d.decode(newTestDeleteMessage("v1", 2))
+	assert.NoError(t, err)
+	event = events[0].ToProto()
+	assert.Equal(t, milvuspb.ChangeEventType_ChangeEventDelete, event.GetType())
+	assert.Equal(t, []int64{2}, event.GetPrimaryKey().GetIntId().GetData())
+	assert.Empty(t, event.GetFieldsData())
+}
+
+type fakeScanner struct {
+	done chan struct{}
+}
+
+func (s *fakeScanner) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *fakeScanner) Error() error {
+	return nil
+}
+
+func (s *fakeScanner) Close() {
+	close(s.done)
+}
+
+func TestSubscription(t *testing.T) {
+	checkpoint := NewCheckpoint(walimplstest.WALName)
+	checkpoint.Advance("v1", walimplstest.NewTestMessageID(5))
+
+	handlers := make(map[string]message.Handler)
+	wal := mock_streaming.NewMockWALAccesser(t)
+	wal.EXPECT().WALName().Return(walimplstest.WALName)
+	wal.EXPECT().Read(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, opts streaming.ReadOption) streaming.Scanner {
+		if opts.VChannel == "v1" {
+			assert.True(t, proto.Equal(options.DeliverPolicyStartAfter(walimplstest.NewTestMessageID(5)), opts.DeliverPolicy))
+		} else {
+			assert.True(t, proto.Equal(options.DeliverPolicyLatest(), opts.DeliverPolicy))
+		}
+		handlers[opts.VChannel] = opts.MessageHandler
+		return &fakeScanner{done: make(chan struct{})}
+	})
+
+	ctx := context.Background()
+	s, err := Subscribe(ctx, wal, SubscribeOption{
+		CollectionName: "test",
+		Schema:         testSchema,
+		VChannels:      []string{"v1", "v2"},
+		Checkpoint:     checkpoint.Encode(),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, handlers, 2)
+
+	go handlers["v2"].Handle(message.HandleParam{Ctx: ctx, Message: newTestInsertMessage("v2", 1)})
+	first := <-s.Events()
+	second := <-s.Events()
+	assert.Equal(t, "v2", first.VChannel)
+	// only the last event of the message advances the checkpoint
+	assert.Equal(t, checkpoint.Encode(), first.Checkpoint)
+	resumed, err := DecodeCheckpoint(walimplstest.WALName, second.Checkpoint)
+	assert.NoError(t, err)
+	id, err := resumed.MessageID("v2")
+	assert.NoError(t, err)
+	assert.True(t, id.EQ(walimplstest.NewTestMessageID(1)))
+
+	// the schema change of v1 doesn't affect the decoding of v2 which hasn't received it yet
+	newSchema := proto.Clone(testSchema).(*schemapb.CollectionSchema)
+	newSchema.Fields[0].Name = "pk"
+	go handlers["v1"].Handle(message.HandleParam{Ctx: ctx, Message: newTestSchemaChangeMessage("v1", 6, newSchema)})
+	ddl := <-s.Events()
+	assert.Equal(t, EventTypeDDL, ddl.Type)
+	assert.Equal(t, "v1", ddl.VChannel)
+	go handlers["v2"].Handle(message.HandleParam{Ctx: ctx, Message: newTestInsertMessage("v2", 2)})
+	first = <-s.Events()
+	<-s.Events()
+	assert.EqualValues(t, 1, first.PrimaryKey)
+
+	s.Close()
+	for range s.Events() {
+	}
+	assert.ErrorIs(t, s.Error(), context.Canceled)
+
+	_, err = Subscribe(ctx, wal, SubscribeOption{
+		CollectionName: "test",
+		Schema:         testSchema,
+		Checkpoint:     "invalid!",
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,186 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/proxy/ingestion"
+	"github.com/milvus-io/milvus/pkg/v2/kv/predicates"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// ingestionSink writes the rows consumed by the kafka ingestion connectors through the dml path of proxy.
+type ingestionSink struct {
+	node *Proxy
+}
+
+func (s *ingestionSink) GetCollectionSchema(ctx context.Context, dbName string, collectionName string) (*schemapb.CollectionSchema, error) {
+	if globalMetaCache == nil {
+		return nil, merr.WrapErrServiceNotReady(paramtable.GetRole(), paramtable.GetNodeID(), "initialization")
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	return schema.CollectionSchema, nil
+}
+
+// Write writes the rows in a user transaction, the kv saves are persisted atomically with the commit point of the transaction.
+func (s *ingestionSink) Write(ctx context.Context, cfg *ingestion.ConnectorConfig, fieldsData []*schemapb.FieldData, numRows uint32, saves map[string]string, preds []predicates.Predicate) error {
+	txnID, err := BeginTransaction(ctx, cfg.DBName, cfg.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+	if err := s.write(NewContextWithTransaction(ctx, txnID), cfg, fieldsData, numRows); err != nil {
+		if err := RollbackTransaction(ctx, txnID); err != nil {
+			log.Ctx(ctx).Warn("failed to rollback the transaction of ingestion connector", zap.String("txnID", txnID), zap.Error(err))
+		}
+		return err
+	}
+	_, err = globalTxnManager.commitWithKV(ctx, GetCurUserFromContextOrDefault(ctx), txnID, saves, preds...)
+	return err
+}
+
+func (s *ingestionSink) write(ctx context.Context, cfg *ingestion.ConnectorConfig, fieldsData []*schemapb.FieldData, numRows uint32) error {
+	if cfg.Upsert {
+		resp, err := s.node.Upsert(ctx, &milvuspb.UpsertRequest{
+			DbName:         cfg.DBName,
+			CollectionName: cfg.CollectionName,
+			PartitionName:  cfg.PartitionName,
+			FieldsData:     fieldsData,
+			NumRows:        numRows,
+		})
+		return merr.CheckRPCCall(resp, err)
+	}
+	resp, err := s.node.Insert(ctx, &milvuspb.InsertRequest{
+		DbName:         cfg.DBName,
+		CollectionName: cfg.CollectionName,
+		PartitionName:  cfg.PartitionName,
+		FieldsData:     fieldsData,
+		NumRows:        numRows,
+	})
+	return merr.CheckRPCCall(resp, err)
+}
+
+// getIngestionManager returns the ingestion manager, or writes the error response if it's not enabled.
+func (node *Proxy) getIngestionManager(w http.ResponseWriter) *ingestion.Manager {
+	if node.ingestionManager == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"msg": "kafka ingestion is not enabled, set proxy.ingestion.enabled to true"}`))
+		return nil
+	}
+	return node.ingestionManager
+}
+
+// CreateIngestionConnector creates a kafka ingestion connector by the json configuration in request body.
+func (node *Proxy) CreateIngestionConnector(w http.ResponseWriter, req *http.Request) {
+	manager := node.getIngestionManager(w)
+	if manager == nil {
+		return
+	}
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write([]byte(`{"msg": "only POST method is allowed"}`))
+		return
+	}
+	cfg := &ingestion.ConnectorConfig{}
+	if err := json.NewDecoder(req.Body).Decode(cfg); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to parse connector config, %s"}`, err.Error())))
+		return
+	}
+	if err := manager.Create(req.Context(), cfg); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create ingestion connector, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// DropIngestionConnector drops the kafka ingestion connector.
+func (node *Proxy) DropIngestionConnector(w http.ResponseWriter, req *http.Request) {
+	manager := node.getIngestionManager(w)
+	if manager == nil {
+		return
+	}
+	if err := req.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop ingestion connector, %s"}`, err.Error())))
+		return
+	}
+	if err := manager.Drop(req.Context(), req.FormValue("name")); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop ingestion connector, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// ListIngestionConnectors lists the configurations of kafka ingestion connectors.
+func (node *Proxy) ListIngestionConnectors(w http.ResponseWriter, req *http.Request) {
+	manager := node.getIngestionManager(w)
+	if manager == nil {
+		return
+	}
+	bytes, err := json.Marshal(manager.List())
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list ingestion connectors, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// GetIngestionConnectorStatus returns the consuming status and lag of the kafka ingestion connector on current proxy.
+func (node *Proxy) GetIngestionConnectorStatus(w http.ResponseWriter, req *http.Request) {
+	manager := node.getIngestionManager(w)
+	if manager == nil {
+		return
+	}
+	if err := req.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get ingestion connector status, %s"}`, err.Error())))
+		return
+	}
+	status, err := manager.Status(req.FormValue("name"))
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get ingestion connector status, %s"}`, err.Error())))
+		return
+	}
+	bytes, err := json.Marshal(status)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get ingestion connector status, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
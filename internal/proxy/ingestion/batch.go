@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingestion
+
+import (
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/json"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// batch is the rows and the kafka offsets to be written together.
+type batch struct {
+	rows []map[string]any
+	// nextOffsets is the next offset to consume of every kafka partition after the batch is written.
+	nextOffsets map[int32]int64
+}
+
+func newBatch() *batch {
+	return &batch{
+		nextOffsets: make(map[int32]int64),
+	}
+}
+
+// Add adds the rows of a kafka message into the batch.
+func (b *batch) Add(partition int32, offset int64, rows ...map[string]any) {
+	b.rows = append(b.rows, rows...)
+	b.nextOffsets[partition] = offset + 1
+}
+
+// IsEmpty returns true if there's no offset need to be committed.
+func (b *batch) IsEmpty() bool {
+	return len(b.nextOffsets) == 0
+}
+
+// buildFieldsData parses the decoded rows by the json row parser of import,
+// and converts them into the column based field data of insert request.
+// The rows that can not be parsed by the schema are skipped and counted as failed rows.
+func buildFieldsData(collSchema *schemapb.CollectionSchema, rows []map[string]any) ([]*schemapb.FieldData, int, int, error) {
+	// the system fields are not provided by the user rows, same as import.
+	schema := &schemapb.CollectionSchema{
+		Name:               collSchema.GetName(),
+		EnableDynamicField: collSchema.GetEnableDynamicField(),
+		Functions:          collSchema.GetFunctions(),
+		Fields: lo.Filter(collSchema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+			return field.GetFieldID() >= common.StartOfUserFieldID
+		}),
+	}
+	parser, err := json.NewRowParser(schema)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	insertData, err := storage.NewInsertData(schema)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	numRows, failedRows := 0, 0
+	for _, raw := range rows {
+		row, err := parser.Parse(raw)
+		if err != nil {
+			failedRows++
+			log.RatedWarn(10, "skip the row that can not be parsed by the collection schema", zap.Error(err))
+			continue
+		}
+		if err := insertData.Append(row); err != nil {
+			return nil, 0, 0, err
+		}
+		numRows++
+	}
+	if numRows == 0 {
+		return nil, 0, failedRows, nil
+	}
+	record, err := storage.TransferInsertDataToInsertRecord(insertData)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	columns := lo.KeyBy(record.GetFieldsData(), func(fieldData *schemapb.FieldData) int64 {
+		return fieldData.GetFieldId()
+	})
+	fieldsData := make([]*schemapb.FieldData, 0, len(columns))
+	for _, field := range schema.GetFields() {
+		fieldData, ok := columns[field.GetFieldID()]
+		if !ok || typeutil.IsAutoPKField(field) || field.GetIsFunctionOutput() {
+			// auto id and function output fields are generated by milvus.
+			continue
+		}
+		fieldData.FieldName = field.GetName()
+		fieldData.IsDynamic = field.GetIsDynamic()
+		fieldsData = append(fieldsData, fieldData)
+	}
+	return fieldsData, numRows, failedRows, nil
+}
@@ -0,0 +1,206 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingestion
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/kv/predicates"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+func newTestSchema(autoID bool) *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, AutoID: autoID},
+			{
+				FieldID: 101, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}},
+			},
+			{
+				FieldID: 102, Name: "text", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "64"}},
+			},
+		},
+	}
+}
+
+func TestBuildFieldsData(t *testing.T) {
+	d, err := newRowDecoder(&ConnectorConfig{Format: FormatJSON})
+	require.NoError(t, err)
+	rows, err := d.Decode([]byte(`[
+		{"pk": 1, "vector": [0.1, 0.2], "text": "a"},
+		{"pk": 2, "vector": [0.3], "text": "b"},
+		{"pk": 3, "vector": [0.5, 0.6], "text": "c"}
+	]`))
+	require.NoError(t, err)
+
+	fieldsData, numRows, failedRows, err := buildFieldsData(newTestSchema(false), rows)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, numRows)
+	assert.Equal(t, 1, failedRows)
+	assert.Len(t, fieldsData, 3)
+	for _, fieldData := range fieldsData {
+		assert.NotEmpty(t, fieldData.GetFieldName())
+	}
+	assert.Equal(t, []int64{1, 3}, fieldsData[0].GetScalars().GetLongData().GetData())
+
+	// the primary key is generated if auto id is enabled.
+	rows, err = d.Decode([]byte(`[{"vector": [0.1, 0.2], "text": "a"}]`))
+	require.NoError(t, err)
+	fieldsData, numRows, failedRows, err = buildFieldsData(newTestSchema(true), rows)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, numRows)
+	assert.Equal(t, 0, failedRows)
+	assert.Len(t, fieldsData, 2)
+
+	// all rows are failed.
+	rows, err = d.Decode([]byte(`[{"pk": "x"}]`))
+	require.NoError(t, err)
+	fieldsData, numRows, failedRows, err = buildFieldsData(newTestSchema(false), rows)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, numRows)
+	assert.Equal(t, 1, failedRows)
+	assert.Empty(t, fieldsData)
+}
+
+// predicateKV is the memory kv supporting the value predicates like etcd kv.
+type predicateKV struct {
+	*memkv.MemoryKV
+	mu sync.Mutex
+}
+
+func newTestKV() *predicateKV {
+	return &predicateKV{MemoryKV: memkv.NewMemoryKV()}
+}
+
+func (kv *predicateKV) MultiSaveAndRemove(ctx context.Context, saves map[string]string, removals []string, preds ...predicates.Predicate) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	for _, pred := range preds {
+		value, err := kv.Load(ctx, pred.Key())
+		if err != nil && !errors.Is(err, merr.ErrIoKeyNotFound) {
+			return err
+		}
+		if err != nil || !pred.IsTrue(value) {
+			return merr.WrapErrIoFailedReason("failed to execute transaction")
+		}
+	}
+	return kv.MemoryKV.MultiSaveAndRemove(ctx, saves, removals)
+}
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	s := newStore(newTestKV())
+
+	cfg := &ConnectorConfig{Name: "c1", Brokers: "b", Topic: "t", CollectionName: "coll", Format: FormatJSON}
+	assert.NoError(t, s.CreateConnector(ctx, cfg))
+	dup := *cfg
+	assert.Error(t, s.CreateConnector(ctx, &dup))
+	cfgs, err := s.ListConnectors(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []*ConnectorConfig{cfg}, cfgs)
+	exist, err := s.HasConnector(ctx, "c1")
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	cfg10 := &ConnectorConfig{Name: "c10", Brokers: "b", Topic: "t", CollectionName: "coll", Format: FormatJSON}
+	assert.NoError(t, s.CreateConnector(ctx, cfg10))
+
+	offsets, err := s.LoadOffsets(ctx, "c1")
+	assert.NoError(t, err)
+	assert.Empty(t, offsets)
+
+	// the first claim starts from the beginning.
+	claimed, err := s.ClaimPartitions(ctx, cfg, "owner1", []int32{0, 1})
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 2)
+	assert.Equal(t, int64(-1), claimed[0].Offset)
+	assert.Equal(t, int64(1), claimed[0].Epoch)
+
+	commit, err := s.NewOffsetCommit(cfg, claimed, map[int32]int64{0: 10, 1: 5})
+	assert.NoError(t, err)
+	assert.NoError(t, s.CommitOffsets(ctx, commit))
+	claimed = commit.offsets
+	commit, err = s.NewOffsetCommit(cfg, claimed, map[int32]int64{1: 8})
+	assert.NoError(t, err)
+	assert.NoError(t, s.CommitOffsets(ctx, commit))
+	claimed[1] = commit.offsets[1]
+	_, err = s.NewOffsetCommit(cfg, claimed, map[int32]int64{2: 1})
+	assert.Error(t, err)
+
+	c10Claimed, err := s.ClaimPartitions(ctx, cfg10, "owner1", []int32{0})
+	assert.NoError(t, err)
+	commit, err = s.NewOffsetCommit(cfg10, c10Claimed, map[int32]int64{0: 1})
+	assert.NoError(t, err)
+	assert.NoError(t, s.CommitOffsets(ctx, commit))
+
+	offsets, err = s.LoadOffsets(ctx, "c1")
+	assert.NoError(t, err)
+	assert.Len(t, offsets, 2)
+	assert.Equal(t, int64(10), offsets[0].Offset)
+	assert.Equal(t, int64(8), offsets[1].Offset)
+	assert.Equal(t, "owner1", offsets[1].Owner)
+
+	// the claim of another instance fences the commit of the previous owner.
+	newClaimed, err := s.ClaimPartitions(ctx, cfg, "owner2", []int32{1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), newClaimed[1].Offset)
+	assert.Equal(t, int64(2), newClaimed[1].Epoch)
+	commit, err = s.NewOffsetCommit(cfg, claimed, map[int32]int64{0: 11, 1: 9})
+	assert.NoError(t, err)
+	assert.Error(t, s.CommitOffsets(ctx, commit))
+	offsets, err = s.LoadOffsets(ctx, "c1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), offsets[0].Offset)
+	assert.Equal(t, int64(8), offsets[1].Offset)
+	assert.Equal(t, "owner2", offsets[1].Owner)
+
+	commit, err = s.NewOffsetCommit(cfg, newClaimed, map[int32]int64{1: 9})
+	assert.NoError(t, err)
+	assert.NoError(t, s.CommitOffsets(ctx, commit))
+
+	// the dropped connector can not commit or claim any more.
+	assert.NoError(t, s.RemoveConnector(ctx, "c1"))
+	commit, err = s.NewOffsetCommit(cfg, commit.offsets, map[int32]int64{1: 10})
+	assert.NoError(t, err)
+	assert.Error(t, s.CommitOffsets(ctx, commit))
+	_, err = s.ClaimPartitions(ctx, cfg, "owner2", []int32{1})
+	assert.Error(t, err)
+
+	cfgs, err = s.ListConnectors(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []*ConnectorConfig{cfg10}, cfgs)
+	offsets, err = s.LoadOffsets(ctx, "c1")
+	assert.NoError(t, err)
+	assert.Empty(t, offsets)
+	offsets, err = s.LoadOffsets(ctx, "c10")
+	assert.NoError(t, err)
+	assert.Len(t, offsets, 1)
+}
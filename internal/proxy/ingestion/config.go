@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingestion
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+const (
+	FormatJSON = "json"
+	FormatAvro = "avro"
+
+	defaultBatchSize     = 1000
+	defaultBatchInterval = time.Second
+)
+
+var connectorNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_\-]*$`)
+
+// ConnectorConfig is the configuration of a kafka ingestion connector.
+type ConnectorConfig struct {
+	// Name is the unique name of the connector.
+	Name string `json:"name"`
+	// Brokers is the bootstrap servers of the external kafka.
+	Brokers string `json:"brokers"`
+	// Topic is the external kafka topic to consume.
+	Topic string `json:"topic"`
+	// GroupID is the consumer group of the connector, all proxies share the partitions of topic by the group.
+	// The connector name is used if not set.
+	GroupID string `json:"group_id,omitempty"`
+	// KafkaConfig is the extra librdkafka configuration, e.g. the security options.
+	KafkaConfig map[string]string `json:"kafka_config,omitempty"`
+
+	DBName         string `json:"db_name,omitempty"`
+	CollectionName string `json:"collection_name"`
+	PartitionName  string `json:"partition_name,omitempty"`
+
+	// Format is the format of kafka message value, json or avro.
+	Format string `json:"format"`
+	// AvroSchema is the writer schema of avro message.
+	// The confluent wire format header (magic byte and schema id) is skipped if present.
+	AvroSchema string `json:"avro_schema,omitempty"`
+	// FieldMapping renames the field of source row into the field of collection,
+	// the fields not in the mapping keep their names.
+	FieldMapping map[string]string `json:"field_mapping,omitempty"`
+
+	// BatchSize is the max rows of a write batch.
+	// A batch is written in one transaction, so it should fit in proxy.transaction.maxBufferSize.
+	BatchSize int `json:"batch_size,omitempty"`
+	// BatchIntervalMs is the max interval to flush a non-full write batch.
+	BatchIntervalMs int64 `json:"batch_interval_ms,omitempty"`
+	// Upsert writes the rows by upsert instead of insert.
+	Upsert bool `json:"upsert,omitempty"`
+
+	value string // the persisted value of the configuration, used to fence the writes of a dropped connector.
+}
+
+// GetGroupID returns the consumer group of the connector.
+func (c *ConnectorConfig) GetGroupID() string {
+	if c.GroupID != "" {
+		return c.GroupID
+	}
+	return c.Name
+}
+
+// GetBatchSize returns the max rows of a write batch.
+func (c *ConnectorConfig) GetBatchSize() int {
+	if c.BatchSize > 0 {
+		return c.BatchSize
+	}
+	return defaultBatchSize
+}
+
+// GetBatchInterval returns the max interval to flush a write batch.
+func (c *ConnectorConfig) GetBatchInterval() time.Duration {
+	if c.BatchIntervalMs > 0 {
+		return time.Duration(c.BatchIntervalMs) * time.Millisecond
+	}
+	return defaultBatchInterval
+}
+
+// Validate checks the configuration of the connector.
+func (c *ConnectorConfig) Validate() error {
+	if !connectorNameRegexp.MatchString(c.Name) {
+		return merr.WrapErrParameterInvalidMsg("invalid connector name %q", c.Name)
+	}
+	if c.Brokers == "" {
+		return merr.WrapErrParameterMissing("brokers")
+	}
+	if c.Topic == "" {
+		return merr.WrapErrParameterMissing("topic")
+	}
+	if c.CollectionName == "" {
+		return merr.WrapErrParameterMissing("collection_name")
+	}
+	switch c.Format {
+	case FormatJSON:
+	case FormatAvro:
+		if c.AvroSchema == "" {
+			return merr.WrapErrParameterMissing("avro_schema")
+		}
+	default:
+		return merr.WrapErrParameterInvalidMsg("unsupported format %q, should be json or avro", c.Format)
+	}
+	if c.BatchSize < 0 || c.BatchIntervalMs < 0 {
+		return merr.WrapErrParameterInvalidMsg("batch size and batch interval should not be negative")
+	}
+	return nil
+}
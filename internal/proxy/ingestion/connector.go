@@ -0,0 +1,480 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingestion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/cockroachdb/errors"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/kv/predicates"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	StateRunning = "running"
+	StateFailed  = "failed"
+	StateStopped = "stopped"
+
+	pollTimeoutMs = 100
+	seekTimeoutMs = 10000
+)
+
+// Sink writes the rows consumed by connectors into milvus.
+type Sink interface {
+	// GetCollectionSchema returns the schema of the target collection.
+	GetCollectionSchema(ctx context.Context, dbName string, collectionName string) (*schemapb.CollectionSchema, error)
+
+	// Write appends the rows into the wal of target collection in a transaction,
+	// and persists the kv saves atomically with the commit point of the transaction.
+	// Nothing is written if the predicates don't hold, the rows should be visible to the wal once the method returns nil.
+	Write(ctx context.Context, cfg *ConnectorConfig, fieldsData []*schemapb.FieldData, numRows uint32, saves map[string]string, preds []predicates.Predicate) error
+}
+
+// errPartitionsFenced is returned if the kafka partitions of the batch are claimed by another connector instance.
+var errPartitionsFenced = errors.New("kafka partitions are claimed by another connector instance")
+
+// ConnectorStatus is the status of a connector on current proxy.
+type ConnectorStatus struct {
+	Name          string            `json:"name"`
+	Topic         string            `json:"topic"`
+	Collection    string            `json:"collection"`
+	State         string            `json:"state"`
+	ConsumedRows  int64             `json:"consumed_rows"`
+	WrittenRows   int64             `json:"written_rows"`
+	FailedRows    int64             `json:"failed_rows"`
+	WrittenBatch  int64             `json:"written_batches"`
+	Lag           int64             `json:"lag"`
+	Partitions    []PartitionStatus `json:"partitions"`
+	LastError     string            `json:"last_error,omitempty"`
+	LastWriteTime time.Time         `json:"last_write_time,omitempty"`
+}
+
+// PartitionStatus is the consuming status of a kafka partition assigned to current proxy.
+type PartitionStatus struct {
+	Partition       int32 `json:"partition"`
+	CommittedOffset int64 `json:"committed_offset"`
+	HighWatermark   int64 `json:"high_watermark"`
+	Lag             int64 `json:"lag"`
+}
+
+// newConnector creates and starts a connector.
+func newConnector(cfg *ConnectorConfig, store *store, sink Sink) (*connector, error) {
+	decoder, err := newRowDecoder(cfg)
+	if err != nil {
+		return nil, err
+	}
+	kafkaConfig := &kafka.ConfigMap{
+		"bootstrap.servers":  cfg.Brokers,
+		"group.id":           cfg.GetGroupID(),
+		"enable.auto.commit": false,
+		// the offsets are kept in etcd, the stored offsets of kafka are never used.
+		"enable.auto.offset.store": false,
+		"auto.offset.reset":        "earliest",
+	}
+	for key, value := range cfg.KafkaConfig {
+		if err := kafkaConfig.SetKey(key, value); err != nil {
+			return nil, err
+		}
+	}
+	consumer, err := kafka.NewConsumer(kafkaConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	owner := fmt.Sprintf("%d-%s", paramtable.GetNodeID(), uuid.NewString())
+	c := &connector{
+		ctx:      ctx,
+		cancel:   cancel,
+		cfg:      cfg,
+		owner:    owner,
+		store:    store,
+		sink:     sink,
+		decoder:  decoder,
+		consumer: consumer,
+		batch:    newBatch(),
+		claimed:  make(map[int32]partitionOffset),
+		logger:   log.With(zap.String("connector", cfg.Name), zap.String("topic", cfg.Topic), zap.String("owner", owner)),
+		status: ConnectorStatus{
+			Name:       cfg.Name,
+			Topic:      cfg.Topic,
+			Collection: cfg.CollectionName,
+			State:      StateRunning,
+		},
+	}
+	if err := consumer.SubscribeTopics([]string{cfg.Topic}, c.rebalance); err != nil {
+		consumer.Close()
+		cancel()
+		return nil, err
+	}
+	c.wg.Add(1)
+	go c.run()
+	return c, nil
+}
+
+// connector consumes the external kafka topic and writes the rows into the collection exactly once.
+//
+// A batch is written in a wal transaction, and the next offsets of kafka partitions are persisted
+// atomically with the commit point of the transaction, the consumer is always assigned with the persisted offsets.
+// So a batch is either visible with its offsets committed or invisible with the offsets unchanged,
+// the redelivered messages after a crash are never applied twice, whatever the insert or upsert mode.
+//
+// Every assignment of a kafka partition claims the partition in etcd with a new epoch,
+// and the offset commit requires the partition is still claimed by the connector instance,
+// so the batch of a stale owner, e.g. a proxy paused across a rebalance, is rolled back instead of applied.
+type connector struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	cfg      *ConnectorConfig
+	owner    string // owner is the unique id of the connector instance to claim the kafka partitions.
+	store    *store
+	sink     Sink
+	decoder  rowDecoder
+	consumer *kafka.Consumer
+	logger   *log.MLogger
+
+	// the fields below are only accessed by the consuming goroutine.
+	batch          *batch
+	batchStartTime time.Time
+	retryBackoff   *backoff.ExponentialBackOff
+
+	mu      sync.Mutex
+	closed  bool                      // the kafka consumer is closed.
+	claimed map[int32]partitionOffset // the committed positions of assigned partitions.
+	status  ConnectorStatus
+}
+
+// Config returns the configuration of the connector.
+func (c *connector) Config() *ConnectorConfig {
+	return c.cfg
+}
+
+// Status returns the status of the connector.
+func (c *connector) Status() ConnectorStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	status := c.status
+	status.Lag = 0
+	status.Partitions = make([]PartitionStatus, 0, len(c.claimed))
+	for partition, claimed := range c.claimed {
+		offset := claimed.Offset
+		ps := PartitionStatus{
+			Partition:       partition,
+			CommittedOffset: offset,
+		}
+		if c.closed {
+			status.Partitions = append(status.Partitions, ps)
+			continue
+		}
+		if _, high, err := c.consumer.GetWatermarkOffsets(c.cfg.Topic, partition); err == nil && high >= 0 {
+			ps.HighWatermark = high
+			if offset >= 0 && high > offset {
+				ps.Lag = high - offset
+			}
+		}
+		status.Lag += ps.Lag
+		status.Partitions = append(status.Partitions, ps)
+	}
+	sort.Slice(status.Partitions, func(i, j int) bool {
+		return status.Partitions[i].Partition < status.Partitions[j].Partition
+	})
+	return status
+}
+
+// Close stops the connector, the pending batch is written before the connector is closed.
+func (c *connector) Close() {
+	c.cancel()
+	c.wg.Wait()
+}
+
+func (c *connector) run() {
+	defer c.wg.Done()
+	defer func() {
+		// flush the pending batch before leaving the consumer group, then the offsets are not lost.
+		if err := c.flush(context.Background()); err != nil {
+			c.logger.Warn("failed to flush batch when connector stopped", zap.Error(err))
+		}
+		c.mu.Lock()
+		c.closed = true
+		c.status.State = StateStopped
+		c.mu.Unlock()
+		if err := c.consumer.Close(); err != nil {
+			c.logger.Warn("failed to close kafka consumer", zap.Error(err))
+		}
+		c.logger.Info("connector stopped")
+	}()
+	c.logger.Info("connector started")
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		default:
+		}
+		if c.shouldFlush() {
+			if err := c.flush(c.ctx); err != nil {
+				if errors.Is(err, errPartitionsFenced) {
+					c.reclaim()
+					continue
+				}
+				// keep the batch and retry it at next round, stop consuming until the batch is written.
+				c.waitForRetry()
+				continue
+			}
+		}
+
+		switch e := c.consumer.Poll(pollTimeoutMs).(type) {
+		case *kafka.Message:
+			c.consume(e)
+		case kafka.Error:
+			c.logger.Warn("kafka consumer error", zap.Error(e))
+			c.setError(e)
+			if e.IsFatal() {
+				c.mu.Lock()
+				c.status.State = StateFailed
+				c.mu.Unlock()
+				<-c.ctx.Done()
+				return
+			}
+		}
+	}
+}
+
+// rebalance claims the assigned partitions and assigns them with the offsets persisted in etcd,
+// and flushes the pending batch before the partitions are revoked.
+func (c *connector) rebalance(consumer *kafka.Consumer, event kafka.Event) error {
+	switch e := event.(type) {
+	case kafka.AssignedPartitions:
+		partitions, err := c.claim(e.Partitions)
+		if err != nil {
+			return err
+		}
+		c.logger.Info("kafka partitions assigned", zap.Any("partitions", partitions))
+		return consumer.Assign(partitions)
+	case kafka.RevokedPartitions:
+		if err := c.flush(c.ctx); err != nil {
+			// drop the batch, the new owner of the partitions will consume them from the persisted offsets.
+			c.logger.Warn("failed to flush batch before partitions revoked, the batch is dropped", zap.Error(err))
+			c.batch = newBatch()
+		}
+		c.mu.Lock()
+		for _, tp := range e.Partitions {
+			delete(c.claimed, tp.Partition)
+		}
+		c.mu.Unlock()
+		c.logger.Info("kafka partitions revoked", zap.Any("partitions", e.Partitions))
+		return consumer.Unassign()
+	}
+	return nil
+}
+
+// claim claims the kafka partitions in etcd, returns the partitions with the offsets to consume from.
+func (c *connector) claim(tps []kafka.TopicPartition) ([]kafka.TopicPartition, error) {
+	ids := make([]int32, 0, len(tps))
+	for _, tp := range tps {
+		ids = append(ids, tp.Partition)
+	}
+	claimed, err := c.store.ClaimPartitions(c.ctx, c.cfg, c.owner, ids)
+	if err != nil {
+		c.logger.Warn("failed to claim kafka partitions of connector", zap.Error(err))
+		c.setError(err)
+		return nil, err
+	}
+	partitions := make([]kafka.TopicPartition, 0, len(tps))
+	c.mu.Lock()
+	for _, tp := range tps {
+		offset := claimed[tp.Partition]
+		tp.Offset = kafka.OffsetBeginning
+		if offset.Offset >= 0 {
+			tp.Offset = kafka.Offset(offset.Offset)
+		}
+		c.claimed[tp.Partition] = offset
+		partitions = append(partitions, tp)
+	}
+	c.mu.Unlock()
+	return partitions, nil
+}
+
+// reclaim drops the pending batch whose partitions are claimed by another connector instance,
+// then claims the partitions again and rewinds the consumer to the persisted offsets.
+// The claim of a stale owner is fenced at its next commit, and the partitions are revoked from it at the rebalance.
+func (c *connector) reclaim() {
+	tps := make([]kafka.TopicPartition, 0, len(c.batch.nextOffsets))
+	for partition := range c.batch.nextOffsets {
+		tps = append(tps, kafka.TopicPartition{Topic: &c.cfg.Topic, Partition: partition})
+	}
+	c.batch = newBatch()
+	partitions, err := c.claim(tps)
+	if err == nil {
+		for _, tp := range partitions {
+			if err = c.consumer.Seek(tp, seekTimeoutMs); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		// the messages after the persisted offsets may be skipped, fail the connector instead of losing them.
+		c.logger.Warn("failed to reclaim the fenced kafka partitions", zap.Error(err))
+		c.setError(err)
+		c.mu.Lock()
+		c.status.State = StateFailed
+		c.mu.Unlock()
+		c.cancel()
+		return
+	}
+	c.logger.Info("fenced kafka partitions are reclaimed", zap.Any("partitions", partitions))
+}
+
+// consume decodes the kafka message into the pending batch.
+func (c *connector) consume(msg *kafka.Message) {
+	if c.batch.IsEmpty() {
+		c.batchStartTime = time.Now()
+	}
+	rows, err := c.decoder.Decode(msg.Value)
+	if err != nil {
+		c.logger.RatedWarn(10, "skip the kafka message that can not be decoded",
+			zap.Int32("partition", msg.TopicPartition.Partition),
+			zap.Int64("offset", int64(msg.TopicPartition.Offset)),
+			zap.Error(err))
+		c.mu.Lock()
+		c.status.FailedRows++
+		c.mu.Unlock()
+	}
+	// the offset is still advanced for the bad message, otherwise the connector will be stuck on it.
+	c.batch.Add(msg.TopicPartition.Partition, int64(msg.TopicPartition.Offset), rows...)
+	c.mu.Lock()
+	c.status.ConsumedRows += int64(len(rows))
+	c.mu.Unlock()
+}
+
+func (c *connector) shouldFlush() bool {
+	if c.batch.IsEmpty() {
+		return false
+	}
+	return len(c.batch.rows) >= c.cfg.GetBatchSize() || time.Since(c.batchStartTime) >= c.cfg.GetBatchInterval()
+}
+
+// flush writes the pending batch into milvus and persists the offsets.
+func (c *connector) flush(ctx context.Context) error {
+	if c.batch.IsEmpty() {
+		return nil
+	}
+	var (
+		fieldsData []*schemapb.FieldData
+		numRows    int
+		failedRows int
+	)
+	if len(c.batch.rows) > 0 {
+		schema, err := c.sink.GetCollectionSchema(ctx, c.cfg.DBName, c.cfg.CollectionName)
+		if err != nil {
+			c.setError(err)
+			return err
+		}
+		if fieldsData, numRows, failedRows, err = buildFieldsData(schema, c.batch.rows); err != nil {
+			c.setError(err)
+			return err
+		}
+	}
+	commit, err := c.store.NewOffsetCommit(c.cfg, c.claimed, c.batch.nextOffsets)
+	if err != nil {
+		c.setError(err)
+		return err
+	}
+	if numRows > 0 {
+		err = c.sink.Write(ctx, c.cfg, fieldsData, uint32(numRows), commit.saves, commit.preds)
+	} else {
+		err = c.store.CommitOffsets(ctx, commit)
+	}
+	if err != nil {
+		// the offsets may be committed even if an error is returned, check the persisted offsets.
+		if err = c.checkCommitted(ctx, commit, err); err != nil {
+			c.logger.Warn("failed to write batch into collection", zap.Int("rows", numRows), zap.Error(err))
+			c.setError(err)
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	for partition, offset := range commit.offsets {
+		c.claimed[partition] = offset
+	}
+	c.status.WrittenRows += int64(numRows)
+	c.status.FailedRows += int64(failedRows)
+	c.status.WrittenBatch++
+	c.status.LastWriteTime = time.Now()
+	c.status.LastError = ""
+	c.mu.Unlock()
+	c.batch = newBatch()
+	c.retryBackoff = nil
+	return nil
+}
+
+// checkCommitted checks the persisted offsets after the commit fails,
+// returns nil if the commit is applied, errPartitionsFenced if any partition is claimed by others.
+func (c *connector) checkCommitted(ctx context.Context, commit *offsetCommit, commitErr error) error {
+	current, err := c.store.LoadOffsets(ctx, c.cfg.Name)
+	if err != nil {
+		return commitErr
+	}
+	applied := true
+	for partition, offset := range commit.offsets {
+		switch current[partition].value {
+		case offset.value:
+		case c.claimed[partition].value:
+			applied = false
+		default:
+			return errors.Mark(errors.Wrapf(commitErr, "kafka partition %d is claimed by %s", partition, current[partition].Owner), errPartitionsFenced)
+		}
+	}
+	if !applied {
+		return commitErr
+	}
+	return nil
+}
+
+// waitForRetry waits for a backoff interval before retrying the failed batch.
+func (c *connector) waitForRetry() {
+	if c.retryBackoff == nil {
+		c.retryBackoff = backoff.NewExponentialBackOff()
+		c.retryBackoff.InitialInterval = 100 * time.Millisecond
+		c.retryBackoff.MaxInterval = 10 * time.Second
+		c.retryBackoff.MaxElapsedTime = 0
+	}
+	select {
+	case <-c.ctx.Done():
+	case <-time.After(c.retryBackoff.NextBackOff()):
+	}
+}
+
+func (c *connector) setError(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.status.LastError = err.Error()
+}
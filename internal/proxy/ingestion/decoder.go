@@ -0,0 +1,183 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingestion
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/linkedin/goavro/v2"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// confluentWireHeaderSize is the size of magic byte and schema id of confluent wire format.
+const confluentWireHeaderSize = 5
+
+// rowDecoder decodes the value of a kafka message into the source rows.
+// The decoded row has the same representation as the json import file,
+// so it can be parsed by the json row parser of import.
+type rowDecoder interface {
+	Decode(value []byte) ([]map[string]any, error)
+}
+
+// newRowDecoder creates a row decoder by the format of the connector.
+func newRowDecoder(cfg *ConnectorConfig) (rowDecoder, error) {
+	var d rowDecoder
+	switch cfg.Format {
+	case FormatJSON:
+		d = jsonDecoder{}
+	case FormatAvro:
+		codec, err := goavro.NewCodec(cfg.AvroSchema)
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid avro schema: %s", err.Error())
+		}
+		d = &avroDecoder{codec: codec}
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("unsupported format %q", cfg.Format)
+	}
+	if len(cfg.FieldMapping) == 0 {
+		return d, nil
+	}
+	return &mappingDecoder{rowDecoder: d, mapping: cfg.FieldMapping}, nil
+}
+
+// jsonDecoder decodes a json object or a json array of objects.
+type jsonDecoder struct{}
+
+func (jsonDecoder) Decode(value []byte) ([]map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(value))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, merr.WrapErrImportFailed("invalid json message: " + err.Error())
+	}
+	switch v := v.(type) {
+	case map[string]any:
+		return []map[string]any{v}, nil
+	case []any:
+		rows := make([]map[string]any, 0, len(v))
+		for _, item := range v {
+			row, ok := item.(map[string]any)
+			if !ok {
+				return nil, merr.WrapErrImportFailed("invalid json message, each row should be a key-value map")
+			}
+			rows = append(rows, row)
+		}
+		return rows, nil
+	default:
+		return nil, merr.WrapErrImportFailed("invalid json message, should be an object or an array of objects")
+	}
+}
+
+// avroDecoder decodes an avro record with the given writer schema.
+type avroDecoder struct {
+	codec *goavro.Codec
+}
+
+func (d *avroDecoder) Decode(value []byte) ([]map[string]any, error) {
+	if len(value) > confluentWireHeaderSize && value[0] == 0 {
+		// try the confluent wire format first, fallback to the raw avro binary.
+		if rows, err := d.decode(value[confluentWireHeaderSize:]); err == nil {
+			return rows, nil
+		}
+	}
+	return d.decode(value)
+}
+
+func (d *avroDecoder) decode(value []byte) ([]map[string]any, error) {
+	native, remain, err := d.codec.NativeFromBinary(value)
+	if err != nil {
+		return nil, merr.WrapErrImportFailed("invalid avro message: " + err.Error())
+	}
+	if len(remain) > 0 {
+		return nil, merr.WrapErrImportFailed("invalid avro message, unexpected trailing bytes")
+	}
+	row, ok := normalizeAvroValue(native).(map[string]any)
+	if !ok {
+		return nil, merr.WrapErrImportFailed("invalid avro message, the schema should be a record")
+	}
+	return []map[string]any{row}, nil
+}
+
+// avroUnionBranches is the branch names of avro union which are unwrapped into the plain value.
+var avroUnionBranches = map[string]struct{}{
+	"boolean": {}, "int": {}, "long": {}, "float": {}, "double": {},
+	"bytes": {}, "string": {}, "array": {}, "map": {},
+}
+
+// normalizeAvroValue converts the avro native value into the json import representation.
+func normalizeAvroValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		if len(v) == 1 {
+			for branch, inner := range v {
+				if _, ok := avroUnionBranches[branch]; ok {
+					return normalizeAvroValue(inner)
+				}
+			}
+		}
+		row := make(map[string]any, len(v))
+		for key, value := range v {
+			row[key] = normalizeAvroValue(value)
+		}
+		return row
+	case []any:
+		arr := make([]any, 0, len(v))
+		for _, value := range v {
+			arr = append(arr, normalizeAvroValue(value))
+		}
+		return arr
+	case int32:
+		return json.Number(strconv.FormatInt(int64(v), 10))
+	case int64:
+		return json.Number(strconv.FormatInt(v, 10))
+	case float32:
+		return json.Number(strconv.FormatFloat(float64(v), 'g', -1, 32))
+	case float64:
+		return json.Number(strconv.FormatFloat(v, 'g', -1, 64))
+	case []byte:
+		return string(v)
+	default:
+		return v
+	}
+}
+
+// mappingDecoder renames the fields of decoded rows.
+type mappingDecoder struct {
+	rowDecoder
+	mapping map[string]string
+}
+
+func (d *mappingDecoder) Decode(value []byte) ([]map[string]any, error) {
+	rows, err := d.rowDecoder.Decode(value)
+	if err != nil {
+		return nil, err
+	}
+	for i, row := range rows {
+		mapped := make(map[string]any, len(row))
+		for key, value := range row {
+			if target, ok := d.mapping[key]; ok {
+				key = target
+			}
+			mapped[key] = value
+		}
+		rows[i] = mapped
+	}
+	return rows, nil
+}
@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingestion
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAvroSchema = `{
+	"type": "record",
+	"name": "row",
+	"fields": [
+		{"name": "pk", "type": "long"},
+		{"name": "vector", "type": {"type": "array", "items": "float"}},
+		{"name": "title", "type": ["null", "string"]}
+	]
+}`
+
+func TestConnectorConfigValidate(t *testing.T) {
+	cfg := &ConnectorConfig{
+		Name:           "c1",
+		Brokers:        "localhost:9092",
+		Topic:          "topic",
+		CollectionName: "coll",
+		Format:         FormatJSON,
+	}
+	assert.NoError(t, cfg.Validate())
+	assert.Equal(t, "c1", cfg.GetGroupID())
+	assert.Equal(t, defaultBatchSize, cfg.GetBatchSize())
+	assert.Equal(t, defaultBatchInterval, cfg.GetBatchInterval())
+
+	invalid := *cfg
+	invalid.Name = "1/c"
+	assert.Error(t, invalid.Validate())
+
+	invalid = *cfg
+	invalid.Topic = ""
+	assert.Error(t, invalid.Validate())
+
+	invalid = *cfg
+	invalid.Format = FormatAvro
+	assert.Error(t, invalid.Validate())
+
+	invalid = *cfg
+	invalid.Format = "csv"
+	assert.Error(t, invalid.Validate())
+
+	invalid = *cfg
+	invalid.BatchSize = -1
+	assert.Error(t, invalid.Validate())
+}
+
+func TestJSONDecoder(t *testing.T) {
+	d, err := newRowDecoder(&ConnectorConfig{Format: FormatJSON})
+	require.NoError(t, err)
+
+	rows, err := d.Decode([]byte(`{"pk": 1, "vector": [0.1, 0.2]}`))
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, json.Number("1"), rows[0]["pk"])
+
+	rows, err = d.Decode([]byte(`[{"pk": 1}, {"pk": 2}]`))
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+
+	_, err = d.Decode([]byte(`[1, 2]`))
+	assert.Error(t, err)
+	_, err = d.Decode([]byte(`"pk"`))
+	assert.Error(t, err)
+	_, err = d.Decode([]byte(`{`))
+	assert.Error(t, err)
+}
+
+func TestAvroDecoder(t *testing.T) {
+	_, err := newRowDecoder(&ConnectorConfig{Format: FormatAvro, AvroSchema: "{"})
+	assert.Error(t, err)
+
+	d, err := newRowDecoder(&ConnectorConfig{
+		Format:       FormatAvro,
+		AvroSchema:   testAvroSchema,
+		FieldMapping: map[string]string{"title": "text"},
+	})
+	require.NoError(t, err)
+
+	codec, err := goavro.NewCodec(testAvroSchema)
+	require.NoError(t, err)
+	value, err := codec.BinaryFromNative(nil, map[string]any{
+		"pk":     int64(7),
+		"vector": []any{float32(0.5), float32(1)},
+		"title":  goavro.Union("string", "hello"),
+	})
+	require.NoError(t, err)
+
+	check := func(rows []map[string]any) {
+		assert.Len(t, rows, 1)
+		assert.Equal(t, json.Number("7"), rows[0]["pk"])
+		assert.Equal(t, []any{json.Number("0.5"), json.Number("1")}, rows[0]["vector"])
+		assert.Equal(t, "hello", rows[0]["text"])
+		assert.NotContains(t, rows[0], "title")
+	}
+
+	// raw avro binary
+	rows, err := d.Decode(value)
+	assert.NoError(t, err)
+	check(rows)
+
+	// confluent wire format
+	rows, err = d.Decode(append([]byte{0, 0, 0, 0, 1}, value...))
+	assert.NoError(t, err)
+	check(rows)
+
+	_, err = d.Decode([]byte{1})
+	assert.Error(t, err)
+}
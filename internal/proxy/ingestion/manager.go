@@ -0,0 +1,207 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingestion
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/kv"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// NewManager creates a connector manager.
+func NewManager(txnKV kv.TxnKV, sink Sink, syncInterval time.Duration) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		ctx:          ctx,
+		cancel:       cancel,
+		store:        newStore(txnKV),
+		sink:         sink,
+		syncInterval: syncInterval,
+		connectors:   make(map[string]*connector),
+	}
+}
+
+// Manager manages the kafka ingestion connectors on current proxy.
+// Every proxy runs all connectors, the kafka partitions of a connector are
+// shared by the proxies through the consumer group of the connector.
+// The connectors created or dropped by other proxies are synced from the meta periodically.
+type Manager struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	mu           sync.Mutex
+	store        *store
+	sink         Sink
+	syncInterval time.Duration
+	connectors   map[string]*connector
+}
+
+// Start recovers the connectors persisted in the meta and starts the background sync.
+func (m *Manager) Start() error {
+	if err := m.sync(m.ctx); err != nil {
+		return err
+	}
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(m.syncInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-m.ctx.Done():
+				return
+			case <-ticker.C:
+				if err := m.sync(m.ctx); err != nil {
+					log.Warn("failed to sync ingestion connectors", zap.Error(err))
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// sync starts the connectors in the meta but not running on current proxy,
+// and stops the running connectors that are removed from the meta.
+func (m *Manager) sync(ctx context.Context) error {
+	cfgs, err := m.store.ListConnectors(ctx)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	expected := make(map[string]struct{}, len(cfgs))
+	for _, cfg := range cfgs {
+		expected[cfg.Name] = struct{}{}
+		if _, ok := m.connectors[cfg.Name]; ok {
+			continue
+		}
+		c, err := newConnector(cfg, m.store, m.sink)
+		if err != nil {
+			// keep the other connectors working, the broken connector can be dropped and created again.
+			log.Ctx(ctx).Warn("failed to start ingestion connector", zap.String("connector", cfg.Name), zap.Error(err))
+			continue
+		}
+		m.connectors[cfg.Name] = c
+		log.Ctx(ctx).Info("ingestion connector started", zap.String("connector", cfg.Name))
+	}
+	for name, c := range m.connectors {
+		if _, ok := expected[name]; !ok {
+			c.Close()
+			delete(m.connectors, name)
+			log.Ctx(ctx).Info("ingestion connector stopped since it's dropped", zap.String("connector", name))
+		}
+	}
+	return nil
+}
+
+// Create validates, persists and starts a new connector.
+func (m *Manager) Create(ctx context.Context, cfg *ConnectorConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if _, err := m.sink.GetCollectionSchema(ctx, cfg.DBName, cfg.CollectionName); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.connectors[cfg.Name]; ok {
+		return merr.WrapErrParameterInvalidMsg("ingestion connector %s already exists", cfg.Name)
+	}
+	if err := m.store.CreateConnector(ctx, cfg); err != nil {
+		return err
+	}
+	c, err := newConnector(cfg, m.store, m.sink)
+	if err != nil {
+		if err := m.store.RemoveConnector(ctx, cfg.Name); err != nil {
+			log.Ctx(ctx).Warn("failed to remove the connector that can not be started", zap.String("connector", cfg.Name), zap.Error(err))
+		}
+		return err
+	}
+	m.connectors[cfg.Name] = c
+	log.Ctx(ctx).Info("ingestion connector created", zap.String("connector", cfg.Name), zap.String("topic", cfg.Topic))
+	return nil
+}
+
+// Drop removes the configuration and offsets of the connector, and stops the connector on current proxy.
+// The connector can be dropped on any proxy, the connector on other proxies can not commit any batch once it's dropped,
+// and is stopped at their next sync.
+func (m *Manager) Drop(ctx context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, running := m.connectors[name]
+	exist, err := m.store.HasConnector(ctx, name)
+	if err != nil {
+		return err
+	}
+	if !exist && !running {
+		return merr.WrapErrParameterInvalidMsg("ingestion connector %s not found", name)
+	}
+	if err := m.store.RemoveConnector(ctx, name); err != nil {
+		return err
+	}
+	if running {
+		c.Close()
+		delete(m.connectors, name)
+	}
+	log.Ctx(ctx).Info("ingestion connector dropped", zap.String("connector", name))
+	return nil
+}
+
+// List returns the configurations of all connectors.
+func (m *Manager) List() []*ConnectorConfig {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cfgs := make([]*ConnectorConfig, 0, len(m.connectors))
+	for _, c := range m.connectors {
+		cfgs = append(cfgs, c.Config())
+	}
+	sort.Slice(cfgs, func(i, j int) bool {
+		return cfgs[i].Name < cfgs[j].Name
+	})
+	return cfgs
+}
+
+// Status returns the status of the connector on current proxy.
+func (m *Manager) Status(name string) (ConnectorStatus, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.connectors[name]
+	if !ok {
+		return ConnectorStatus{}, merr.WrapErrParameterInvalidMsg("ingestion connector %s not found", name)
+	}
+	return c.Status(), nil
+}
+
+// Close stops all connectors, the configurations and offsets are kept.
+func (m *Manager) Close() {
+	m.cancel()
+	m.wg.Wait()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, c := range m.connectors {
+		c.Close()
+	}
+	m.connectors = make(map[string]*connector)
+}
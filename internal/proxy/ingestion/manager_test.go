@@ -0,0 +1,180 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingestion
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/kv"
+	"github.com/milvus-io/milvus/pkg/v2/kv/predicates"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+type testSink struct {
+	kv      kv.TxnKV
+	mu      sync.Mutex
+	pks     []int64
+	failure atomic.Int32 // the number of following writes that should fail.
+}
+
+func (s *testSink) GetCollectionSchema(ctx context.Context, dbName string, collectionName string) (*schemapb.CollectionSchema, error) {
+	if collectionName != "coll" {
+		return nil, merr.WrapErrCollectionNotFound(collectionName)
+	}
+	return newTestSchema(false), nil
+}
+
+func (s *testSink) Write(ctx context.Context, cfg *ConnectorConfig, fieldsData []*schemapb.FieldData, numRows uint32, saves map[string]string, preds []predicates.Predicate) error {
+	if s.failure.Load() > 0 {
+		s.failure.Dec()
+		return errors.New("mock write failure")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// the rows are visible only if the offsets are committed, same as the transaction of proxy.
+	if err := s.kv.MultiSaveAndRemove(ctx, saves, nil, preds...); err != nil {
+		return err
+	}
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldName() == "pk" {
+			s.pks = append(s.pks, fieldData.GetScalars().GetLongData().GetData()...)
+		}
+	}
+	return nil
+}
+
+func (s *testSink) PKs() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]int64{}, s.pks...)
+}
+
+func produce(t *testing.T, producer *kafka.Producer, topic string, values ...string) {
+	for _, value := range values {
+		err := producer.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+			Value:          []byte(value),
+		}, nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 0, producer.Flush(10000))
+}
+
+func totalOffsets(t *testing.T, s *store, name string) int64 {
+	offsets, err := s.LoadOffsets(context.Background(), name)
+	require.NoError(t, err)
+	total := int64(0)
+	for _, offset := range offsets {
+		total += offset.Offset
+	}
+	return total
+}
+
+func TestManager(t *testing.T) {
+	cluster, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer cluster.Close()
+
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": cluster.BootstrapServers()})
+	require.NoError(t, err)
+	defer producer.Close()
+
+	topic := "ingestion_test"
+	rows := make([]string, 0, 5)
+	for i := 1; i <= 5; i++ {
+		rows = append(rows, fmt.Sprintf(`{"pk": %d, "vector": [0.1, 0.2], "text": "row-%d"}`, i, i))
+	}
+	produce(t, producer, topic, rows...)
+
+	ctx := context.Background()
+	txnKV := newTestKV()
+	sink := &testSink{kv: txnKV}
+	sink.failure.Store(1)
+	m := NewManager(txnKV, sink, time.Second)
+	require.NoError(t, m.Start())
+
+	cfg := &ConnectorConfig{
+		Name:            "c1",
+		Brokers:         cluster.BootstrapServers(),
+		Topic:           topic,
+		CollectionName:  "coll",
+		Format:          FormatJSON,
+		BatchSize:       2,
+		BatchIntervalMs: 100,
+	}
+	invalid := *cfg
+	invalid.CollectionName = "not_exist"
+	assert.Error(t, m.Create(ctx, &invalid))
+	assert.NoError(t, m.Create(ctx, cfg))
+	assert.Error(t, m.Create(ctx, cfg))
+	assert.Len(t, m.List(), 1)
+
+	// the failed batch is retried.
+	assert.Eventually(t, func() bool {
+		return len(sink.PKs()) == 5
+	}, 60*time.Second, 100*time.Millisecond)
+	assert.ElementsMatch(t, []int64{1, 2, 3, 4, 5}, sink.PKs())
+	assert.Eventually(t, func() bool {
+		return totalOffsets(t, m.store, "c1") == 5
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// the bad message is skipped.
+	produce(t, producer, topic, `{"pk": "bad"}`, `not a json`)
+	assert.Eventually(t, func() bool {
+		status, err := m.Status("c1")
+		return err == nil && status.FailedRows == 2 && status.Lag == 0
+	}, 30*time.Second, 100*time.Millisecond)
+	status, err := m.Status("c1")
+	assert.NoError(t, err)
+	assert.Equal(t, StateRunning, status.State)
+	assert.Equal(t, int64(5), status.WrittenRows)
+	assert.NotEmpty(t, status.Partitions)
+	assert.Equal(t, int64(7), totalOffsets(t, m.store, "c1"))
+
+	// the connector is recovered from the persisted offsets, no row is written twice.
+	m.Close()
+	produce(t, producer, topic, `{"pk": 6, "vector": [0.1, 0.2], "text": "row-6"}`)
+	m = NewManager(txnKV, sink, time.Second)
+	require.NoError(t, m.Start())
+	assert.Eventually(t, func() bool {
+		return len(sink.PKs()) >= 6 && totalOffsets(t, m.store, "c1") == 8
+	}, 60*time.Second, 100*time.Millisecond)
+	assert.ElementsMatch(t, []int64{1, 2, 3, 4, 5, 6}, sink.PKs())
+
+	// the connector can be dropped by any proxy, and it's stopped on other proxies at next sync.
+	other := NewManager(txnKV, sink, time.Second)
+	assert.NoError(t, other.Drop(ctx, "c1"))
+	assert.Eventually(t, func() bool {
+		return len(m.List()) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.Error(t, m.Drop(ctx, "c1"))
+	_, err = m.Status("c1")
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), totalOffsets(t, m.store, "c1"))
+	m.Close()
+}
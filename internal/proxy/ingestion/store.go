@@ -0,0 +1,211 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingestion
+
+import (
+	"context"
+	"path"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/kv"
+	"github.com/milvus-io/milvus/pkg/v2/kv/predicates"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+const (
+	connectorPrefix = "ingestion/connectors"
+	offsetPrefix    = "ingestion/offsets"
+)
+
+// store persists the connector configurations and the consumed offsets of connectors.
+// The offsets of kafka partitions are kept by milvus instead of the kafka consumer group,
+// so the offsets are only advanced with the rows appended into the wal in one transaction.
+type store struct {
+	kv kv.TxnKV
+}
+
+func newStore(txnKV kv.TxnKV) *store {
+	return &store{kv: txnKV}
+}
+
+// partitionOffset is the persisted consuming position of a kafka partition.
+type partitionOffset struct {
+	// Offset is the next offset to consume, -1 if the partition has never been committed.
+	Offset int64 `json:"offset"`
+	// Epoch is increased every time the partition is claimed by a connector instance.
+	Epoch int64 `json:"epoch"`
+	// Owner is the connector instance which claims the partition at the epoch.
+	Owner string `json:"owner"`
+
+	value string // the persisted value, used as the fence of the offset commit.
+}
+
+// CreateConnector saves the configuration of a new connector, fails if the connector exists.
+func (s *store) CreateConnector(ctx context.Context, cfg *ConnectorConfig) error {
+	key := connectorKey(cfg.Name)
+	exist, err := s.kv.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return merr.WrapErrParameterInvalidMsg("ingestion connector %s already exists", cfg.Name)
+	}
+	value, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	if err := s.kv.Save(ctx, key, string(value)); err != nil {
+		return err
+	}
+	cfg.value = string(value)
+	return nil
+}
+
+// ListConnectors loads the configurations of all connectors.
+func (s *store) ListConnectors(ctx context.Context) ([]*ConnectorConfig, error) {
+	_, values, err := s.kv.LoadWithPrefix(ctx, connectorPrefix+"/")
+	if err != nil {
+		return nil, err
+	}
+	cfgs := make([]*ConnectorConfig, 0, len(values))
+	for _, value := range values {
+		cfg := &ConnectorConfig{}
+		if err := json.Unmarshal([]byte(value), cfg); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal connector config")
+		}
+		cfg.value = value
+		cfgs = append(cfgs, cfg)
+	}
+	return cfgs, nil
+}
+
+// HasConnector checks if the connector exists.
+func (s *store) HasConnector(ctx context.Context, name string) (bool, error) {
+	return s.kv.Has(ctx, connectorKey(name))
+}
+
+// RemoveConnector removes the configuration and the offsets of connector.
+// The configuration is removed first, so the running instances of the connector can not commit offsets any more.
+func (s *store) RemoveConnector(ctx context.Context, name string) error {
+	if err := s.kv.Remove(ctx, connectorKey(name)); err != nil {
+		return err
+	}
+	return s.kv.RemoveWithPrefix(ctx, path.Join(offsetPrefix, name)+"/")
+}
+
+// LoadOffsets loads the consuming positions of all kafka partitions of connector.
+func (s *store) LoadOffsets(ctx context.Context, name string) (map[int32]partitionOffset, error) {
+	keys, values, err := s.kv.LoadWithPrefix(ctx, path.Join(offsetPrefix, name)+"/")
+	if err != nil {
+		return nil, err
+	}
+	offsets := make(map[int32]partitionOffset, len(keys))
+	for i, key := range keys {
+		partition, err := strconv.ParseInt(path.Base(key), 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid offset key %s", key)
+		}
+		offset := partitionOffset{}
+		if err := json.Unmarshal([]byte(values[i]), &offset); err != nil {
+			return nil, errors.Wrapf(err, "invalid offset value of key %s", key)
+		}
+		offset.value = values[i]
+		offsets[int32(partition)] = offset
+	}
+	return offsets, nil
+}
+
+// ClaimPartitions claims the kafka partitions for the connector instance by increasing the epochs of partitions,
+// which fences the offset commits of the previous owners. Returns the claimed positions to consume from.
+func (s *store) ClaimPartitions(ctx context.Context, cfg *ConnectorConfig, owner string, partitions []int32) (map[int32]partitionOffset, error) {
+	current, err := s.LoadOffsets(ctx, cfg.Name)
+	if err != nil {
+		return nil, err
+	}
+	claimed := make(map[int32]partitionOffset, len(partitions))
+	saves := make(map[string]string, len(partitions))
+	// the connector may be dropped by other proxies, never recreate its offsets.
+	preds := []predicates.Predicate{predicates.ValueEqual(connectorKey(cfg.Name), cfg.value)}
+	for _, partition := range partitions {
+		offset := partitionOffset{Offset: -1, Epoch: 1, Owner: owner}
+		if prev, ok := current[partition]; ok {
+			offset.Offset = prev.Offset
+			offset.Epoch = prev.Epoch + 1
+			preds = append(preds, predicates.ValueEqual(offsetKey(cfg.Name, partition), prev.value))
+		}
+		value, err := json.Marshal(offset)
+		if err != nil {
+			return nil, err
+		}
+		offset.value = string(value)
+		saves[offsetKey(cfg.Name, partition)] = offset.value
+		claimed[partition] = offset
+	}
+	if err := s.kv.MultiSaveAndRemove(ctx, saves, nil, preds...); err != nil {
+		return nil, errors.Wrap(err, "failed to claim kafka partitions")
+	}
+	return claimed, nil
+}
+
+// offsetCommit is the kv mutation to advance the offsets of the claimed partitions.
+type offsetCommit struct {
+	saves   map[string]string
+	preds   []predicates.Predicate
+	offsets map[int32]partitionOffset // the positions after the commit.
+}
+
+// NewOffsetCommit builds the mutation to advance the claimed partitions to the next offsets,
+// the mutation can only be applied if the partitions are still claimed by the connector instance.
+func (s *store) NewOffsetCommit(cfg *ConnectorConfig, claimed map[int32]partitionOffset, nextOffsets map[int32]int64) (*offsetCommit, error) {
+	commit := &offsetCommit{
+		saves:   make(map[string]string, len(nextOffsets)),
+		preds:   []predicates.Predicate{predicates.ValueEqual(connectorKey(cfg.Name), cfg.value)},
+		offsets: make(map[int32]partitionOffset, len(nextOffsets)),
+	}
+	for partition, next := range nextOffsets {
+		prev, ok := claimed[partition]
+		if !ok {
+			return nil, errors.Errorf("kafka partition %d is not claimed", partition)
+		}
+		offset := partitionOffset{Offset: next, Epoch: prev.Epoch, Owner: prev.Owner}
+		value, err := json.Marshal(offset)
+		if err != nil {
+			return nil, err
+		}
+		offset.value = string(value)
+		commit.saves[offsetKey(cfg.Name, partition)] = offset.value
+		commit.preds = append(commit.preds, predicates.ValueEqual(offsetKey(cfg.Name, partition), prev.value))
+		commit.offsets[partition] = offset
+	}
+	return commit, nil
+}
+
+// CommitOffsets applies the offset commit without any rows.
+func (s *store) CommitOffsets(ctx context.Context, commit *offsetCommit) error {
+	return s.kv.MultiSaveAndRemove(ctx, commit.saves, nil, commit.preds...)
+}
+
+func connectorKey(name string) string {
+	return path.Join(connectorPrefix, name)
+}
+
+func offsetKey(name string, partition int32) string {
+	return path.Join(offsetPrefix, name, strconv.FormatInt(int64(partition), 10))
+}
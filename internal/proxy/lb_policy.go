@@ -25,6 +25,7 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/v2/log"
@@ -37,20 +38,41 @@ import (
 type executeFunc func(context.Context, UniqueID, types.QueryNodeClient, string) error
 
 type ChannelWorkload struct {
-	db             string
-	collectionName string
-	collectionID   int64
-	channel        string
-	nq             int64
-	exec           executeFunc
+	db               string
+	collectionName   string
+	collectionID     int64
+	channel          string
+	nq               int64
+	consistencyLevel commonpb.ConsistencyLevel
+	exec             executeFunc
 }
 
 type CollectionWorkLoad struct {
-	db             string
-	collectionName string
-	collectionID   int64
-	nq             int64
-	exec           executeFunc
+	db               string
+	collectionName   string
+	collectionID     int64
+	nq               int64
+	consistencyLevel commonpb.ConsistencyLevel
+	exec             executeFunc
+}
+
+// allowFollower returns whether the workload could be served by the read-only follower replicas,
+// only the requests with relaxed consistency accept the staleness of follower replicas.
+func allowFollower(level commonpb.ConsistencyLevel) bool {
+	return level == commonpb.ConsistencyLevel_Eventually || level == commonpb.ConsistencyLevel_Bounded
+}
+
+type servePartialKey struct{}
+
+// withServePartial marks the workload accepts to be served by the loaded segments of a loading collection.
+func withServePartial(ctx context.Context) context.Context {
+	return context.WithValue(ctx, servePartialKey{}, true)
+}
+
+// isServePartialWorkload returns whether the shard leaders of a loading collection could serve the workload.
+func isServePartialWorkload(ctx context.Context) bool {
+	servePartial, _ := ctx.Value(servePartialKey{}).(bool)
+	return servePartial
 }
 
 type LBPolicy interface {
@@ -176,8 +198,11 @@ func (lb *LBPolicyImpl) selectNode(ctx context.Context, balancer LBBalancer, wor
 			}
 		}()
 
-		// Filter nodes based on excludeNodes
+		// Filter nodes based on excludeNodes, follower replicas are only available for relaxed consistency
 		for _, node := range shardLeaders {
+			if node.follower && !allowFollower(workload.consistencyLevel) {
+				continue
+			}
 			if !excludeNodes.Contain(node.nodeID) {
 				if node.serviceable {
 					serviceableNodes[node.nodeID] = node
@@ -311,12 +336,13 @@ func (lb *LBPolicyImpl) Execute(ctx context.Context, workload CollectionWorkLoad
 	for _, channel := range channelList {
 		wg.Go(func() error {
 			return lb.ExecuteWithRetry(ctx, ChannelWorkload{
-				db:             workload.db,
-				collectionName: workload.collectionName,
-				collectionID:   workload.collectionID,
-				channel:        channel,
-				nq:             workload.nq,
-				exec:           workload.exec,
+				db:               workload.db,
+				collectionName:   workload.collectionName,
+				collectionID:     workload.collectionID,
+				channel:          channel,
+				nq:               workload.nq,
+				consistencyLevel: workload.consistencyLevel,
+				exec:             workload.exec,
 			})
 		})
 	}
@@ -334,12 +360,13 @@ func (lb *LBPolicyImpl) ExecuteOneChannel(ctx context.Context, workload Collecti
 	// let every request could retry at least twice, which could retry after update shard leader cache
 	for _, channel := range channelList {
 		return lb.ExecuteWithRetry(ctx, ChannelWorkload{
-			db:             workload.db,
-			collectionName: workload.collectionName,
-			collectionID:   workload.collectionID,
-			channel:        channel,
-			nq:             workload.nq,
-			exec:           workload.exec,
+			db:               workload.db,
+			collectionName:   workload.collectionName,
+			collectionID:     workload.collectionID,
+			channel:          channel,
+			nq:               workload.nq,
+			consistencyLevel: workload.consistencyLevel,
+			exec:             workload.exec,
 		})
 	}
 	return fmt.Errorf("no acitvate sheard leader exist for collection: %s", workload.collectionName)
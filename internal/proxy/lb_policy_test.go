@@ -762,6 +762,124 @@ func (s *LBPolicySuite) TestSelectNodeWithExcludeClearing() {
 	s.Equal(1, excludeNodes.Len()) // Should NOT be cleared for empty shard leaders
 }
 
+func (s *LBPolicySuite) TestFollowerReplica() {
+	ctx := context.Background()
+
+	s.qc.(*MixCoordMock).GetShardLeadersFunc = func(ctx context.Context, req *querypb.GetShardLeadersRequest, opts ...grpc.CallOption) (*querypb.GetShardLeadersResponse, error) {
+		return &querypb.GetShardLeadersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+				ExtraInfo: map[string]string{common.FollowerNodesKey: "2,3"},
+			},
+			Shards: []*querypb.ShardLeadersList{
+				{
+					ChannelName: s.channels[0],
+					NodeIds:     []int64{1, 2, 3},
+					NodeAddrs:   []string{"localhost:9000", "localhost:9001", "localhost:9002"},
+					Serviceable: []bool{true, true, true},
+				},
+			},
+		}, nil
+	}
+	globalMetaCache.DeprecateShardCache(dbName, s.collectionName)
+
+	// strong consistency never goes to follower replicas
+	s.lbBalancer.ExpectedCalls = nil
+	s.lbBalancer.EXPECT().RegisterNodeInfo(mock.Anything)
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, []int64{1}, mock.Anything).Return(1, nil).Times(1)
+	targetNode, err := s.lbPolicy.selectNode(ctx, s.lbBalancer, ChannelWorkload{
+		db:               dbName,
+		collectionName:   s.collectionName,
+		collectionID:     s.collectionID,
+		channel:          s.channels[0],
+		nq:               1,
+		consistencyLevel: commonpb.ConsistencyLevel_Strong,
+	}, &typeutil.UniqueSet{})
+	s.NoError(err)
+	s.False(targetNode.follower)
+
+	// eventually consistency could be served by follower replicas
+	s.lbBalancer.ExpectedCalls = nil
+	s.mgr.ExpectedCalls = nil
+	s.mgr.EXPECT().GetClient(mock.Anything, mock.Anything).Return(s.qn, nil)
+	s.lbBalancer.EXPECT().RegisterNodeInfo(mock.Anything)
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).Return(2, nil)
+	s.lbBalancer.EXPECT().CancelWorkload(mock.Anything, mock.Anything)
+	err = s.lbPolicy.ExecuteWithRetry(ctx, ChannelWorkload{
+		db:               dbName,
+		collectionName:   s.collectionName,
+		collectionID:     s.collectionID,
+		channel:          s.channels[0],
+		nq:               1,
+		consistencyLevel: commonpb.ConsistencyLevel_Eventually,
+		exec: func(ctx context.Context, nodeID UniqueID, qn types.QueryNodeClient, channel string) error {
+			s.EqualValues(2, nodeID)
+			return nil
+		},
+	})
+	s.NoError(err)
+
+	// the bounded reads served by follower replicas return the growing rows
+	growing := &internalpb.SearchResults{Status: merr.Success(), NumQueries: 1, TopK: 1}
+	sealed := &internalpb.SearchResults{Status: merr.Success(), NumQueries: 1}
+	s.lbBalancer.ExpectedCalls = nil
+	s.lbBalancer.EXPECT().RegisterNodeInfo(mock.Anything)
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).Return(2, nil)
+	s.lbBalancer.EXPECT().CancelWorkload(mock.Anything, mock.Anything)
+	s.lbBalancer.EXPECT().UpdateCostMetrics(mock.Anything, mock.Anything)
+	s.qn.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *querypb.SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error) {
+		if req.GetReq().GetIgnoreGrowing() {
+			return sealed, nil
+		}
+		return growing, nil
+	}).Once()
+	task := &searchTask{
+		SearchRequest: &internalpb.SearchRequest{Base: &commonpb.MsgBase{}},
+		request:       &milvuspb.SearchRequest{},
+		lb:            s.lbPolicy,
+		resultBuf:     typeutil.NewConcurrentSet[*internalpb.SearchResults](),
+	}
+	err = s.lbPolicy.ExecuteWithRetry(ctx, ChannelWorkload{
+		db:               dbName,
+		collectionName:   s.collectionName,
+		collectionID:     s.collectionID,
+		channel:          s.channels[0],
+		nq:               1,
+		consistencyLevel: commonpb.ConsistencyLevel_Bounded,
+		exec:             task.searchShard,
+	})
+	s.NoError(err)
+	s.Equal([]*internalpb.SearchResults{growing}, task.resultBuf.Collect())
+
+	// no leader is available if all of them are followers
+	s.qc.(*MixCoordMock).GetShardLeadersFunc = func(ctx context.Context, req *querypb.GetShardLeadersRequest, opts ...grpc.CallOption) (*querypb.GetShardLeadersResponse, error) {
+		return &querypb.GetShardLeadersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+				ExtraInfo: map[string]string{common.FollowerNodesKey: "2"},
+			},
+			Shards: []*querypb.ShardLeadersList{
+				{
+					ChannelName: s.channels[0],
+					NodeIds:     []int64{2},
+					NodeAddrs:   []string{"localhost:9001"},
+					Serviceable: []bool{true},
+				},
+			},
+		}, nil
+	}
+	globalMetaCache.DeprecateShardCache(dbName, s.collectionName)
+	_, err = s.lbPolicy.selectNode(ctx, s.lbBalancer, ChannelWorkload{
+		db:               dbName,
+		collectionName:   s.collectionName,
+		collectionID:     s.collectionID,
+		channel:          s.channels[0],
+		nq:               1,
+		consistencyLevel: commonpb.ConsistencyLevel_Session,
+	}, &typeutil.UniqueSet{})
+	s.ErrorIs(err, merr.ErrChannelNotAvailable)
+}
+
 func TestLBPolicySuite(t *testing.T) {
 	suite.Run(t, new(LBPolicySuite))
 }
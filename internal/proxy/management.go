@@ -31,6 +31,8 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 // this file contains proxy management restful API handler
@@ -86,6 +88,26 @@ func RegisterMgrRoute(proxy *Proxy) {
 			Path:        management.RouteQueryCoordBalanceStatus,
 			HandlerFunc: proxy.CheckQueryCoordBalanceStatus,
 		})
+		management.Register(&management.Handler{
+			Path:        management.RouteQueryCoordBalancePlan,
+			HandlerFunc: proxy.GetQueryCoordBalancePlan,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteCreateIngestionConnector,
+			HandlerFunc: proxy.CreateIngestionConnector,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteDropIngestionConnector,
+			HandlerFunc: proxy.DropIngestionConnector,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteListIngestionConnectors,
+			HandlerFunc: proxy.ListIngestionConnectors,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteIngestionConnectorStatus,
+			HandlerFunc: proxy.GetIngestionConnectorStatus,
+		})
 	})
 }
 
@@ -276,6 +298,53 @@ func (node *Proxy) CheckQueryCoordBalanceStatus(w http.ResponseWriter, req *http
 	w.Write([]byte(fmt.Sprintf(`{"msg": "OK", "status": "%v"}`, balanceStatus)))
 }
 
+// GetQueryCoordBalancePlan returns the plans of a balance round simulated by querycoord, no task will be submitted.
+// `balancer` selects the balancer to simulate, and `collection_id` limits the simulation to one collection.
+func (node *Proxy) GetQueryCoordBalancePlan(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get balance plan, %s"}`, err.Error())))
+		return
+	}
+
+	params := map[string]interface{}{
+		metricsinfo.MetricTypeKey:                 metricsinfo.BalancePlanKey,
+		metricsinfo.MetricRequestProcessInRoleKey: typeutil.QueryCoordRole,
+		metricsinfo.MetricRequestParamBalancerKey: req.FormValue("balancer"),
+	}
+	if req.FormValue("collection_id") != "" {
+		collectionID, err := strconv.ParseInt(req.FormValue("collection_id"), 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get balance plan, %s"}`, err.Error())))
+			return
+		}
+		params[metricsinfo.MetricRequestParamCollectionIDKey] = collectionID
+	}
+	metricsReq, err := metricsinfo.ConstructGetMetricsRequest(params)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get balance plan, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.mixCoord.GetMetrics(req.Context(), metricsReq)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get balance plan, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get balance plan, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(resp.GetResponse()))
+}
+
 func (node *Proxy) SuspendQueryNode(w http.ResponseWriter, req *http.Request) {
 	err := req.ParseForm()
 	if err != nil {
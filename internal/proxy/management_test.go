@@ -29,6 +29,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	management "github.com/milvus-io/milvus/internal/http"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
@@ -430,6 +431,74 @@ func (s *ProxyManagementSuite) TestCheckBalanceStatus() {
 	})
 }
 
+func (s *ProxyManagementSuite) TestGetBalancePlan() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().GetMetrics(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+			s.Contains(req.GetRequest(), `"balancer":"ScoreBasedBalancer"`)
+			s.Contains(req.GetRequest(), `"collection_id":1`)
+			return &milvuspb.GetMetricsResponse{
+				Status:   merr.Success(),
+				Response: `{"balancer":"ScoreBasedBalancer"}`,
+			}, nil
+		})
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteQueryCoordBalancePlan, strings.NewReader("balancer=ScoreBasedBalancer&collection_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetQueryCoordBalancePlan(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"balancer":"ScoreBasedBalancer"}`, recorder.Body.String())
+	})
+
+	s.Run("invalid_collection_id", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteQueryCoordBalancePlan, strings.NewReader("collection_id=abc"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetQueryCoordBalancePlan(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().GetMetrics(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteQueryCoordBalancePlan, nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetQueryCoordBalancePlan(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().GetMetrics(mock.Anything, mock.Anything).Return(&milvuspb.GetMetricsResponse{
+			Status: merr.Status(merr.ErrServiceNotReady),
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteQueryCoordBalancePlan, nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetQueryCoordBalancePlan(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
 func (s *ProxyManagementSuite) TestSuspendQueryNode() {
 	s.Run("normal", func() {
 		s.SetupTest()
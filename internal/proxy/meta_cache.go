@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/reduce"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
@@ -1007,6 +1008,9 @@ func (m *MetaCache) updateShardLocationCache(ctx context.Context, database, coll
 		CollectionID:            collectionID,
 		WithUnserviceableShards: true,
 	}
+	if isServePartialWorkload(ctx) {
+		reduce.SetServePartial(req.GetBase())
+	}
 	resp, err := m.mixCoord.GetShardLeaders(ctx, req)
 	if err := merr.CheckRPCCall(resp.GetStatus(), err); err != nil {
 		log.Error("failed to get shard locations",
@@ -1015,7 +1019,7 @@ func (m *MetaCache) updateShardLocationCache(ctx context.Context, database, coll
 		return nil, err
 	}
 
-	shards := parseShardLeaderList2QueryNode(resp.GetShards())
+	shards := parseShardLeaderList2QueryNode(resp.GetShards(), parseFollowerNodes(resp.GetStatus()))
 
 	// convert shards map to string for logging
 	if log.Logger.Level() == zap.DebugLevel {
@@ -1035,6 +1039,10 @@ func (m *MetaCache) updateShardLocationCache(ctx context.Context, database, coll
 		shardLeaders: shards,
 		idx:          atomic.NewInt64(0),
 	}
+	if _, ok := resp.GetStatus().GetExtraInfo()[common.ServePartialKey]; ok {
+		// the collection is not fully loaded, the shard leaders only serve the requests in serve partial mode
+		return newShardLeaders, nil
+	}
 
 	m.leaderMut.Lock()
 	if _, ok := m.collLeader[database]; !ok {
@@ -1046,14 +1054,20 @@ func (m *MetaCache) updateShardLocationCache(ctx context.Context, database, coll
 	return newShardLeaders, nil
 }
 
-func parseShardLeaderList2QueryNode(shardsLeaders []*querypb.ShardLeadersList) map[string][]nodeInfo {
+func parseShardLeaderList2QueryNode(shardsLeaders []*querypb.ShardLeadersList, followers typeutil.UniqueSet) map[string][]nodeInfo {
 	shard2QueryNodes := make(map[string][]nodeInfo)
 
 	for _, leaders := range shardsLeaders {
 		qns := make([]nodeInfo, len(leaders.GetNodeIds()))
 
 		for j := range qns {
-			qns[j] = nodeInfo{leaders.GetNodeIds()[j], leaders.GetNodeAddrs()[j], leaders.GetServiceable()[j]}
+			nodeID := leaders.GetNodeIds()[j]
+			qns[j] = nodeInfo{
+				nodeID:      nodeID,
+				address:     leaders.GetNodeAddrs()[j],
+				serviceable: leaders.GetServiceable()[j],
+				follower:    followers.Contain(nodeID),
+			}
 		}
 
 		shard2QueryNodes[leaders.GetChannelName()] = qns
@@ -1062,6 +1076,24 @@ func parseShardLeaderList2QueryNode(shardsLeaders []*querypb.ShardLeadersList) m
 	return shard2QueryNodes
 }
 
+// parseFollowerNodes parses the nodes of read-only follower replicas from the GetShardLeaders response status.
+func parseFollowerNodes(status *commonpb.Status) typeutil.UniqueSet {
+	followers := typeutil.NewUniqueSet()
+	value, ok := status.GetExtraInfo()[common.FollowerNodesKey]
+	if !ok {
+		return followers
+	}
+	for _, str := range strings.Split(value, ",") {
+		nodeID, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			log.Warn("invalid follower node in shard leaders response", zap.String("value", value), zap.Error(err))
+			continue
+		}
+		followers.Insert(nodeID)
+	}
+	return followers
+}
+
 // used for Garbage collection shard client
 func (m *MetaCache) ListShardLocation() map[int64]nodeInfo {
 	m.leaderMut.RLock()
@@ -36,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/reduce"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
@@ -1422,6 +1423,70 @@ func TestMetaCache_GetShard(t *testing.T) {
 	})
 }
 
+func TestMetaCache_GetShardServePartial(t *testing.T) {
+	var (
+		ctx            = context.Background()
+		collectionName = "collection1"
+		collectionID   = int64(1)
+	)
+
+	rootCoord := &MockMixCoordClientInterface{}
+	mgr := newShardClientMgr()
+	err := InitMetaCache(ctx, rootCoord, mgr)
+	require.Nil(t, err)
+
+	shardLeadersResp := func(partial bool) *querypb.GetShardLeadersResponse {
+		resp := &querypb.GetShardLeadersResponse{
+			Status: merr.Success(),
+			Shards: []*querypb.ShardLeadersList{
+				{
+					ChannelName: "channel-1",
+					NodeIds:     []int64{1},
+					NodeAddrs:   []string{"localhost:9000"},
+					Serviceable: []bool{false},
+				},
+			},
+		}
+		if partial {
+			resp.Status.ExtraInfo = map[string]string{common.ServePartialKey: "true"}
+		}
+		return resp
+	}
+
+	var calls int
+	rootCoord.getShardLeaders = func(ctx context.Context, in *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+		calls++
+		if !reduce.IsServePartial(in.GetBase()) {
+			return &querypb.GetShardLeadersResponse{
+				Status: merr.Status(merr.WrapErrCollectionNotFullyLoaded(collectionID)),
+			}, nil
+		}
+		return shardLeadersResp(true), nil
+	}
+
+	// the loading collection is rejected without serve partial
+	_, err = globalMetaCache.GetShard(ctx, true, dbName, collectionName, collectionID, "channel-1")
+	assert.ErrorIs(t, err, merr.ErrCollectionNotFullyLoaded)
+
+	// the shard leaders of a loading collection are returned in serve partial mode, but not cached
+	partialCtx := withServePartial(ctx)
+	for i := 0; i < 2; i++ {
+		shards, err := globalMetaCache.GetShard(partialCtx, true, dbName, collectionName, collectionID, "channel-1")
+		assert.NoError(t, err)
+		assert.Len(t, shards, 1)
+	}
+	assert.Equal(t, 3, calls)
+	assert.Nil(t, globalMetaCache.(*MetaCache).getCachedShardLeaders(dbName, collectionName, "test"))
+
+	// the shard leaders are cached once the collection is fully loaded
+	rootCoord.getShardLeaders = func(ctx context.Context, in *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+		return shardLeadersResp(false), nil
+	}
+	_, err = globalMetaCache.GetShard(partialCtx, true, dbName, collectionName, collectionID, "channel-1")
+	assert.NoError(t, err)
+	assert.NotNil(t, globalMetaCache.(*MetaCache).getCachedShardLeaders(dbName, collectionName, "test"))
+}
+
 func TestMetaCache_ClearShards(t *testing.T) {
 	var (
 		ctx            = context.TODO()
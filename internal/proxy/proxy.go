@@ -35,7 +35,10 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/proxy/connection"
+	"github.com/milvus-io/milvus/internal/proxy/ingestion"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
@@ -132,6 +135,9 @@ type Proxy struct {
 	enableComplexDeleteLimit bool
 
 	slowQueries *expirable.LRU[Timestamp, *metricsinfo.SlowQuery]
+
+	// kafka ingestion connectors
+	ingestionManager *ingestion.Manager
 }
 
 // NewProxy returns a Proxy struct.
@@ -446,6 +452,29 @@ func (node *Proxy) Start() error {
 	log.Debug("update state code", zap.String("role", typeutil.ProxyRole), zap.String("State", commonpb.StateCode_Healthy.String()))
 	node.UpdateStateCode(commonpb.StateCode_Healthy)
 
+	metaKV := etcdkv.NewEtcdKV(node.etcdCli, paramtable.Get().EtcdCfg.MetaRootPath.GetValue(),
+		etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+	if streamingutil.IsStreamingServiceEnabled() {
+		globalTxnManager = newTxnManager(metaKV, streaming.WAL())
+		globalTxnManager.Start()
+		log.Info("start user transaction manager done")
+	}
+
+	if paramtable.Get().ProxyCfg.IngestionEnabled.GetAsBool() && globalTxnManager == nil {
+		log.Warn("kafka ingestion connectors are not started since they require the streaming service")
+	} else if paramtable.Get().ProxyCfg.IngestionEnabled.GetAsBool() {
+		node.ingestionManager = ingestion.NewManager(
+			metaKV,
+			&ingestionSink{node: node},
+			paramtable.Get().ProxyCfg.IngestionSyncInterval.GetAsDurationByParse(),
+		)
+		if err := node.ingestionManager.Start(); err != nil {
+			log.Warn("failed to start kafka ingestion connectors", zap.Error(err))
+			return err
+		}
+		log.Info("start kafka ingestion connectors done")
+	}
+
 	// register devops api
 	RegisterMgrRoute(node)
 
@@ -455,6 +484,15 @@ func (node *Proxy) Start() error {
 // Stop stops a proxy node.
 func (node *Proxy) Stop() error {
 	log := log.Ctx(node.ctx)
+	if node.ingestionManager != nil {
+		// stop the connectors before the dml path is closed, the pending batches are written.
+		node.ingestionManager.Close()
+		log.Info("close kafka ingestion connectors", zap.String("role", typeutil.ProxyRole))
+	}
+	if globalTxnManager != nil {
+		globalTxnManager.Close()
+		log.Info("close user transaction manager", zap.String("role", typeutil.ProxyRole))
+	}
 	if node.rowIDAllocator != nil {
 		node.rowIDAllocator.Close()
 		log.Info("close id allocator", zap.String("role", typeutil.ProxyRole))
@@ -14,6 +14,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
@@ -437,6 +438,26 @@ func parseGroupByInfo(searchParamsPair []*commonpb.KeyValuePair, schema *schemap
 			return nil, merr.WrapErrFieldNotFound(groupByFieldName, "groupBy field not found in schema")
 		}
 	}
+
+	// group by the parent id of the TextChunking function, the hits of the chunks are grouped back to the documents
+	groupByParentStr, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByParentKey, searchParamsPair)
+	if err == nil {
+		groupByParent, err := strconv.ParseBool(groupByParentStr)
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg(
+				fmt.Sprintf("failed to parse input group by parent:%s", groupByParentStr))
+		}
+		if groupByParent {
+			if groupByFieldId != -1 {
+				return nil, merr.WrapErrParameterInvalidMsg(
+					fmt.Sprintf("%s and %s cannot be used together", GroupByFieldKey, GroupByParentKey))
+			}
+			groupByFieldId, err = getParentFieldID(schema)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	ret.groupByFieldId = groupByFieldId
 
 	// 2. parse group size
@@ -476,6 +497,21 @@ func parseGroupByInfo(searchParamsPair []*commonpb.KeyValuePair, schema *schemap
 	return ret, nil
 }
 
+// getParentFieldID returns the id of the parent id field, which is the output field of the TextChunking function.
+func getParentFieldID(schema *schemapb.CollectionSchema) (int64, error) {
+	fSchema := function.GetTextChunkingFunction(schema.GetFunctions())
+	if fSchema == nil {
+		return -1, merr.WrapErrParameterInvalidMsg(
+			fmt.Sprintf("%s requires a TextChunking function in the collection", GroupByParentKey))
+	}
+	for _, field := range schema.GetFields() {
+		if field.GetName() == fSchema.GetOutputFieldNames()[0] {
+			return field.GetFieldID(), nil
+		}
+	}
+	return -1, merr.WrapErrFieldNotFound(fSchema.GetOutputFieldNames()[0], "parent field of TextChunking function not found in schema")
+}
+
 // parseRankParams get limit and offset from rankParams, both are optional.
 func parseRankParams(rankParamsPair []*commonpb.KeyValuePair, schema *schemapb.CollectionSchema) (*rankParams, error) {
 	var (
@@ -23,10 +23,12 @@ type nodeInfo struct {
 	nodeID      UniqueID
 	address     string
 	serviceable bool
+	// follower is true if the node belongs to a read-only follower replica
+	follower bool
 }
 
 func (n nodeInfo) String() string {
-	return fmt.Sprintf("<NodeID: %d, serviceable: %v, address: %s>", n.nodeID, n.serviceable, n.address)
+	return fmt.Sprintf("<NodeID: %d, serviceable: %v, follower: %v, address: %s>", n.nodeID, n.serviceable, n.follower, n.address)
 }
 
 var errClosed = errors.New("client is closed")
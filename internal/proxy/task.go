@@ -52,10 +52,12 @@ const (
 
 const (
 	IgnoreGrowingKey     = "ignore_growing"
+	ServePartialKey      = common.ServePartialKey
 	ReduceStopForBestKey = "reduce_stop_for_best"
 	IteratorField        = "iterator"
 	CollectionID         = "collection_id"
 	GroupByFieldKey      = "group_by_field"
+	GroupByParentKey     = "group_by_parent"
 	GroupSizeKey         = "group_size"
 	StrictGroupSize      = "strict_group_size"
 	RankGroupScorer      = "rank_group_scorer"
@@ -3066,6 +3068,20 @@ func (t *RunAnalyzerTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// isServePartial is used to check if the request could be served by the loaded segments only
+func isServePartial(params []*commonpb.KeyValuePair) (bool, error) {
+	for _, kv := range params {
+		if kv.GetKey() == ServePartialKey {
+			servePartial, err := strconv.ParseBool(kv.GetValue())
+			if err != nil {
+				return false, merr.WrapErrParameterInvalidMsg("parse %s failed: %s", ServePartialKey, kv.GetValue())
+			}
+			return servePartial, nil
+		}
+	}
+	return false, nil
+}
+
 // isIgnoreGrowing is used to check if the request should ignore growing
 func isIgnoreGrowing(params []*commonpb.KeyValuePair) (bool, error) {
 	for _, kv := range params {
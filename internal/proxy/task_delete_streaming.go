@@ -7,7 +7,6 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 
-	"github.com/milvus-io/milvus/internal/distributed/streaming"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
@@ -68,7 +67,7 @@ func (dt *deleteTaskByStreamingService) Execute(ctx context.Context) (err error)
 		zap.Int64("taskID", dt.ID()),
 		zap.Duration("prepare duration", dt.tr.RecordSpan()))
 
-	resp := streaming.WAL().AppendMessages(ctx, msgs...)
+	resp := appendDmlMessages(ctx, dt.collectionID, msgs...)
 	if err := resp.UnwrapFirstError(); err != nil {
 		log.Ctx(ctx).Warn("append messages to wal failed", zap.Error(err))
 		return err
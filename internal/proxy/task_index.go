@@ -160,6 +160,12 @@ func (cit *createIndexTask) parseFunctionParamsToIndex(indexParamsMap map[string
 			return fmt.Errorf("index metric type of BM25 function output field must be BM25, got %s", metricType)
 		}
 
+	case schemapb.FunctionType_MinHash:
+		// the signatures of MinHash function output field are compared by MHJACCARD by default
+		if _, ok := indexParamsMap["metric_type"]; !ok {
+			indexParamsMap["metric_type"] = metric.MHJACCARD
+		}
+
 	default:
 		return nil
 	}
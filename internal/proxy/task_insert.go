@@ -40,6 +40,8 @@ type insertTask struct {
 	schema          *schemapb.CollectionSchema
 	partitionKeys   *schemapb.FieldData
 	schemaTimestamp uint64
+	// the parent ids of the documents chunked by the TextChunking function
+	parentIDs []int64
 }
 
 // TraceCtx returns insertTask context
@@ -173,11 +175,16 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		// the parent ids of the chunked documents
+		exec.SetIDAllocator(it.idAllocator)
+		// the chunks expand the request, so the size is checked again before they are embedded
+		exec.SetMaxInsertSize(maxInsertSize)
 		sp.AddEvent("Create-function-udf")
 		if err := exec.ProcessInsert(ctx, it.insertMsg); err != nil {
 			return err
 		}
 		sp.AddEvent("Call-function-udf")
+		it.parentIDs = exec.GetParentIDs()
 	}
 	rowNums := uint32(it.insertMsg.NRows())
 	// set insertTask.rowIDs
@@ -352,5 +359,19 @@ func (it *insertTask) Execute(ctx context.Context) error {
 }
 
 func (it *insertTask) PostExecute(ctx context.Context) error {
+	// the rows of the request are the chunks of the documents, the documents are returned by their
+	// parent ids instead of the primary keys of the chunks
+	if it.parentIDs != nil {
+		it.result.IDs = &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{Data: it.parentIDs},
+			},
+		}
+		succIndex := make([]uint32, len(it.parentIDs))
+		for i := range succIndex {
+			succIndex[i] = uint32(i)
+		}
+		it.result.SuccIndex = succIndex
+	}
 	return nil
 }
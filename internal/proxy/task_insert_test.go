@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"context"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -456,6 +457,126 @@ func TestInsertTask_Function(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestInsertTask_TextChunking(t *testing.T) {
+	paramtable.Init()
+	collectionName := "TestInsertTask_TextChunking"
+	schema := &schemapb.CollectionSchema{
+		Name:   collectionName,
+		AutoID: true,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, AutoID: true},
+			{
+				FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "max_length", Value: "200"},
+				},
+			},
+			{FieldID: 102, Name: "parent", DataType: schemapb.DataType_Int64, IsFunctionOutput: true},
+		},
+		Functions: []*schemapb.FunctionSchema{
+			{
+				Name:             "chunking",
+				Type:             schemapb.FunctionType_TextChunking,
+				InputFieldIds:    []int64{101},
+				InputFieldNames:  []string{"text"},
+				OutputFieldIds:   []int64{102},
+				OutputFieldNames: []string{"parent"},
+				Params: []*commonpb.KeyValuePair{
+					{Key: "chunk_size", Value: "10"},
+					{Key: "chunk_overlap", Value: "2"},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rc := mocks.NewMockRootCoordClient(t)
+	rc.EXPECT().AllocID(mock.Anything, mock.Anything).Return(&rootcoordpb.AllocIDResponse{
+		Status: merr.Status(nil),
+		ID:     11198,
+		Count:  10,
+	}, nil)
+	idAllocator, err := allocator.NewIDAllocator(ctx, rc, 0)
+	assert.NoError(t, err)
+	idAllocator.Start()
+	defer idAllocator.Close()
+
+	info := newSchemaInfo(schema)
+	cache := NewMockCache(t)
+	cache.On("GetCollectionID", mock.Anything, mock.Anything, mock.Anything).Return(UniqueID(0), nil)
+	cache.On("GetCollectionSchema", mock.Anything, mock.Anything, mock.Anything).Return(info, nil)
+	cache.On("GetPartitionInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&partitionInfo{
+		name:        "p1",
+		partitionID: 10,
+	}, nil).Maybe()
+	cache.On("GetCollectionInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&collectionInfo{schema: info}, nil)
+	cache.On("GetDatabaseInfo", mock.Anything, mock.Anything).Return(&databaseInfo{properties: []*commonpb.KeyValuePair{}}, nil)
+	globalMetaCache = cache
+
+	newTask := func() *insertTask {
+		return &insertTask{
+			ctx: context.Background(),
+			insertMsg: &BaseInsertTask{
+				InsertRequest: &msgpb.InsertRequest{
+					CollectionName: collectionName,
+					DbName:         "hooooooo",
+					Base: &commonpb.MsgBase{
+						MsgType: commonpb.MsgType_Insert,
+					},
+					Version: msgpb.InsertDataVersion_ColumnBased,
+					FieldsData: []*schemapb.FieldData{
+						{
+							Type:      schemapb.DataType_VarChar,
+							FieldId:   101,
+							FieldName: "text",
+							Field: &schemapb.FieldData_Scalars{
+								Scalars: &schemapb.ScalarField{
+									Data: &schemapb.ScalarField_StringData{
+										StringData: &schemapb.StringArray{
+											Data: []string{"abcdefghijklmnop", "short"},
+										},
+									},
+								},
+							},
+						},
+					},
+					NumRows: 2,
+				},
+			},
+			schema:      schema,
+			idAllocator: idAllocator,
+		}
+	}
+
+	t.Run("return the parent ids", func(t *testing.T) {
+		task := newTask()
+		assert.NoError(t, task.PreExecute(ctx))
+		// the documents are chunked into 3 rows
+		assert.Equal(t, uint64(3), task.insertMsg.NRows())
+		assert.Equal(t, 3, len(task.result.GetIDs().GetIntId().GetData()))
+		var parents []int64
+		for _, field := range task.insertMsg.GetFieldsData() {
+			if field.GetFieldName() == "parent" {
+				parents = field.GetScalars().GetLongData().GetData()
+			}
+		}
+		assert.Equal(t, 3, len(parents))
+
+		assert.NoError(t, task.PostExecute(ctx))
+		assert.Equal(t, []int64{parents[0], parents[2]}, task.result.GetIDs().GetIntId().GetData())
+		assert.Equal(t, []uint32{0, 1}, task.result.GetSuccIndex())
+	})
+
+	t.Run("check the size of the chunks", func(t *testing.T) {
+		// the request fits in the limit before it is chunked
+		Params.Save(Params.QuotaConfig.MaxInsertSize.Key, strconv.Itoa(newTask().insertMsg.Size()))
+		defer Params.Reset(Params.QuotaConfig.MaxInsertSize.Key)
+		err := newTask().PreExecute(ctx)
+		assert.ErrorIs(t, err, merr.ErrParameterTooLarge)
+	})
+}
+
 func TestInsertTaskForSchemaMismatch(t *testing.T) {
 	cache := globalMetaCache
 	defer func() { globalMetaCache = cache }()
@@ -72,6 +72,8 @@ type queryTask struct {
 	fastSkip         bool
 
 	reQuery              bool
+	servePartial         bool
+	consistencyLevel     commonpb.ConsistencyLevel
 	allQueryCnt          int64
 	totalRelatedDataSize int64
 	mustUsePartitionKey  bool
@@ -370,6 +372,12 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if t.RetrieveRequest.IgnoreGrowing, err = isIgnoreGrowing(t.request.GetQueryParams()); err != nil {
 		return err
 	}
+	if t.servePartial, err = isServePartial(t.request.GetQueryParams()); err != nil {
+		return err
+	}
+	if t.servePartial {
+		reduce.SetServePartial(t.RetrieveRequest.GetBase())
+	}
 
 	queryParams, err := parseQueryParams(t.request.GetQueryParams())
 	if err != nil {
@@ -485,6 +493,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	}
 
 	t.GuaranteeTimestamp = guaranteeTs
+	t.consistencyLevel = consistencyLevel
 	// need modify mvccTs and guaranteeTs for iterator specially
 	if t.queryParams.isIterator && t.request.GetGuaranteeTimestamp() > 0 {
 		t.MvccTimestamp = t.request.GetGuaranteeTimestamp()
@@ -523,12 +532,16 @@ func (t *queryTask) Execute(ctx context.Context) error {
 		zap.String("requestType", "query"))
 
 	t.resultBuf = typeutil.NewConcurrentSet[*internalpb.RetrieveResults]()
+	if t.servePartial {
+		ctx = withServePartial(ctx)
+	}
 	err := t.lb.Execute(ctx, CollectionWorkLoad{
-		db:             t.request.GetDbName(),
-		collectionID:   t.CollectionID,
-		collectionName: t.collectionName,
-		nq:             1,
-		exec:           t.queryShard,
+		db:               t.request.GetDbName(),
+		collectionID:     t.CollectionID,
+		collectionName:   t.collectionName,
+		nq:               1,
+		consistencyLevel: t.consistencyLevel,
+		exec:             t.queryShard,
 	})
 	if err != nil {
 		log.Warn("fail to execute query", zap.Error(err))
@@ -588,6 +601,14 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 	t.result.PrimaryFieldName = primaryFieldSchema.GetName()
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
+	if t.servePartial {
+		coverage, _ := reduce.MergeCoverage(toReduceResults...)
+		if t.result.Status == nil {
+			t.result.Status = merr.Success()
+		}
+		reduce.SetCoverage(t.result.Status, coverage)
+	}
+
 	if t.queryParams.isIterator && t.request.GetGuaranteeTimestamp() == 0 {
 		// first page for iteration, need to set up sessionTs for iterator
 		t.result.SessionTs = getMaxMvccTsFromChannels(t.channelsMvcc, t.BeginTs())
@@ -72,6 +72,7 @@ type searchTask struct {
 	resultSizeInsufficient bool
 	isTopkReduce           bool
 	isRecallEvaluation     bool
+	servePartial           bool
 
 	translatedOutputFields []string
 	userOutputFields       []string
@@ -91,6 +92,9 @@ type searchTask struct {
 	// New reranker functions
 	functionScore *rerank.FunctionScore
 	rankParams    *rankParams
+	// the limit+offset of the search when the rerank picks from the candidates itself,
+	// the search topk may be raised to the candidate size of the rerank
+	rerankTopk int64
 
 	isIterator bool
 	// we always remove pk field from output fields, as search result already contains pk field.
@@ -199,6 +203,12 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	if t.SearchRequest.IgnoreGrowing, err = isIgnoreGrowing(t.request.SearchParams); err != nil {
 		return err
 	}
+	if t.servePartial, err = isServePartial(t.request.GetSearchParams()); err != nil {
+		return err
+	}
+	if t.servePartial {
+		reduce.SetServePartial(t.SearchRequest.GetBase())
+	}
 
 	outputFieldIDs, err := getOutputFieldIDs(t.schema, t.translatedOutputFields)
 	if err != nil {
@@ -404,6 +414,10 @@ func (t *searchTask) initAdvancedSearchRequest(ctx context.Context) error {
 			return err
 		}
 
+		if err := t.oversampleTopk(queryInfo); err != nil {
+			return err
+		}
+
 		ignoreGrowing := t.SearchRequest.IgnoreGrowing
 		if !ignoreGrowing {
 			// fetch ignore_growing from sub search param if not set in search request
@@ -549,6 +563,9 @@ func (t *searchTask) advancedPostProcess(ctx context.Context, span trace.Span, t
 
 func (t *searchTask) fillResult() {
 	limit := t.SearchRequest.GetTopk() - t.SearchRequest.GetOffset()
+	if t.rerankTopk > 0 {
+		limit = t.rerankTopk - t.SearchRequest.GetOffset()
+	}
 	resultSizeInsufficient := false
 	for _, topk := range t.result.Results.Topks {
 		if topk < limit {
@@ -691,6 +708,13 @@ func (t *searchTask) initSearchRequest(ctx context.Context) error {
 		if !t.functionScore.IsSupportGroup() && queryInfo.GetGroupByFieldId() > 0 {
 			return merr.WrapErrParameterInvalidMsg("Current rerank does not support grouping search")
 		}
+
+		if t.functionScore.GetCandidateSize() > 0 {
+			t.rerankTopk = queryInfo.GetTopk()
+			if err := t.oversampleTopk(queryInfo); err != nil {
+				return err
+			}
+		}
 	}
 
 	t.isIterator = isIterator
@@ -773,9 +797,28 @@ func (t *searchTask) initSearchRequest(ctx context.Context) error {
 	return nil
 }
 
+// oversampleTopk raises the search topk to the candidate size of the rerank, so the rerank picks
+// the results from more candidates than limit+offset
+func (t *searchTask) oversampleTopk(queryInfo *planpb.QueryInfo) error {
+	candidateSize := t.functionScore.GetCandidateSize()
+	if candidateSize <= queryInfo.GetTopk() {
+		return nil
+	}
+	if err := validateLimit(candidateSize); err != nil {
+		return merr.WrapErrParameterInvalidMsg("candidate size [%d] of the rerank is invalid, %s", candidateSize, err.Error())
+	}
+	queryInfo.Topk = candidateSize
+	return nil
+}
+
 func (t *searchTask) searchPostProcess(ctx context.Context, span trace.Span, toReduceResults []*internalpb.SearchResults) error {
 	metricType := getMetricType(toReduceResults)
-	result, err := t.reduceResults(t.ctx, toReduceResults, t.SearchRequest.GetNq(), t.SearchRequest.GetTopk(), t.SearchRequest.GetOffset(), metricType, t.queryInfos[0], false)
+	// all the candidates are kept for the rerank, which applies the offset
+	reduceOffset := t.SearchRequest.GetOffset()
+	if t.rerankTopk > 0 {
+		reduceOffset = 0
+	}
+	result, err := t.reduceResults(t.ctx, toReduceResults, t.SearchRequest.GetNq(), t.SearchRequest.GetTopk(), reduceOffset, metricType, t.queryInfos[0], false)
 	if err != nil {
 		return err
 	}
@@ -785,7 +828,11 @@ func (t *searchTask) searchPostProcess(ctx context.Context, span trace.Span, toR
 			ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-call-rerank-function-udf")
 			defer sp.End()
 			groupScorerStr := getGroupScorerStr(t.request.GetSearchParams())
-			params := rerank.NewSearchParams(t.Nq, t.SearchRequest.GetTopk(), t.SearchRequest.GetOffset(),
+			limit := t.SearchRequest.GetTopk()
+			if t.rerankTopk > 0 {
+				limit = t.rerankTopk - t.SearchRequest.GetOffset()
+			}
+			params := rerank.NewSearchParams(t.Nq, limit, t.SearchRequest.GetOffset(),
 				t.queryInfos[0].RoundDecimal, t.queryInfos[0].GroupByFieldId, t.queryInfos[0].GroupSize, t.queryInfos[0].StrictGroupSize, groupScorerStr, []string{metricType})
 			// rank only returns id and score
 			if t.result, err = t.functionScore.Process(ctx, params, []*milvuspb.SearchResults{result}); err != nil {
@@ -901,12 +948,16 @@ func (t *searchTask) Execute(ctx context.Context) error {
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute search %d", t.ID()))
 	defer tr.CtxElapse(ctx, "done")
 
+	if t.servePartial {
+		ctx = withServePartial(ctx)
+	}
 	err := t.lb.Execute(ctx, CollectionWorkLoad{
-		db:             t.request.GetDbName(),
-		collectionID:   t.SearchRequest.CollectionID,
-		collectionName: t.collectionName,
-		nq:             t.Nq,
-		exec:           t.searchShard,
+		db:               t.request.GetDbName(),
+		collectionID:     t.SearchRequest.CollectionID,
+		collectionName:   t.collectionName,
+		nq:               t.Nq,
+		consistencyLevel: t.SearchRequest.GetConsistencyLevel(),
+		exec:             t.searchShard,
 	})
 	if err != nil {
 		log.Warn("search execute failed", zap.Error(err))
@@ -1028,6 +1079,14 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	}
 	t.result.Results.OutputFields = t.userOutputFields
 	t.result.CollectionName = t.request.GetCollectionName()
+	if t.servePartial {
+		// report the coverage even if no shard reports it, so the caller could tell the result is partial
+		coverage, _ := reduce.MergeCoverage(toReduceResults...)
+		if t.result.Status == nil {
+			t.result.Status = merr.Success()
+		}
+		reduce.SetCoverage(t.result.Status, coverage)
+	}
 
 	primaryFieldSchema, _ := t.schema.GetPkField()
 	if t.userRequestedPkFieldExplicitly {
@@ -1159,6 +1218,12 @@ func requeryImpl(t *searchTask, span trace.Span, ids *schemapb.IDs, outputFields
 		UseDefaultConsistency: false,
 		GuaranteeTimestamp:    t.SearchRequest.GuaranteeTimestamp,
 	}
+	if t.servePartial {
+		queryReq.QueryParams = append(queryReq.QueryParams, &commonpb.KeyValuePair{
+			Key:   ServePartialKey,
+			Value: strconv.FormatBool(true),
+		})
+	}
 	pkField, err := typeutil.GetPrimaryFieldSchema(t.schema.CollectionSchema)
 	if err != nil {
 		return nil, err
@@ -294,6 +294,56 @@ func TestSearchTask_PostExecute(t *testing.T) {
 		assert.Equal(t, []int64{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, qt.result.Results.Ids.GetIntId().Data)
 	})
 
+	t.Run("Test search mmr rerank with candidate size", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		collName := "test_search_mmr_rerank" + funcutil.GenRandomStr()
+		_, fieldNameId := createCollWithFields(t, collName, qc)
+		qt := getSearchTaskWithRerank(t, collName, testFloatVecField)
+		qt.request.FunctionScore.Functions[0].Params = []*commonpb.KeyValuePair{
+			{Key: "reranker", Value: "mmr"},
+			{Key: "candidate_size", Value: "30"},
+		}
+		qt.request.SearchParams = append(getBaseSearchParams(), &commonpb.KeyValuePair{Key: OffsetKey, Value: "2"})
+		err = qt.PreExecute(ctx)
+		assert.NoError(t, err)
+		// the search topk is raised from limit+offset to the candidate size
+		assert.Equal(t, int64(30), qt.SearchRequest.GetTopk())
+		assert.Equal(t, int64(2), qt.SearchRequest.GetOffset())
+		assert.Equal(t, int64(12), qt.rerankTopk)
+		plan := &planpb.PlanNode{}
+		assert.NoError(t, proto.Unmarshal(qt.SearchRequest.GetSerializedExprPlan(), plan))
+		assert.Equal(t, int64(30), plan.GetVectorAnns().GetQueryInfo().GetTopk())
+
+		result := genTestSearchResultData(1, 30, schemapb.DataType_Int64, testInt64Field, fieldNameId[testInt64Field], false)
+		resultData := &schemapb.SearchResultData{}
+		assert.NoError(t, proto.Unmarshal(result.SlicedBlob, resultData))
+		vectors := testutils.GenerateVectorFieldData(schemapb.DataType_FloatVector, testFloatVecField, 30, testVecDim)
+		vectors.FieldId = fieldNameId[testFloatVecField]
+		resultData.FieldsData = []*schemapb.FieldData{vectors, resultData.FieldsData[1]}
+		result.SlicedBlob, err = proto.Marshal(resultData)
+		assert.NoError(t, err)
+		qt.resultBuf.Insert(result)
+
+		err := qt.PostExecute(context.TODO())
+		assert.NoError(t, err)
+		// the rerank picks limit results after the offset out of all the candidates
+		assert.Equal(t, []int64{10}, qt.result.Results.Topks)
+		assert.Equal(t, 10, len(qt.result.Results.Ids.GetIntId().GetData()))
+		assert.False(t, qt.resultSizeInsufficient)
+
+		// the candidate size doesn't lower the search topk
+		qt = getSearchTaskWithRerank(t, collName, testFloatVecField)
+		qt.request.FunctionScore.Functions[0].Params = []*commonpb.KeyValuePair{
+			{Key: "reranker", Value: "mmr"},
+			{Key: "candidate_size", Value: "5"},
+		}
+		err = qt.PreExecute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), qt.SearchRequest.GetTopk())
+		assert.Equal(t, int64(10), qt.rerankTopk)
+	})
+
 	getHybridSearchTaskWithRerank := func(t *testing.T, collName string, funcInput string, data [][]string) *searchTask {
 		subReqs := []*milvuspb.SubSearchRequest{}
 		for _, item := range data {
@@ -452,6 +502,24 @@ func TestSearchTask_PostExecute(t *testing.T) {
 		assert.Equal(t, testInt32Field, qt.result.Results.FieldsData[0].FieldName)
 	})
 
+	t.Run("Test hybridsearch mmr rerank with candidate size", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		collName := "test_hybridsearch_mmr_rerank" + funcutil.GenRandomStr()
+		createCollWithFields(t, collName, qc)
+		qt := getHybridSearchTaskWithRerank(t, collName, testFloatVecField, [][]string{{"sentence"}, {"sentence"}})
+		qt.request.FunctionScore.Functions[0].Params = []*commonpb.KeyValuePair{
+			{Key: "reranker", Value: "mmr"},
+			{Key: "candidate_size", Value: "30"},
+		}
+		err = qt.PreExecute(ctx)
+		assert.NoError(t, err)
+		for _, subReq := range qt.SearchRequest.GetSubReqs() {
+			assert.Equal(t, int64(30), subReq.GetTopk())
+		}
+		assert.Equal(t, int64(10), qt.rankParams.GetLimit())
+	})
+
 	// rrf/weigted rank
 	t.Run("Test rank function", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
@@ -3246,6 +3314,59 @@ func TestTaskSearch_parseSearchInfo(t *testing.T) {
 		assert.False(t, searchInfo.planInfo.GetStrictGroupSize())
 	})
 
+	t.Run("parseSearchInfo group by parent", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 101, Name: "c1"},
+				{FieldID: 102, Name: "text"},
+				{FieldID: 103, Name: "parent"},
+			},
+			Functions: []*schemapb.FunctionSchema{
+				{
+					Name:             "chunking",
+					Type:             schemapb.FunctionType_TextChunking,
+					InputFieldNames:  []string{"text"},
+					OutputFieldNames: []string{"parent"},
+				},
+			},
+		}
+		params := getValidSearchParams()
+		params = append(params, &commonpb.KeyValuePair{
+			Key:   GroupByParentKey,
+			Value: "true",
+		})
+		searchInfo, err := parseSearchInfo(params, schema, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(103), searchInfo.planInfo.GetGroupByFieldId())
+
+		// group_by_parent=false doesn't group the hits
+		searchInfo, err = parseSearchInfo(append(getValidSearchParams(), &commonpb.KeyValuePair{
+			Key:   GroupByParentKey,
+			Value: "false",
+		}), schema, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-1), searchInfo.planInfo.GetGroupByFieldId())
+
+		// conflicts with group_by_field
+		_, err = parseSearchInfo(append(params, &commonpb.KeyValuePair{
+			Key:   GroupByFieldKey,
+			Value: "c1",
+		}), schema, nil)
+		assert.Error(t, err)
+
+		// invalid value
+		_, err = parseSearchInfo(append(getValidSearchParams(), &commonpb.KeyValuePair{
+			Key:   GroupByParentKey,
+			Value: "x",
+		}), schema, nil)
+		assert.Error(t, err)
+
+		// no TextChunking function
+		schema.Functions = nil
+		_, err = parseSearchInfo(params, schema, nil)
+		assert.Error(t, err)
+	})
+
 	t.Run("parseSearchInfo error", func(t *testing.T) {
 		spNoTopk := []*commonpb.KeyValuePair{{
 			Key:   AnnsFieldKey,
@@ -4912,3 +4912,23 @@ func TestAlterCollectionField(t *testing.T) {
 		})
 	}
 }
+
+func TestIsServePartial(t *testing.T) {
+	servePartial, err := isServePartial(nil)
+	assert.NoError(t, err)
+	assert.False(t, servePartial)
+
+	servePartial, err = isServePartial([]*commonpb.KeyValuePair{
+		{Key: TopKKey, Value: "10"},
+		{Key: ServePartialKey, Value: "true"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, servePartial)
+
+	servePartial, err = isServePartial([]*commonpb.KeyValuePair{{Key: ServePartialKey, Value: "false"}})
+	assert.NoError(t, err)
+	assert.False(t, servePartial)
+
+	_, err = isServePartial([]*commonpb.KeyValuePair{{Key: ServePartialKey, Value: "not_bool"}})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
@@ -156,6 +156,11 @@ func (it *upsertTask) insertPreExecute(ctx context.Context) error {
 		return err
 	}
 
+	// the chunks of a document can't be matched by the primary key of the document
+	if fSchema := function.GetTextChunkingFunction(it.schema.CollectionSchema.Functions); fSchema != nil {
+		return merr.WrapErrParameterInvalidMsg("upsert is not supported on the collection with TextChunking function [%s]", fSchema.GetName())
+	}
+
 	// Calculate embedding fields
 	if function.HasNonBM25Functions(it.schema.CollectionSchema.Functions, []int64{}) {
 		ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-Proxy-Upsert-insertPreExecute-call-function-udf")
@@ -7,7 +7,6 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 
-	"github.com/milvus-io/milvus/internal/distributed/streaming"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
@@ -36,7 +35,7 @@ func (ut *upsertTaskByStreamingService) Execute(ctx context.Context) error {
 	}
 
 	messages := append(insertMsgs, deleteMsgs...)
-	resp := streaming.WAL().AppendMessages(ctx, messages...)
+	resp := appendDmlMessages(ctx, ut.upsertMsg.InsertMsg.CollectionID, messages...)
 	if err := resp.UnwrapFirstError(); err != nil {
 		log.Warn("append messages to wal failed", zap.Error(err))
 		return err
@@ -0,0 +1,619 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v2/kv"
+	"github.com/milvus-io/milvus/pkg/v2/kv/predicates"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/contextutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/retry"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const txnCommitPrefix = "proxy/transactions"
+
+// globalTxnManager manages the user transactions of the proxy, it's created when the proxy starts.
+var globalTxnManager *txnManager
+
+// userTxn is a user transaction on one collection.
+// The mutations are buffered at proxy until commit, so an expired transaction holds no resource on wal.
+// The transaction is pinned to the proxy which begins it, all the requests of the transaction must be sent to that proxy.
+type userTxn struct {
+	id           string
+	user         string
+	collectionID int64
+	deadline     time.Time
+	size         int
+	msgs         []message.MutableMessage
+}
+
+// txnCommitRecord is persisted after the mutations of every vchannel are prepared in a wal transaction,
+// it's the commit point of the user transaction, the wal transactions left in the record are committed
+// by the sweeper if the commit is interrupted.
+type txnCommitRecord struct {
+	TxnID        string `json:"txnID"`
+	CollectionID int64  `json:"collectionID"`
+	// Keepalive is the keepalive of the wal transactions in milliseconds.
+	Keepalive int64 `json:"keepalive"`
+	// CommitTime is the unix milliseconds when the record is persisted.
+	CommitTime int64 `json:"commitTime"`
+	// WALTxns is the wal transaction id of every vchannel which is not committed yet.
+	WALTxns map[string]int64 `json:"walTxns"`
+}
+
+// txnManager keeps the in-flight user transactions.
+type txnManager struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+	mu         sync.Mutex
+	kv         kv.TxnKV
+	wal        streaming.WALAccesser
+	txns       map[string]*userTxn
+	committing typeutil.Set[string]
+	// commitRetryOpts are the retry options of committing the prepared wal transactions in place.
+	commitRetryOpts []retry.Option
+}
+
+func newTxnManager(txnKV kv.TxnKV, wal streaming.WALAccesser) *txnManager {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &txnManager{
+		ctx:        ctx,
+		cancel:     cancel,
+		kv:         txnKV,
+		wal:        wal,
+		txns:       make(map[string]*userTxn),
+		committing: typeutil.NewSet[string](),
+	}
+}
+
+// Start starts the background sweeper, which rolls back the expired transactions
+// and completes the commits interrupted by failures, including the ones of other proxies.
+func (m *txnManager) Start() {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(paramtable.Get().ProxyCfg.TransactionSweepInterval.GetAsDurationByParse())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-m.ctx.Done():
+				return
+			case <-ticker.C:
+				m.sweep(m.ctx)
+			}
+		}
+	}()
+}
+
+// Close stops the sweeper, the uncommitted transactions of the proxy are dropped.
+func (m *txnManager) Close() {
+	m.cancel()
+	m.wg.Wait()
+}
+
+// begin starts a new transaction, the timeout is capped by proxy.transaction.timeout.
+func (m *txnManager) begin(user string, collectionID int64, timeout time.Duration) string {
+	maxTimeout := paramtable.Get().ProxyCfg.TransactionTimeout.GetAsDuration(time.Second)
+	if timeout <= 0 || timeout > maxTimeout {
+		timeout = maxTimeout
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	txn := &userTxn{
+		// the owner proxy is encoded into the transaction id, so other proxies can tell where the transaction is.
+		id:           fmt.Sprintf("%d-%s", paramtable.GetNodeID(), uuid.NewString()),
+		user:         user,
+		collectionID: collectionID,
+		deadline:     time.Now().Add(timeout),
+	}
+	m.txns[txn.id] = txn
+	return txn.id
+}
+
+// stage buffers the messages into the transaction.
+func (m *txnManager) stage(user string, txnID string, collectionID int64, msgs ...message.MutableMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	txn, err := m.getLocked(user, txnID)
+	if err != nil {
+		return err
+	}
+	if txn.collectionID != collectionID {
+		return merr.WrapErrParameterInvalidMsg("transaction %s is bound to collection %d, but got collection %d", txnID, txn.collectionID, collectionID)
+	}
+	size := txn.size
+	for _, msg := range msgs {
+		size += msg.EstimateSize()
+	}
+	if maxSize := paramtable.Get().ProxyCfg.TransactionMaxBufferSize.GetAsInt(); size > maxSize {
+		return merr.WrapErrParameterInvalidMsg("transaction %s exceeds the max buffer size %d", txnID, maxSize)
+	}
+	txn.size = size
+	txn.msgs = append(txn.msgs, msgs...)
+	return nil
+}
+
+// remove takes the transaction out of the manager, so it cannot be staged or finished again.
+func (m *txnManager) remove(user string, txnID string) (*userTxn, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	txn, err := m.getLocked(user, txnID)
+	if err != nil {
+		return nil, err
+	}
+	delete(m.txns, txnID)
+	return txn, nil
+}
+
+// commit writes the buffered messages into the wal, returns the max commit timetick for session consistency.
+// The commit is done in two phases:
+//  1. the messages of every vchannel are appended into a wal transaction, any failure rollbacks all the wal transactions.
+//  2. the commit record is persisted as the commit point, then the wal transactions are committed with retry.
+//
+// Once the record is persisted, the transaction is committed even if the proxy crashes. The commit succeeds only if
+// the wal transactions of all the vchannels are committed, otherwise an error is returned and the pending
+// vchannels are kept in the record and committed by the sweeper.
+func (m *txnManager) commit(ctx context.Context, user string, txnID string) (uint64, error) {
+	return m.commitWithKV(ctx, user, txnID, nil)
+}
+
+// commitWithKV commits the transaction like commit, and the kv saves are persisted atomically with the commit record.
+// The transaction is rolled back if the predicates don't hold when the commit record is persisted,
+// so the caller can bind its own state, e.g. the consumed offsets of an external source, to the transaction.
+func (m *txnManager) commitWithKV(ctx context.Context, user string, txnID string, saves map[string]string, preds ...predicates.Predicate) (uint64, error) {
+	txn, err := m.remove(user, txnID)
+	if err != nil {
+		return 0, err
+	}
+	m.mu.Lock()
+	m.committing.Insert(txnID)
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		m.committing.Remove(txnID)
+		m.mu.Unlock()
+	}()
+	log := log.Ctx(ctx).With(zap.String("txnID", txnID), zap.Int64("collectionID", txn.collectionID))
+
+	vchannels := make([]string, 0)
+	channelMsgs := make(map[string][]message.MutableMessage)
+	for _, msg := range txn.msgs {
+		if _, ok := channelMsgs[msg.VChannel()]; !ok {
+			vchannels = append(vchannels, msg.VChannel())
+		}
+		channelMsgs[msg.VChannel()] = append(channelMsgs[msg.VChannel()], msg)
+	}
+
+	// the wal transactions are kept alive long enough for the sweeper to complete an interrupted commit.
+	keepalive := paramtable.Get().ProxyCfg.TransactionTimeout.GetAsDuration(time.Second)
+	walTxns := make(map[string]streaming.Txn, len(vchannels))
+	rollback := func() {
+		for _, walTxn := range walTxns {
+			if err := walTxn.Rollback(ctx); err != nil {
+				log.Warn("rollback wal transaction failed", zap.Error(err))
+			}
+		}
+	}
+	for _, vchannel := range vchannels {
+		walTxn, err := m.wal.Txn(ctx, streaming.TxnOption{VChannel: vchannel, Keepalive: keepalive})
+		if err != nil {
+			log.Warn("begin wal transaction failed", zap.String("vchannel", vchannel), zap.Error(err))
+			rollback()
+			return 0, err
+		}
+		walTxns[vchannel] = walTxn
+		for _, msg := range channelMsgs[vchannel] {
+			if err := walTxn.Append(ctx, msg); err != nil {
+				log.Warn("append message into wal transaction failed", zap.String("vchannel", vchannel), zap.Error(err))
+				rollback()
+				return 0, err
+			}
+		}
+	}
+
+	record := &txnCommitRecord{
+		TxnID:        txnID,
+		CollectionID: txn.collectionID,
+		Keepalive:    keepalive.Milliseconds(),
+		CommitTime:   time.Now().UnixMilli(),
+		WALTxns:      make(map[string]int64, len(walTxns)),
+	}
+	for vchannel, walTxn := range walTxns {
+		record.WALTxns[vchannel] = int64(walTxn.TxnContext().TxnID)
+	}
+	value, err := json.Marshal(record)
+	if err != nil {
+		rollback()
+		return 0, err
+	}
+	kvs := make(map[string]string, len(saves)+1)
+	for key, value := range saves {
+		kvs[key] = value
+	}
+	kvs[path.Join(txnCommitPrefix, txnID)] = string(value)
+	if err := m.kv.MultiSaveAndRemove(ctx, kvs, nil, preds...); err != nil {
+		// the record may be persisted even if an error is returned, check it before rolling back,
+		// otherwise the saves bound to the transaction may be persisted without the mutations.
+		_, loadErr := m.kv.Load(ctx, path.Join(txnCommitPrefix, txnID))
+		if loadErr == nil {
+			log.Warn("transaction commit record is persisted with error", zap.Error(err))
+		} else if errors.Is(loadErr, merr.ErrIoKeyNotFound) {
+			log.Warn("persist transaction commit record failed", zap.Error(err))
+			rollback()
+			return 0, err
+		} else {
+			// the wal transactions are committed by the sweeper if the record is persisted, or expire by keepalive.
+			log.Warn("the result of the transaction is unknown since the commit record can not be loaded", zap.Error(err), zap.NamedError("loadErr", loadErr))
+			return 0, err
+		}
+	}
+
+	timetick, err := m.commitPrepared(ctx, record, walTxns, m.commitRetryOpts...)
+	if err != nil {
+		log.Warn("user transaction is persisted but not committed on all the vchannels",
+			zap.Strings("vchannels", vchannels),
+			zap.Any("pending", record.WALTxns),
+			zap.Error(err))
+		return 0, err
+	}
+	log.Info("user transaction committed",
+		zap.Int("messages", len(txn.msgs)),
+		zap.Strings("vchannels", vchannels))
+	return timetick, nil
+}
+
+// commitPrepared commits the prepared wal transactions of the record with retry, returns the max commit timetick.
+// The pending vchannels without a wal transaction in walTxns are resumed by the wal transaction id of the record.
+// A vchannel is removed from the record only after it's committed, the record is removed once all the vchannels
+// are committed, an error is returned if any vchannel is still pending.
+func (m *txnManager) commitPrepared(ctx context.Context, record *txnCommitRecord, walTxns map[string]streaming.Txn, opts ...retry.Option) (uint64, error) {
+	log := log.Ctx(ctx).With(zap.String("txnID", record.TxnID), zap.Int64("collectionID", record.CollectionID))
+
+	var maxTimeTick uint64
+	err := retry.Do(ctx, func() error {
+		var lastErr error
+		for vchannel, id := range record.WALTxns {
+			walTxn, ok := walTxns[vchannel]
+			if !ok {
+				var err error
+				walTxn, err = m.wal.Txn(ctx, streaming.TxnOption{
+					VChannel: vchannel,
+					TxnContext: &message.TxnContext{
+						TxnID:     message.TxnID(id),
+						Keepalive: time.Duration(record.Keepalive) * time.Millisecond,
+					},
+				})
+				if err != nil {
+					log.Warn("resume wal transaction failed", zap.String("vchannel", vchannel), zap.Error(err))
+					lastErr = err
+					continue
+				}
+			}
+			// a wal transaction can only be committed once, the retry resumes it again.
+			delete(walTxns, vchannel)
+			result, err := walTxn.Commit(ctx)
+			if err == nil {
+				delete(record.WALTxns, vchannel)
+				maxTimeTick = max(maxTimeTick, result.TimeTick)
+				continue
+			}
+			if !status.AsStreamingError(err).IsTxnExpired() {
+				log.Warn("commit wal transaction failed, retry it later", zap.String("vchannel", vchannel), zap.Error(err))
+				lastErr = err
+				continue
+			}
+			// the wal transaction is not found if it's committed by another proxy, which removes the vchannel from the record.
+			committed, loadErr := m.isCommittedByOthers(ctx, record.TxnID, vchannel)
+			if loadErr != nil {
+				lastErr = loadErr
+				continue
+			}
+			if committed {
+				delete(record.WALTxns, vchannel)
+				continue
+			}
+			// the vchannel is kept in the record, the transaction is never reported as committed without it.
+			log.Error("wal transaction is expired before committed", zap.String("vchannel", vchannel), zap.Error(err))
+			lastErr = retry.Unrecoverable(err)
+		}
+
+		var err error
+		if len(record.WALTxns) == 0 {
+			err = m.kv.Remove(ctx, path.Join(txnCommitPrefix, record.TxnID))
+		} else {
+			err = m.saveRecord(ctx, record)
+		}
+		if err != nil {
+			log.Warn("update transaction commit record failed", zap.Error(err))
+		}
+		if lastErr != nil {
+			return lastErr
+		}
+		return err
+	}, opts...)
+	if err != nil {
+		pending := lo.Keys(record.WALTxns)
+		return 0, errors.Wrapf(err, "transaction %s is persisted but not committed on vchannels %v", record.TxnID, pending)
+	}
+	return maxTimeTick, nil
+}
+
+// isCommittedByOthers checks whether the vchannel is removed from the persisted commit record.
+func (m *txnManager) isCommittedByOthers(ctx context.Context, txnID string, vchannel string) (bool, error) {
+	value, err := m.kv.Load(ctx, path.Join(txnCommitPrefix, txnID))
+	if errors.Is(err, merr.ErrIoKeyNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	persisted := &txnCommitRecord{}
+	if err := json.Unmarshal([]byte(value), persisted); err != nil {
+		return false, err
+	}
+	_, ok := persisted.WALTxns[vchannel]
+	return !ok, nil
+}
+
+// sweep rolls back the expired transactions and rolls forward the interrupted commits.
+func (m *txnManager) sweep(ctx context.Context) {
+	m.mu.Lock()
+	m.expireLocked()
+	m.mu.Unlock()
+
+	_, values, err := m.kv.LoadWithPrefix(ctx, txnCommitPrefix+"/")
+	if err != nil {
+		log.Ctx(ctx).Warn("load transaction commit records failed", zap.Error(err))
+		return
+	}
+	interval := paramtable.Get().ProxyCfg.TransactionSweepInterval.GetAsDurationByParse()
+	for _, value := range values {
+		record := &txnCommitRecord{}
+		if err := json.Unmarshal([]byte(value), record); err != nil {
+			log.Ctx(ctx).Warn("invalid transaction commit record", zap.String("record", value), zap.Error(err))
+			continue
+		}
+		m.mu.Lock()
+		committing := m.committing.Contain(record.TxnID)
+		m.mu.Unlock()
+		// skip the commits in progress, the fresh records are probably being committed by other proxies.
+		if committing || time.Since(time.UnixMilli(record.CommitTime)) < interval {
+			continue
+		}
+		m.rollForward(ctx, record)
+	}
+}
+
+// rollForward resumes the prepared wal transactions of the record and commits them,
+// the sweeper tries once every interval.
+func (m *txnManager) rollForward(ctx context.Context, record *txnCommitRecord) {
+	if _, err := m.commitPrepared(ctx, record, nil, retry.Attempts(1)); err != nil {
+		log.Ctx(ctx).Warn("roll forward the interrupted transaction commit failed", zap.String("txnID", record.TxnID), zap.Error(err))
+		return
+	}
+	log.Ctx(ctx).Info("roll forward the interrupted transaction commit", zap.String("txnID", record.TxnID))
+}
+
+func (m *txnManager) saveRecord(ctx context.Context, record *txnCommitRecord) error {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return m.kv.Save(ctx, path.Join(txnCommitPrefix, record.TxnID), string(value))
+}
+
+// rollback drops the buffered messages of the transaction.
+func (m *txnManager) rollback(user string, txnID string) error {
+	_, err := m.remove(user, txnID)
+	return err
+}
+
+func (m *txnManager) getLocked(user string, txnID string) (*userTxn, error) {
+	txn, ok := m.txns[txnID]
+	if !ok || txn.user != user {
+		if owner, _, ok := strings.Cut(txnID, "-"); ok && owner != strconv.FormatInt(paramtable.GetNodeID(), 10) {
+			return nil, merr.WrapErrParameterInvalidMsg("transaction %s belongs to proxy %s, the requests of a transaction must be sent to the same proxy", txnID, owner)
+		}
+		return nil, merr.WrapErrParameterInvalidMsg("transaction %s not found", txnID)
+	}
+	if time.Now().After(txn.deadline) {
+		delete(m.txns, txnID)
+		return nil, merr.WrapErrParameterInvalidMsg("transaction %s is expired", txnID)
+	}
+	return txn, nil
+}
+
+func (m *txnManager) expireLocked() {
+	now := time.Now()
+	for id, txn := range m.txns {
+		if now.After(txn.deadline) {
+			log.Info("user transaction is expired and rolled back", zap.String("txnID", id), zap.Int64("collectionID", txn.collectionID))
+			delete(m.txns, id)
+		}
+	}
+}
+
+// getTransactionIDFromContext returns the transaction id which the request is bound to, empty if not bound.
+func getTransactionIDFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md[strings.ToLower(util.HeaderTransactionID)]
+	if len(values) < 1 {
+		return ""
+	}
+	return values[0]
+}
+
+// NewContextWithTransaction binds the requests of the context to the transaction.
+func NewContextWithTransaction(ctx context.Context, txnID string) context.Context {
+	if txnID == "" {
+		return ctx
+	}
+	return contextutil.AppendToIncomingContext(ctx, strings.ToLower(util.HeaderTransactionID), txnID)
+}
+
+// appendDmlMessages appends the dml messages into the wal,
+// the messages are buffered into the transaction instead if the request is bound to a transaction.
+func appendDmlMessages(ctx context.Context, collectionID int64, msgs ...message.MutableMessage) streaming.AppendResponses {
+	txnID := getTransactionIDFromContext(ctx)
+	if txnID == "" {
+		return streaming.WAL().AppendMessages(ctx, msgs...)
+	}
+	if globalTxnManager == nil {
+		err := merr.WrapErrServiceNotReady(paramtable.GetRole(), paramtable.GetNodeID(), "initialization")
+		return streaming.AppendResponses{Responses: []streaming.AppendResponse{{Error: err}}}
+	}
+	resp := streaming.AppendResponses{Responses: make([]streaming.AppendResponse, len(msgs))}
+	if err := globalTxnManager.stage(GetCurUserFromContextOrDefault(ctx), txnID, collectionID, msgs...); err != nil {
+		return streaming.AppendResponses{Responses: []streaming.AppendResponse{{Error: err}}}
+	}
+	return resp
+}
+
+// BeginTransaction starts a transaction on the collection, the insert, upsert and delete requests carrying
+// the transaction id in the metadata are buffered into the transaction and become visible atomically on commit.
+// The reads inside the transaction don't see the buffered mutations.
+func BeginTransaction(ctx context.Context, dbName string, collectionName string, timeout time.Duration) (string, error) {
+	if !streamingutil.IsStreamingServiceEnabled() {
+		return "", merr.WrapErrServiceUnavailable("transaction requires the streaming service")
+	}
+	if globalMetaCache == nil || globalTxnManager == nil {
+		return "", merr.WrapErrServiceNotReady(paramtable.GetRole(), paramtable.GetNodeID(), "initialization")
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return "", err
+	}
+	txnID := globalTxnManager.begin(GetCurUserFromContextOrDefault(ctx), collectionID, timeout)
+	log.Ctx(ctx).Info("user transaction begin",
+		zap.String("txnID", txnID),
+		zap.String("dbName", dbName),
+		zap.String("collectionName", collectionName))
+	return txnID, nil
+}
+
+// CommitTransaction commits the transaction, returns the commit timestamp for session consistency.
+// The transaction is atomic across the shards, the shards are committed only after all the mutations are written
+// and the commit point is persisted, the interrupted commit is completed by the sweeper of the proxies.
+// The success is returned only after the shards are all committed, an error after the commit point is persisted
+// means the commit is still in progress and the mutations become visible once the sweeper completes it.
+func CommitTransaction(ctx context.Context, txnID string) (uint64, error) {
+	if !streamingutil.IsStreamingServiceEnabled() {
+		return 0, merr.WrapErrServiceUnavailable("transaction requires the streaming service")
+	}
+	if globalTxnManager == nil {
+		return 0, merr.WrapErrServiceNotReady(paramtable.GetRole(), paramtable.GetNodeID(), "initialization")
+	}
+	return globalTxnManager.commit(ctx, GetCurUserFromContextOrDefault(ctx), txnID)
+}
+
+// RollbackTransaction drops the mutations of the transaction.
+func RollbackTransaction(ctx context.Context, txnID string) error {
+	if globalTxnManager == nil {
+		return merr.WrapErrServiceNotReady(paramtable.GetRole(), paramtable.GetNodeID(), "initialization")
+	}
+	return globalTxnManager.rollback(GetCurUserFromContextOrDefault(ctx), txnID)
+}
+
+// BeginTransaction starts a transaction on the collection, the requests of the transaction must be sent to this proxy.
+func (node *Proxy) BeginTransaction(ctx context.Context, req *milvuspb.BeginTransactionRequest) (*milvuspb.BeginTransactionResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-BeginTransaction")
+	defer sp.End()
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &milvuspb.BeginTransactionResponse{Status: merr.Status(err)}, nil
+	}
+	txnID, err := BeginTransaction(ctx, req.GetDbName(), req.GetCollectionName(), time.Duration(req.GetTimeout())*time.Second)
+	if err != nil {
+		log.Ctx(ctx).Warn("fail to begin transaction",
+			zap.String("db", req.GetDbName()),
+			zap.String("collection", req.GetCollectionName()),
+			zap.Error(err))
+		return &milvuspb.BeginTransactionResponse{Status: merr.Status(err)}, nil
+	}
+	return &milvuspb.BeginTransactionResponse{
+		Status:        merr.Success(),
+		TransactionId: txnID,
+	}, nil
+}
+
+// CommitTransaction commits the transaction, returns the commit timestamp for session consistency.
+func (node *Proxy) CommitTransaction(ctx context.Context, req *milvuspb.CommitTransactionRequest) (*milvuspb.CommitTransactionResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CommitTransaction")
+	defer sp.End()
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &milvuspb.CommitTransactionResponse{Status: merr.Status(err)}, nil
+	}
+	timestamp, err := CommitTransaction(ctx, req.GetTransactionId())
+	if err != nil {
+		log.Ctx(ctx).Warn("fail to commit transaction", zap.String("transactionID", req.GetTransactionId()), zap.Error(err))
+		return &milvuspb.CommitTransactionResponse{Status: merr.Status(err)}, nil
+	}
+	return &milvuspb.CommitTransactionResponse{
+		Status:    merr.Success(),
+		Timestamp: timestamp,
+	}, nil
+}
+
+// RollbackTransaction drops the mutations of the transaction.
+func (node *Proxy) RollbackTransaction(ctx context.Context, req *milvuspb.RollbackTransactionRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-RollbackTransaction")
+	defer sp.End()
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := RollbackTransaction(ctx, req.GetTransactionId()); err != nil {
+		log.Ctx(ctx).Warn("fail to rollback transaction", zap.String("transactionID", req.GetTransactionId()), zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
@@ -0,0 +1,393 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/json"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/mocks/distributed/mock_streaming"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v2/kv"
+	"github.com/milvus-io/milvus/pkg/v2/kv/predicates"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/retry"
+)
+
+type fakeWALTxn struct {
+	vchannel   string
+	txnCtx     message.TxnContext
+	appended   []message.MutableMessage
+	appendErr  error
+	commitErr  error
+	committed  bool
+	rollbacked bool
+}
+
+func (t *fakeWALTxn) Append(ctx context.Context, msg message.MutableMessage, opts ...streaming.AppendOption) error {
+	if t.appendErr != nil {
+		return t.appendErr
+	}
+	t.appended = append(t.appended, msg)
+	return nil
+}
+
+func (t *fakeWALTxn) Commit(ctx context.Context) (*types.AppendResult, error) {
+	if t.commitErr != nil {
+		return nil, t.commitErr
+	}
+	t.committed = true
+	return &types.AppendResult{TimeTick: uint64(len(t.appended))}, nil
+}
+
+func (t *fakeWALTxn) Rollback(ctx context.Context) error {
+	t.rollbacked = true
+	return nil
+}
+
+func (t *fakeWALTxn) TxnContext() message.TxnContext {
+	return t.txnCtx
+}
+
+// newFakeWAL returns a wal whose transactions are recorded by vchannel, the resumed transactions keep the context.
+func newFakeWAL(t *testing.T, walTxns map[string]*fakeWALTxn) *mock_streaming.MockWALAccesser {
+	return newFailingFakeWAL(t, walTxns, func(vchannel string) error { return nil })
+}
+
+// newFailingFakeWAL returns a fake wal, every new or resumed transaction fails to be committed with the error of commitErr.
+func newFailingFakeWAL(t *testing.T, walTxns map[string]*fakeWALTxn, commitErr func(vchannel string) error) *mock_streaming.MockWALAccesser {
+	wal := mock_streaming.NewMockWALAccesser(t)
+	wal.EXPECT().Txn(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, opts streaming.TxnOption) (streaming.Txn, error) {
+		txnCtx := message.TxnContext{TxnID: message.TxnID(len(walTxns) + 1), Keepalive: opts.Keepalive}
+		if opts.TxnContext != nil {
+			txnCtx = *opts.TxnContext
+		}
+		walTxns[opts.VChannel] = &fakeWALTxn{vchannel: opts.VChannel, txnCtx: txnCtx, commitErr: commitErr(opts.VChannel)}
+		return walTxns[opts.VChannel], nil
+	}).Maybe()
+	return wal
+}
+
+// failedTxnKV fails the kv transactions, the transaction is applied before failing if applied is true.
+type failedTxnKV struct {
+	kv.TxnKV
+	applied bool
+}
+
+func (f *failedTxnKV) MultiSaveAndRemove(ctx context.Context, saves map[string]string, removals []string, preds ...predicates.Predicate) error {
+	if f.applied {
+		if err := f.TxnKV.MultiSaveAndRemove(ctx, saves, removals); err != nil {
+			return err
+		}
+	}
+	return errors.New("mock")
+}
+
+func newTestDeleteMutableMessage(vchannel string) message.MutableMessage {
+	return message.NewDeleteMessageBuilderV1().
+		WithVChannel(vchannel).
+		WithHeader(&message.DeleteMessageHeader{CollectionId: 1}).
+		WithBody(&msgpb.DeleteRequest{}).
+		MustBuildMutable()
+}
+
+func TestTxnManager(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	t.Run("commit", func(t *testing.T) {
+		walTxns := make(map[string]*fakeWALTxn)
+		txnKV := memkv.NewMemoryKV()
+		m := newTxnManager(txnKV, newFakeWAL(t, walTxns))
+		txnID := m.begin("user", 1, time.Minute)
+		assert.NoError(t, m.stage("user", txnID, 1, newTestDeleteMutableMessage("v1"), newTestDeleteMutableMessage("v2")))
+		assert.NoError(t, m.stage("user", txnID, 1, newTestDeleteMutableMessage("v1")))
+		assert.Error(t, m.stage("user", txnID, 2, newTestDeleteMutableMessage("v1")))
+		assert.Error(t, m.stage("other", txnID, 1, newTestDeleteMutableMessage("v1")))
+
+		ts, err := m.commit(ctx, "user", txnID)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, ts)
+		assert.Len(t, walTxns, 2)
+		assert.Len(t, walTxns["v1"].appended, 2)
+		assert.True(t, walTxns["v1"].committed)
+		assert.True(t, walTxns["v2"].committed)
+		keys, _, err := txnKV.LoadWithPrefix(ctx, txnCommitPrefix)
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+
+		// the transaction cannot be finished twice
+		_, err = m.commit(ctx, "user", txnID)
+		assert.Error(t, err)
+		assert.Error(t, m.rollback("user", txnID))
+	})
+
+	t.Run("append failure rollbacks all", func(t *testing.T) {
+		walTxns := make(map[string]*fakeWALTxn)
+		wal := mock_streaming.NewMockWALAccesser(t)
+		wal.EXPECT().Txn(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, opts streaming.TxnOption) (streaming.Txn, error) {
+			walTxns[opts.VChannel] = &fakeWALTxn{vchannel: opts.VChannel}
+			if opts.VChannel == "v2" {
+				walTxns[opts.VChannel].appendErr = errors.New("mock")
+			}
+			return walTxns[opts.VChannel], nil
+		})
+		txnKV := memkv.NewMemoryKV()
+		m := newTxnManager(txnKV, wal)
+		txnID := m.begin("user", 1, time.Minute)
+		assert.NoError(t, m.stage("user", txnID, 1, newTestDeleteMutableMessage("v1"), newTestDeleteMutableMessage("v2")))
+
+		_, err := m.commit(ctx, "user", txnID)
+		assert.Error(t, err)
+		for _, walTxn := range walTxns {
+			assert.False(t, walTxn.committed)
+			assert.True(t, walTxn.rollbacked)
+		}
+		keys, _, err := txnKV.LoadWithPrefix(ctx, txnCommitPrefix)
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("commit with kv", func(t *testing.T) {
+		walTxns := make(map[string]*fakeWALTxn)
+		txnKV := memkv.NewMemoryKV()
+		m := newTxnManager(txnKV, newFakeWAL(t, walTxns))
+		txnID := m.begin("user", 1, time.Minute)
+		assert.NoError(t, m.stage("user", txnID, 1, newTestDeleteMutableMessage("v1")))
+		_, err := m.commitWithKV(ctx, "user", txnID, map[string]string{"offset": "1"})
+		assert.NoError(t, err)
+		assert.True(t, walTxns["v1"].committed)
+		value, err := txnKV.Load(ctx, "offset")
+		assert.NoError(t, err)
+		assert.Equal(t, "1", value)
+
+		// the transaction is rolled back if the commit record is not persisted.
+		failedKV := &failedTxnKV{TxnKV: txnKV}
+		m = newTxnManager(failedKV, newFakeWAL(t, walTxns))
+		txnID = m.begin("user", 1, time.Minute)
+		assert.NoError(t, m.stage("user", txnID, 1, newTestDeleteMutableMessage("v1")))
+		_, err = m.commitWithKV(ctx, "user", txnID, map[string]string{"offset": "2"})
+		assert.Error(t, err)
+		assert.False(t, walTxns["v1"].committed)
+		assert.True(t, walTxns["v1"].rollbacked)
+		value, err = txnKV.Load(ctx, "offset")
+		assert.NoError(t, err)
+		assert.Equal(t, "1", value)
+
+		// the transaction is committed if the commit record is persisted with error.
+		failedKV.applied = true
+		txnID = m.begin("user", 1, time.Minute)
+		assert.NoError(t, m.stage("user", txnID, 1, newTestDeleteMutableMessage("v1")))
+		_, err = m.commitWithKV(ctx, "user", txnID, map[string]string{"offset": "3"})
+		assert.NoError(t, err)
+		assert.True(t, walTxns["v1"].committed)
+		value, err = txnKV.Load(ctx, "offset")
+		assert.NoError(t, err)
+		assert.Equal(t, "3", value)
+		keys, _, err := txnKV.LoadWithPrefix(ctx, txnCommitPrefix)
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("interrupted commit is rolled forward", func(t *testing.T) {
+		paramtable.Get().Save(paramtable.Get().ProxyCfg.TransactionSweepInterval.Key, "1ms")
+		defer paramtable.Get().Reset(paramtable.Get().ProxyCfg.TransactionSweepInterval.Key)
+
+		walTxns := make(map[string]*fakeWALTxn)
+		txnKV := memkv.NewMemoryKV()
+		m := newTxnManager(txnKV, newFailingFakeWAL(t, walTxns, func(vchannel string) error {
+			if vchannel == "v2" {
+				return errors.New("mock")
+			}
+			return nil
+		}))
+		m.commitRetryOpts = []retry.Option{retry.Attempts(3), retry.Sleep(time.Millisecond)}
+		txnID := m.begin("user", 1, time.Minute)
+		assert.NoError(t, m.stage("user", txnID, 1, newTestDeleteMutableMessage("v1"), newTestDeleteMutableMessage("v2")))
+
+		// the commit is not reported as succeeded until all the vchannels are committed.
+		_, err := m.commit(ctx, "user", txnID)
+		assert.Error(t, err)
+		assert.True(t, walTxns["v1"].committed)
+		assert.False(t, walTxns["v2"].committed)
+		_, values, err := txnKV.LoadWithPrefix(ctx, txnCommitPrefix)
+		assert.NoError(t, err)
+		assert.Len(t, values, 1)
+		record := &txnCommitRecord{}
+		assert.NoError(t, json.Unmarshal([]byte(values[0]), record))
+		assert.Equal(t, map[string]int64{"v2": int64(walTxns["v2"].txnCtx.TxnID)}, record.WALTxns)
+
+		// the sweeper of any proxy resumes the pending wal transaction and commits it.
+		resumed := make(map[string]*fakeWALTxn)
+		other := newTxnManager(txnKV, newFakeWAL(t, resumed))
+		time.Sleep(10 * time.Millisecond)
+		other.sweep(ctx)
+		assert.Len(t, resumed, 1)
+		assert.True(t, resumed["v2"].committed)
+		assert.Equal(t, walTxns["v2"].txnCtx.TxnID, resumed["v2"].txnCtx.TxnID)
+		keys, _, err := txnKV.LoadWithPrefix(ctx, txnCommitPrefix)
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("transient commit failure is retried", func(t *testing.T) {
+		walTxns := make(map[string]*fakeWALTxn)
+		failures := 0
+		txnKV := memkv.NewMemoryKV()
+		m := newTxnManager(txnKV, newFailingFakeWAL(t, walTxns, func(vchannel string) error {
+			if vchannel == "v2" && failures == 0 {
+				failures++
+				return errors.New("mock")
+			}
+			return nil
+		}))
+		m.commitRetryOpts = []retry.Option{retry.Attempts(3), retry.Sleep(time.Millisecond)}
+		txnID := m.begin("user", 1, time.Minute)
+		assert.NoError(t, m.stage("user", txnID, 1, newTestDeleteMutableMessage("v1"), newTestDeleteMutableMessage("v2")))
+
+		// the wal transaction of v2 fails to be committed once, the retry resumes and commits it.
+		_, err := m.commit(ctx, "user", txnID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, failures)
+		assert.True(t, walTxns["v1"].committed)
+		assert.True(t, walTxns["v2"].committed)
+		assert.Len(t, walTxns, 2)
+		keys, _, err := txnKV.LoadWithPrefix(ctx, txnCommitPrefix)
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("expired vchannel is never dropped", func(t *testing.T) {
+		walTxns := make(map[string]*fakeWALTxn)
+		txnKV := memkv.NewMemoryKV()
+		m := newTxnManager(txnKV, newFailingFakeWAL(t, walTxns, func(vchannel string) error {
+			if vchannel == "v2" {
+				return status.NewTransactionExpired("mock")
+			}
+			return nil
+		}))
+		m.commitRetryOpts = []retry.Option{retry.Attempts(3), retry.Sleep(time.Millisecond)}
+		txnID := m.begin("user", 1, time.Minute)
+		assert.NoError(t, m.stage("user", txnID, 1, newTestDeleteMutableMessage("v1"), newTestDeleteMutableMessage("v2")))
+
+		_, err := m.commit(ctx, "user", txnID)
+		assert.Error(t, err)
+		assert.True(t, walTxns["v1"].committed)
+		_, values, err := txnKV.LoadWithPrefix(ctx, txnCommitPrefix)
+		assert.NoError(t, err)
+		assert.Len(t, values, 1)
+		record := &txnCommitRecord{}
+		assert.NoError(t, json.Unmarshal([]byte(values[0]), record))
+		assert.Equal(t, map[string]int64{"v2": int64(walTxns["v2"].txnCtx.TxnID)}, record.WALTxns)
+
+		// the wal transaction is not found if another proxy has committed it and removed it from the record.
+		assert.NoError(t, m.saveRecord(ctx, &txnCommitRecord{TxnID: record.TxnID, CollectionID: record.CollectionID, Keepalive: record.Keepalive, WALTxns: map[string]int64{}}))
+		_, err = m.commitPrepared(ctx, record, nil, retry.Attempts(1))
+		assert.NoError(t, err)
+		assert.Empty(t, record.WALTxns)
+		keys, _, err := txnKV.LoadWithPrefix(ctx, txnCommitPrefix)
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("pinned to proxy", func(t *testing.T) {
+		m := newTxnManager(memkv.NewMemoryKV(), newFakeWAL(t, make(map[string]*fakeWALTxn)))
+		err := m.stage("user", fmt.Sprintf("%d-txn", paramtable.GetNodeID()+1), 1, newTestDeleteMutableMessage("v1"))
+		assert.ErrorContains(t, err, "must be sent to the same proxy")
+		err = m.stage("user", fmt.Sprintf("%d-txn", paramtable.GetNodeID()), 1, newTestDeleteMutableMessage("v1"))
+		assert.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("rollback and expire", func(t *testing.T) {
+		m := newTxnManager(memkv.NewMemoryKV(), newFakeWAL(t, make(map[string]*fakeWALTxn)))
+		txnID := m.begin("user", 1, time.Minute)
+		assert.NoError(t, m.rollback("user", txnID))
+		assert.Error(t, m.stage("user", txnID, 1, newTestDeleteMutableMessage("v1")))
+
+		txnID = m.begin("user", 1, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		assert.Error(t, m.stage("user", txnID, 1, newTestDeleteMutableMessage("v1")))
+		assert.Empty(t, m.txns)
+
+		// the expired transactions are swept in background
+		paramtable.Get().Save(paramtable.Get().ProxyCfg.TransactionSweepInterval.Key, "10ms")
+		defer paramtable.Get().Reset(paramtable.Get().ProxyCfg.TransactionSweepInterval.Key)
+		m.Start()
+		defer m.Close()
+		m.begin("user", 1, time.Millisecond)
+		assert.Eventually(t, func() bool {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			return len(m.txns) == 0
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("buffer size limit", func(t *testing.T) {
+		paramtable.Get().Save(paramtable.Get().ProxyCfg.TransactionMaxBufferSize.Key, "1")
+		defer paramtable.Get().Reset(paramtable.Get().ProxyCfg.TransactionMaxBufferSize.Key)
+
+		m := newTxnManager(memkv.NewMemoryKV(), newFakeWAL(t, make(map[string]*fakeWALTxn)))
+		txnID := m.begin("user", 1, time.Minute)
+		assert.Error(t, m.stage("user", txnID, 1, newTestDeleteMutableMessage("v1")))
+	})
+}
+
+func TestProxy_Transaction(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		beginResp, err := node.BeginTransaction(ctx, &milvuspb.BeginTransactionRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(beginResp.GetStatus()), merr.ErrServiceNotReady)
+		commitResp, err := node.CommitTransaction(ctx, &milvuspb.CommitTransactionRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(commitResp.GetStatus()), merr.ErrServiceNotReady)
+		status, err := node.RollbackTransaction(ctx, &milvuspb.RollbackTransactionRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+	})
+
+	t.Run("streaming service disabled", func(t *testing.T) {
+		streamingutil.UnsetStreamingServiceEnabled()
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		beginResp, err := node.BeginTransaction(ctx, &milvuspb.BeginTransactionRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(beginResp.GetStatus()), merr.ErrServiceUnavailable)
+		commitResp, err := node.CommitTransaction(ctx, &milvuspb.CommitTransactionRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(commitResp.GetStatus()), merr.ErrServiceUnavailable)
+	})
+}
@@ -39,7 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/ctokenizer"
-	"github.com/milvus-io/milvus/internal/util/function"
+	milvusfunction "github.com/milvus-io/milvus/internal/util/function"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
 	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
@@ -873,12 +873,38 @@ func validateFunction(coll *schemapb.CollectionSchema) error {
 		}
 	}
 
-	if err := function.ValidateFunctions(coll); err != nil {
+	if err := validateTextChunkingFunction(coll); err != nil {
+		return err
+	}
+
+	if err := milvusfunction.ValidateFunctions(coll); err != nil {
 		return err
 	}
 	return nil
 }
 
+// validateTextChunkingFunction checks the collection level constraints of the TextChunking function,
+// every chunk is a row with its own primary key, so the primary key must be auto id.
+func validateTextChunkingFunction(coll *schemapb.CollectionSchema) error {
+	chunkingFunctions := lo.Filter(coll.GetFunctions(), func(fSchema *schemapb.FunctionSchema, _ int) bool {
+		return fSchema.GetType() == schemapb.FunctionType_TextChunking
+	})
+	if len(chunkingFunctions) == 0 {
+		return nil
+	}
+	if len(chunkingFunctions) > 1 {
+		return fmt.Errorf("only one TextChunking function is allowed in a collection, but got %d", len(chunkingFunctions))
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(coll)
+	if err != nil {
+		return err
+	}
+	if !pkField.GetAutoID() {
+		return fmt.Errorf("the primary key must be auto id in the collection with TextChunking function: function %s", chunkingFunctions[0].GetName())
+	}
+	return nil
+}
+
 func checkFunctionOutputField(fSchema *schemapb.FunctionSchema, fields []*schemapb.FieldSchema) error {
 	switch fSchema.GetType() {
 	case schemapb.FunctionType_BM25:
@@ -890,7 +916,19 @@ func checkFunctionOutputField(fSchema *schemapb.FunctionSchema, fields []*schema
 			return fmt.Errorf("BM25 function output field must be a SparseFloatVector field, but got %s", fields[0].DataType.String())
 		}
 	case schemapb.FunctionType_TextEmbedding:
-		if err := function.TextEmbeddingOutputsCheck(fields); err != nil {
+		if err := milvusfunction.TextEmbeddingOutputsCheck(fields); err != nil {
+			return err
+		}
+	case schemapb.FunctionType_MinHash:
+		if err := milvusfunction.MinHashOutputsCheck(fields); err != nil {
+			return err
+		}
+	case schemapb.FunctionType_MultimodalEmbedding:
+		if err := milvusfunction.MultimodalEmbeddingOutputsCheck(fields); err != nil {
+			return err
+		}
+	case schemapb.FunctionType_TextChunking:
+		if err := milvusfunction.TextChunkingOutputsCheck(fields); err != nil {
 			return err
 		}
 	default:
@@ -914,6 +952,27 @@ func checkFunctionInputField(function *schemapb.FunctionSchema, fields []*schema
 		if len(fields) != 1 || (fields[0].DataType != schemapb.DataType_VarChar && fields[0].DataType != schemapb.DataType_Text) {
 			return errors.New("TextEmbedding function input field must be a VARCHAR/TEXT field")
 		}
+	case schemapb.FunctionType_MinHash:
+		if len(fields) != 1 || (fields[0].DataType != schemapb.DataType_VarChar && fields[0].DataType != schemapb.DataType_Text) {
+			return errors.New("MinHash function input field must be a VARCHAR/TEXT field")
+		}
+	case schemapb.FunctionType_MultimodalEmbedding:
+		// the image field, and the optional text field
+		if len(fields) != 1 && len(fields) != 2 {
+			return fmt.Errorf("MultimodalEmbedding function needs an image field and an optional text field, but got %d input fields", len(fields))
+		}
+		for _, field := range fields {
+			if field.DataType != schemapb.DataType_VarChar && field.DataType != schemapb.DataType_Text {
+				return errors.New("MultimodalEmbedding function input field must be a VARCHAR/TEXT field")
+			}
+		}
+	case schemapb.FunctionType_TextChunking:
+		if len(fields) != 1 || (fields[0].DataType != schemapb.DataType_VarChar && fields[0].DataType != schemapb.DataType_Text) {
+			return errors.New("TextChunking function input field must be a VARCHAR/TEXT field")
+		}
+		if fields[0].GetIsPrimaryKey() || fields[0].GetIsPartitionKey() {
+			return errors.New("TextChunking function input field cannot be primary key or partition key")
+		}
 	default:
 		return errors.New("check input field with unknown function type")
 	}
@@ -959,6 +1018,12 @@ func checkFunctionBasicParams(function *schemapb.FunctionSchema) error {
 		if len(function.GetParams()) == 0 {
 			return errors.New("TextEmbedding function accepts no params")
 		}
+	case schemapb.FunctionType_MinHash:
+	case schemapb.FunctionType_MultimodalEmbedding:
+		if len(function.GetParams()) == 0 {
+			return errors.New("MultimodalEmbedding function requires params")
+		}
+	case schemapb.FunctionType_TextChunking:
 	default:
 		return errors.New("check function params with unknown function type")
 	}
@@ -2866,6 +2866,56 @@ func TestValidateFunction(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "function output field cannot be nullable")
 	})
+
+	t.Run("TextChunking function", func(t *testing.T) {
+		newSchema := func() *schemapb.CollectionSchema {
+			return &schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{
+					{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, AutoID: true},
+					{Name: "text", DataType: schemapb.DataType_VarChar},
+					{Name: "parent", DataType: schemapb.DataType_Int64},
+				},
+				Functions: []*schemapb.FunctionSchema{
+					{
+						Name:             "chunking",
+						Type:             schemapb.FunctionType_TextChunking,
+						InputFieldNames:  []string{"text"},
+						OutputFieldNames: []string{"parent"},
+						Params:           []*commonpb.KeyValuePair{{Key: "chunk_size", Value: "256"}},
+					},
+				},
+			}
+		}
+		schema := newSchema()
+		assert.NoError(t, validateFunction(schema))
+		assert.True(t, schema.Fields[2].GetIsFunctionOutput())
+
+		schema = newSchema()
+		schema.Fields[0].AutoID = false
+		err := validateFunction(schema)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "the primary key must be auto id")
+
+		schema = newSchema()
+		schema.Fields = append(schema.Fields, &schemapb.FieldSchema{Name: "parent2", DataType: schemapb.DataType_Int64})
+		schema.Functions = append(schema.Functions, &schemapb.FunctionSchema{
+			Name:             "chunking2",
+			Type:             schemapb.FunctionType_TextChunking,
+			InputFieldNames:  []string{"text"},
+			OutputFieldNames: []string{"parent2"},
+		})
+		err = validateFunction(schema)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only one TextChunking function is allowed")
+
+		schema = newSchema()
+		schema.Functions[0].Params = []*commonpb.KeyValuePair{{Key: "chunk_size", Value: "0"}}
+		assert.Error(t, validateFunction(schema))
+
+		schema = newSchema()
+		schema.Fields[2].DataType = schemapb.DataType_VarChar
+		assert.Error(t, validateFunction(schema))
+	})
 }
 
 func TestValidateModelFunction(t *testing.T) {
@@ -3126,6 +3176,52 @@ func TestValidateFunctionOutputField(t *testing.T) {
 		err := checkFunctionOutputField(function, fields)
 		assert.Error(t, err)
 	})
+
+	t.Run("MinHash function output", func(t *testing.T) {
+		fn := &schemapb.FunctionSchema{
+			Type: schemapb.FunctionType_MinHash,
+		}
+		fields := []*schemapb.FieldSchema{
+			{
+				DataType:   schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "512"}},
+			},
+		}
+		assert.NoError(t, checkFunctionOutputField(fn, fields))
+
+		fields[0].TypeParams = []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "16"}}
+		assert.Error(t, checkFunctionOutputField(fn, fields))
+
+		fields[0].DataType = schemapb.DataType_FloatVector
+		assert.Error(t, checkFunctionOutputField(fn, fields))
+	})
+
+	t.Run("MultimodalEmbedding function", func(t *testing.T) {
+		fn := &schemapb.FunctionSchema{
+			Type: schemapb.FunctionType_MultimodalEmbedding,
+		}
+		assert.NoError(t, checkFunctionOutputField(fn, []*schemapb.FieldSchema{{DataType: schemapb.DataType_FloatVector}}))
+		assert.Error(t, checkFunctionOutputField(fn, []*schemapb.FieldSchema{{DataType: schemapb.DataType_BinaryVector}}))
+
+		image := &schemapb.FieldSchema{DataType: schemapb.DataType_VarChar}
+		caption := &schemapb.FieldSchema{DataType: schemapb.DataType_Text}
+		assert.NoError(t, checkFunctionInputField(fn, []*schemapb.FieldSchema{image}))
+		assert.NoError(t, checkFunctionInputField(fn, []*schemapb.FieldSchema{image, caption}))
+		assert.Error(t, checkFunctionInputField(fn, []*schemapb.FieldSchema{image, caption, image}))
+		assert.Error(t, checkFunctionInputField(fn, []*schemapb.FieldSchema{{DataType: schemapb.DataType_Int64}}))
+	})
+
+	t.Run("TextChunking function", func(t *testing.T) {
+		fn := &schemapb.FunctionSchema{
+			Type: schemapb.FunctionType_TextChunking,
+		}
+		assert.NoError(t, checkFunctionOutputField(fn, []*schemapb.FieldSchema{{DataType: schemapb.DataType_Int64}}))
+		assert.Error(t, checkFunctionOutputField(fn, []*schemapb.FieldSchema{{DataType: schemapb.DataType_VarChar}}))
+
+		assert.NoError(t, checkFunctionInputField(fn, []*schemapb.FieldSchema{{DataType: schemapb.DataType_Text}}))
+		assert.Error(t, checkFunctionInputField(fn, []*schemapb.FieldSchema{{DataType: schemapb.DataType_VarChar, IsPartitionKey: true}}))
+		assert.Error(t, checkFunctionInputField(fn, []*schemapb.FieldSchema{{DataType: schemapb.DataType_Int64}}))
+	})
 }
 
 func TestValidateFunctionBasicParams(t *testing.T) {
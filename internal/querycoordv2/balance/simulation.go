@@ -0,0 +1,178 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balance
+
+import (
+	"context"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// SimulateBalance runs BalanceReplica of the balancer on every replica against the current
+// distribution, and returns the generated plans together with the per node distribution
+// before and after applying them. No task will be submitted to the scheduler.
+func SimulateBalance(ctx context.Context, balancerName string, balancer Balance, dist *meta.DistributionManager, replicas []*meta.Replica) *metricsinfo.BalancePlan {
+	plan := &metricsinfo.BalancePlan{
+		Balancer:     balancerName,
+		SegmentPlans: make([]*metricsinfo.BalanceSegmentPlan, 0),
+		ChannelPlans: make([]*metricsinfo.BalanceChannelPlan, 0),
+	}
+
+	nodes := make(map[int64]*metricsinfo.BalanceNodeDist)
+	getNode := func(nodeID int64) *metricsinfo.BalanceNodeDist {
+		node, ok := nodes[nodeID]
+		if !ok {
+			node = newBalanceNodeDist(dist, nodeID)
+			nodes[nodeID] = node
+		}
+		return node
+	}
+
+	for _, replica := range replicas {
+		for _, nodeID := range replica.GetNodes() {
+			getNode(nodeID)
+		}
+
+		segmentPlans, channelPlans := balancer.BalanceReplica(ctx, replica)
+		for _, p := range segmentPlans {
+			rows, memSize := p.Segment.GetNumOfRows(), estimateSegmentMemSize(p.Segment)
+			plan.SegmentPlans = append(plan.SegmentPlans, &metricsinfo.BalanceSegmentPlan{
+				SegmentID:    p.Segment.GetID(),
+				CollectionID: p.Segment.GetCollectionID(),
+				ReplicaID:    replica.GetID(),
+				Channel:      p.Segment.GetInsertChannel(),
+				NumOfRows:    rows,
+				MemSize:      memSize,
+				From:         p.From,
+				To:           p.To,
+				FromScore:    p.FromScore,
+				ToScore:      p.ToScore,
+				SegmentScore: p.SegmentScore,
+			})
+			if p.From != -1 {
+				from := getNode(p.From)
+				from.SegmentCountAfter--
+				from.RowCountAfter -= rows
+				from.MemSizeAfter -= memSize
+				from.ScoreDelta -= p.SegmentScore
+			}
+			if p.To != -1 {
+				to := getNode(p.To)
+				to.SegmentCountAfter++
+				to.RowCountAfter += rows
+				to.MemSizeAfter += memSize
+				to.ScoreDelta += p.SegmentScore
+			}
+		}
+
+		for _, p := range channelPlans {
+			var growingRows int64
+			if p.Channel.View != nil {
+				growingRows = p.Channel.View.NumOfGrowingRows
+			}
+			plan.ChannelPlans = append(plan.ChannelPlans, &metricsinfo.BalanceChannelPlan{
+				CollectionID: p.Channel.GetCollectionID(),
+				ReplicaID:    replica.GetID(),
+				ChannelName:  p.Channel.GetChannelName(),
+				From:         p.From,
+				To:           p.To,
+				FromScore:    p.FromScore,
+				ToScore:      p.ToScore,
+				ChannelScore: p.ChannelScore,
+			})
+			if p.From != -1 {
+				from := getNode(p.From)
+				from.ChannelCountAfter--
+				from.RowCountAfter -= growingRows
+				from.ScoreDelta -= p.ChannelScore
+			}
+			if p.To != -1 {
+				to := getNode(p.To)
+				to.ChannelCountAfter++
+				to.RowCountAfter += growingRows
+				to.ScoreDelta += p.ChannelScore
+			}
+		}
+	}
+
+	plan.Nodes = make([]*metricsinfo.BalanceNodeDist, 0, len(nodes))
+	for _, node := range nodes {
+		plan.Nodes = append(plan.Nodes, node)
+	}
+	sort.Slice(plan.Nodes, func(i, j int) bool {
+		return plan.Nodes[i].NodeID < plan.Nodes[j].NodeID
+	})
+	return plan
+}
+
+// newBalanceNodeDist collects the current distribution on the node,
+// the "after" fields start from the current value and are adjusted by plans.
+func newBalanceNodeDist(dist *meta.DistributionManager, nodeID int64) *metricsinfo.BalanceNodeDist {
+	node := &metricsinfo.BalanceNodeDist{NodeID: nodeID}
+	segments := dist.SegmentDistManager.GetByFilter(meta.WithNodeID(nodeID))
+	for _, s := range segments {
+		node.RowCount += s.GetNumOfRows()
+		node.MemSize += estimateSegmentMemSize(s)
+	}
+	channels := dist.ChannelDistManager.GetByFilter(meta.WithNodeID2Channel(nodeID))
+	for _, ch := range channels {
+		if ch.View != nil {
+			node.RowCount += ch.View.NumOfGrowingRows
+		}
+	}
+	node.SegmentCount = len(segments)
+	node.ChannelCount = len(channels)
+
+	node.SegmentCountAfter = node.SegmentCount
+	node.ChannelCountAfter = node.ChannelCount
+	node.RowCountAfter = node.RowCount
+	node.MemSizeAfter = node.MemSize
+	return node
+}
+
+// estimateSegmentMemSize estimates the memory usage of a sealed segment by its binlogs and loaded indexes,
+// fields which have a loaded index are counted by the index size instead of the raw binlogs.
+func estimateSegmentMemSize(s *meta.Segment) int64 {
+	var size int64
+	indexedFields := typeutil.NewSet[int64]()
+	for _, info := range s.IndexInfo {
+		indexedFields.Insert(info.GetFieldID())
+		size += info.GetIndexSize()
+	}
+	binlogSize := func(fieldBinlogs []*datapb.FieldBinlog, skipIndexed bool) {
+		for _, fieldBinlog := range fieldBinlogs {
+			if skipIndexed && indexedFields.Contain(fieldBinlog.GetFieldID()) {
+				continue
+			}
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				if binlog.GetMemorySize() > 0 {
+					size += binlog.GetMemorySize()
+				} else {
+					size += binlog.GetLogSize()
+				}
+			}
+		}
+	}
+	binlogSize(s.GetBinlogs(), true)
+	binlogSize(s.GetStatslogs(), false)
+	binlogSize(s.GetDeltalogs(), false)
+	return size
+}
@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+)
+
+func TestSimulateBalance(t *testing.T) {
+	ctx := context.Background()
+	dist := meta.NewDistributionManager()
+
+	moved := &meta.Segment{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:            1,
+			CollectionID:  100,
+			NumOfRows:     100,
+			InsertChannel: "v1",
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 101, Binlogs: []*datapb.Binlog{{LogSize: 10, MemorySize: 40}}},
+				{FieldID: 102, Binlogs: []*datapb.Binlog{{LogSize: 30}}},
+			},
+		},
+		Node: 1,
+		IndexInfo: map[int64]*querypb.FieldIndexInfo{
+			1000: {FieldID: 102, IndexID: 1000, IndexSize: 20},
+		},
+	}
+	dist.SegmentDistManager.Update(1, moved, &meta.Segment{
+		SegmentInfo: &datapb.SegmentInfo{ID: 2, CollectionID: 100, NumOfRows: 50, InsertChannel: "v1"},
+		Node:        1,
+	})
+	channel := &meta.DmChannel{
+		VchannelInfo: &datapb.VchannelInfo{CollectionID: 100, ChannelName: "v1"},
+		Node:         1,
+		View:         &meta.LeaderView{ID: 1, CollectionID: 100, Channel: "v1", NumOfGrowingRows: 7},
+	}
+	dist.ChannelDistManager.Update(1, channel)
+
+	replica := meta.NewReplica(&querypb.Replica{ID: 10, CollectionID: 100, Nodes: []int64{1, 2}})
+
+	balancer := NewMockBalancer(t)
+	balancer.EXPECT().BalanceReplica(mock.Anything, mock.Anything).Return(
+		[]SegmentAssignPlan{{Segment: moved, Replica: replica, From: 1, To: 2, SegmentScore: 100}},
+		[]ChannelAssignPlan{{Channel: channel, Replica: replica, From: 1, To: 2, ChannelScore: 1}},
+	)
+
+	plan := SimulateBalance(ctx, meta.ScoreBasedBalancerName, balancer, dist, []*meta.Replica{replica})
+	assert.Equal(t, meta.ScoreBasedBalancerName, plan.Balancer)
+	assert.Len(t, plan.SegmentPlans, 1)
+	assert.EqualValues(t, 1, plan.SegmentPlans[0].SegmentID)
+	assert.EqualValues(t, 60, plan.SegmentPlans[0].MemSize)
+	assert.Len(t, plan.ChannelPlans, 1)
+	assert.Equal(t, "v1", plan.ChannelPlans[0].ChannelName)
+
+	assert.Len(t, plan.Nodes, 2)
+	source, target := plan.Nodes[0], plan.Nodes[1]
+	assert.EqualValues(t, 1, source.NodeID)
+	assert.Equal(t, 2, source.SegmentCount)
+	assert.Equal(t, 1, source.SegmentCountAfter)
+	assert.Equal(t, 0, source.ChannelCountAfter)
+	assert.EqualValues(t, 157, source.RowCount)
+	assert.EqualValues(t, 50, source.RowCountAfter)
+	assert.EqualValues(t, 60, source.MemSize)
+	assert.EqualValues(t, 0, source.MemSizeAfter)
+	assert.EqualValues(t, -101, source.ScoreDelta)
+
+	assert.EqualValues(t, 2, target.NodeID)
+	assert.Equal(t, 0, target.SegmentCount)
+	assert.Equal(t, 1, target.SegmentCountAfter)
+	assert.Equal(t, 1, target.ChannelCountAfter)
+	assert.EqualValues(t, 107, target.RowCountAfter)
+	assert.EqualValues(t, 60, target.MemSizeAfter)
+	assert.EqualValues(t, 101, target.ScoreDelta)
+}
@@ -19,6 +19,7 @@ package querycoordv2
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
@@ -320,6 +322,56 @@ func (s *Server) getSegmentsJSON(ctx context.Context, req *milvuspb.GetMetricsRe
 	return "", fmt.Errorf("invalid param value in=[%s], it should be qc or qn", in)
 }
 
+// getTasksJSON returns the scheduled tasks followed by the unfinished load/release jobs.
+func (s *Server) getTasksJSON() (string, error) {
+	tasks := make([]*metricsinfo.QueryCoordTask, 0)
+	if ret := s.taskScheduler.GetTasksJSON(); ret != "" {
+		if err := json.Unmarshal([]byte(ret), &tasks); err != nil {
+			return "", err
+		}
+	}
+	tasks = append(tasks, s.jobScheduler.GetJobStats()...)
+	bs, err := json.Marshal(tasks)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// getBalancePlanJSON simulates one balance round with the requested balancer (the configured one by default)
+// on the loaded collections, the generated plans are returned without submitting any task.
+func (s *Server) getBalancePlanJSON(ctx context.Context, jsonReq gjson.Result) (string, error) {
+	balancerName := paramtable.Get().QueryCoordCfg.Balancer.GetValue()
+	if v := jsonReq.Get(metricsinfo.MetricRequestParamBalancerKey); v.Exists() && v.String() != "" {
+		balancerName = v.String()
+		if !lo.Contains(balancerNames, balancerName) {
+			return "", merr.WrapErrParameterInvalid(strings.Join(balancerNames, "|"), balancerName, "unknown balancer")
+		}
+	}
+
+	collectionIDs := s.meta.CollectionManager.GetAll(ctx)
+	if collectionID := metricsinfo.GetCollectionIDFromRequest(jsonReq); collectionID != 0 {
+		collectionIDs = []int64{collectionID}
+	}
+
+	replicas := make([]*meta.Replica, 0)
+	for _, collectionID := range collectionIDs {
+		collection := s.meta.CollectionManager.GetCollection(ctx, collectionID)
+		if collection == nil || collection.GetStatus() != querypb.LoadStatus_Loaded {
+			continue
+		}
+		replicas = append(replicas, s.meta.ReplicaManager.GetByCollection(ctx, collectionID)...)
+	}
+
+	plan := balance.SimulateBalance(ctx, balancerName, s.getBalancerByName(balancerName), s.dist, replicas)
+	bs, err := json.Marshal(plan)
+	if err != nil {
+		log.Ctx(ctx).Warn("marshal balance plan failed", zap.String("balancer", balancerName), zap.Error(err))
+		return "", err
+	}
+	return string(bs), nil
+}
+
 // TODO(dragondriver): add more detail metrics
 func (s *Server) getSystemInfoMetrics(
 	ctx context.Context,
@@ -27,6 +27,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/observers"
@@ -42,6 +43,7 @@ import (
 
 type LoadCollectionJob struct {
 	*BaseJob
+	loadSlot
 	req  *querypb.LoadCollectionRequest
 	undo *UndoList
 
@@ -54,6 +56,7 @@ type LoadCollectionJob struct {
 	nodeMgr                  *session.NodeManager
 	collInfo                 *milvuspb.DescribeCollectionResponse
 	userSpecifiedReplicaMode bool
+	admission                *loadAdmission
 }
 
 func NewLoadCollectionJob(
@@ -80,9 +83,18 @@ func NewLoadCollectionJob(
 		collectionObserver:       collectionObserver,
 		nodeMgr:                  nodeMgr,
 		userSpecifiedReplicaMode: userSpecifiedReplicaMode,
+		admission:                newLoadAdmission(meta, dist, broker, nodeMgr),
 	}
 }
 
+func (job *LoadCollectionJob) DBID() int64 {
+	return job.req.GetDbID()
+}
+
+func (job *LoadCollectionJob) Priority() commonpb.LoadPriority {
+	return job.req.GetPriority()
+}
+
 func (job *LoadCollectionJob) PreExecute() error {
 	req := job.req
 	log := log.Ctx(job.ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
@@ -106,7 +118,7 @@ func (job *LoadCollectionJob) PreExecute() error {
 
 	collection := job.meta.GetCollection(job.ctx, req.GetCollectionID())
 	if collection == nil {
-		return nil
+		return job.checkLoadAdmission()
 	}
 
 	if collection.GetReplicaNumber() != req.GetReplicaNumber() {
@@ -126,7 +138,23 @@ func (job *LoadCollectionJob) PreExecute() error {
 		return merr.WrapErrParameterInvalid(collectionUsedRG, req.GetResourceGroups(), "can't change the resource groups for loaded partitions")
 	}
 
-	return nil
+	return job.checkLoadAdmission()
+}
+
+// checkLoadAdmission rejects the job if the target resource groups don't have enough free capacity to load the lacked partitions.
+func (job *LoadCollectionJob) checkLoadAdmission() error {
+	if !isLoadAdmissionEnabled() {
+		return nil
+	}
+	req := job.req
+	partitionIDs, err := job.broker.GetPartitions(job.ctx, req.GetCollectionID())
+	if err != nil {
+		log.Ctx(job.ctx).Warn("failed to get partitions from RootCoord", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		return err
+	}
+	lackPartitionIDs := getLackPartitions(job.ctx, job.meta, req.GetCollectionID(), partitionIDs)
+	return job.admission.Admit(job.ctx, req.GetCollectionID(), lackPartitionIDs, job.collInfo.GetSchema(),
+		req.GetLoadFields(), req.GetResourceGroups(), req.GetReplicaNumber())
 }
 
 func (job *LoadCollectionJob) Execute() error {
@@ -229,8 +257,8 @@ func (job *LoadCollectionJob) Execute() error {
 	}
 	job.undo.IsTargetUpdated = true
 
-	// 6. register load task into collection observer
-	job.collectionObserver.LoadCollection(ctx, req.GetCollectionID())
+	// 6. register load task into collection observer, the load slot is released after the collection is loaded
+	job.collectionObserver.LoadCollection(ctx, req.GetCollectionID(), job.handOverSlot())
 
 	return nil
 }
@@ -243,6 +271,7 @@ func (job *LoadCollectionJob) PostExecute() {
 
 type LoadPartitionJob struct {
 	*BaseJob
+	loadSlot
 	req  *querypb.LoadPartitionsRequest
 	undo *UndoList
 
@@ -255,6 +284,7 @@ type LoadPartitionJob struct {
 	nodeMgr                  *session.NodeManager
 	collInfo                 *milvuspb.DescribeCollectionResponse
 	userSpecifiedReplicaMode bool
+	admission                *loadAdmission
 }
 
 func NewLoadPartitionJob(
@@ -281,9 +311,18 @@ func NewLoadPartitionJob(
 		collectionObserver:       collectionObserver,
 		nodeMgr:                  nodeMgr,
 		userSpecifiedReplicaMode: userSpecifiedReplicaMode,
+		admission:                newLoadAdmission(meta, dist, broker, nodeMgr),
 	}
 }
 
+func (job *LoadPartitionJob) DBID() int64 {
+	return job.req.GetDbID()
+}
+
+func (job *LoadPartitionJob) Priority() commonpb.LoadPriority {
+	return job.req.GetPriority()
+}
+
 func (job *LoadPartitionJob) PreExecute() error {
 	req := job.req
 	log := log.Ctx(job.ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
@@ -307,7 +346,7 @@ func (job *LoadPartitionJob) PreExecute() error {
 
 	collection := job.meta.GetCollection(job.ctx, req.GetCollectionID())
 	if collection == nil {
-		return nil
+		return job.checkLoadAdmission()
 	}
 
 	if collection.GetReplicaNumber() != req.GetReplicaNumber() {
@@ -325,7 +364,18 @@ func (job *LoadPartitionJob) PreExecute() error {
 		return merr.WrapErrParameterInvalid(collectionUsedRG, req.GetResourceGroups(), "can't change the resource groups for loaded partitions")
 	}
 
-	return nil
+	return job.checkLoadAdmission()
+}
+
+// checkLoadAdmission rejects the job if the target resource groups don't have enough free capacity to load the lacked partitions.
+func (job *LoadPartitionJob) checkLoadAdmission() error {
+	if !isLoadAdmissionEnabled() {
+		return nil
+	}
+	req := job.req
+	lackPartitionIDs := getLackPartitions(job.ctx, job.meta, req.GetCollectionID(), req.GetPartitionIDs())
+	return job.admission.Admit(job.ctx, req.GetCollectionID(), lackPartitionIDs, job.collInfo.GetSchema(),
+		req.GetLoadFields(), req.GetResourceGroups(), req.GetReplicaNumber())
 }
 
 func (job *LoadPartitionJob) Execute() error {
@@ -442,7 +492,7 @@ func (job *LoadPartitionJob) Execute() error {
 	}
 	job.undo.IsTargetUpdated = true
 
-	job.collectionObserver.LoadPartitions(ctx, req.GetCollectionID(), lackPartitionIDs)
+	job.collectionObserver.LoadPartitions(ctx, req.GetCollectionID(), lackPartitionIDs, job.handOverSlot())
 
 	return nil
 }
@@ -0,0 +1,357 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const diskANNIndexType = "DISKANN"
+
+// loadResourceUsage is the estimated resource usage of loading segments, in bytes.
+type loadResourceUsage struct {
+	MemorySize   uint64
+	DiskSize     uint64
+	SegmentCount int
+}
+
+func (u *loadResourceUsage) add(other loadResourceUsage) {
+	u.MemorySize += other.MemorySize
+	u.DiskSize += other.DiskSize
+	u.SegmentCount += other.SegmentCount
+}
+
+// loadAdmission estimates the memory and disk required to load one replica of the given partitions,
+// and checks it against the free capacity of the target resource groups before the load job is accepted.
+type loadAdmission struct {
+	meta    *meta.Meta
+	dist    *meta.DistributionManager
+	broker  meta.Broker
+	nodeMgr *session.NodeManager
+}
+
+func newLoadAdmission(m *meta.Meta, dist *meta.DistributionManager, broker meta.Broker, nodeMgr *session.NodeManager) *loadAdmission {
+	return &loadAdmission{
+		meta:    m,
+		dist:    dist,
+		broker:  broker,
+		nodeMgr: nodeMgr,
+	}
+}
+
+func isLoadAdmissionEnabled() bool {
+	return paramtable.Get().QueryCoordCfg.LoadAdmissionEnabled.GetAsBool()
+}
+
+// getLackPartitions returns the partitions which haven't been loaded.
+func getLackPartitions(ctx context.Context, m *meta.Meta, collectionID int64, partitionIDs []int64) []int64 {
+	loadedPartitionIDs := lo.Map(m.CollectionManager.GetPartitionsByCollection(ctx, collectionID),
+		func(partition *meta.Partition, _ int) int64 {
+			return partition.GetPartitionID()
+		})
+	return lo.Without(partitionIDs, loadedPartitionIDs...)
+}
+
+// Admit checks whether the load request could be accepted, it waits in queue for at most
+// `queryCoord.loadAdmission.waitTimeout` if the free capacity is not enough.
+func (la *loadAdmission) Admit(ctx context.Context,
+	collectionID int64,
+	partitionIDs []int64,
+	schema *schemapb.CollectionSchema,
+	loadFields []int64,
+	resourceGroups []string,
+	replicaNumber int32,
+) error {
+	if len(partitionIDs) == 0 {
+		return nil
+	}
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID), zap.Int64s("partitionIDs", partitionIDs))
+
+	usage, err := la.estimate(ctx, collectionID, partitionIDs, schema, loadFields)
+	if err != nil {
+		// estimation is best effort, never block the load because of it
+		log.Warn("failed to estimate load resource usage, skip load admission", zap.Error(err))
+		return nil
+	}
+	log.Info("estimated load resource usage per replica",
+		zap.Int("segmentNum", usage.SegmentCount),
+		zap.Uint64("memorySize", usage.MemorySize),
+		zap.Uint64("diskSize", usage.DiskSize))
+
+	err = la.check(ctx, collectionID, usage, resourceGroups, replicaNumber)
+	if err == nil {
+		return nil
+	}
+	timeout := paramtable.Get().QueryCoordCfg.LoadAdmissionWaitTimeout.GetAsDuration(time.Second)
+	if timeout <= 0 {
+		log.Warn("load request rejected by load admission", zap.Error(err))
+		return err
+	}
+
+	log.Info("no enough capacity for load request, wait in queue", zap.Duration("timeout", timeout), zap.Error(err))
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			log.Warn("load request rejected by load admission after waiting", zap.Error(err))
+			return err
+		case <-ticker.C:
+			if err = la.check(ctx, collectionID, usage, resourceGroups, replicaNumber); err == nil {
+				return nil
+			}
+		}
+	}
+}
+
+// estimate estimates the resource usage of loading one replica of the partitions.
+func (la *loadAdmission) estimate(ctx context.Context, collectionID int64, partitionIDs []int64, schema *schemapb.CollectionSchema, loadFields []int64) (*loadResourceUsage, error) {
+	_, segments, err := la.broker.GetRecoveryInfoV2(ctx, collectionID, partitionIDs...)
+	if err != nil {
+		return nil, err
+	}
+	segmentIDs := lo.FilterMap(segments, func(segment *datapb.SegmentInfo, _ int) (int64, bool) {
+		return segment.GetID(), segment.GetLevel() != datapb.SegmentLevel_L0
+	})
+	usage := &loadResourceUsage{}
+	if len(segmentIDs) == 0 {
+		return usage, nil
+	}
+
+	segmentInfos, err := la.broker.GetSegmentInfo(ctx, segmentIDs...)
+	if err != nil {
+		return nil, err
+	}
+	indexInfos, err := la.broker.GetIndexInfo(ctx, collectionID, segmentIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	estimator := newSegmentResourceEstimator(schema, loadFields)
+	for _, segment := range segmentInfos {
+		usage.add(estimator.estimate(segment, indexInfos[segment.GetID()]))
+	}
+	return usage, nil
+}
+
+// check compares the required resource of all replicas in each resource group with the free capacity of the group.
+func (la *loadAdmission) check(ctx context.Context, collectionID int64, usage *loadResourceUsage, resourceGroups []string, replicaNumber int32) error {
+	replicaNumInRG, err := utils.AssignReplica(ctx, la.meta, resourceGroups, replicaNumber, false)
+	if err != nil {
+		return err
+	}
+
+	diskCapacity := uint64(float64(paramtable.Get().QueryNodeCfg.DiskCapacityLimit.GetAsInt64()) *
+		paramtable.Get().QueryNodeCfg.MaxDiskUsagePercentage.GetAsFloat())
+	memoryThreshold := paramtable.Get().QueryNodeCfg.OverloadedMemoryThresholdPercentage.GetAsFloat()
+	for rg, replicaNum := range replicaNumInRG {
+		if replicaNum == 0 {
+			continue
+		}
+		nodes, err := la.meta.ResourceManager.GetNodes(ctx, rg)
+		if err != nil {
+			return err
+		}
+
+		var freeMemory, freeDisk uint64
+		nodeNum := 0
+		for _, nodeID := range nodes {
+			info := la.nodeMgr.Get(nodeID)
+			if info == nil || info.GetState() != session.NodeStateNormal {
+				continue
+			}
+			if info.MemCapacity() <= 0 {
+				// memory capacity is unknown until the first heartbeat, skip the check to avoid false rejection
+				log.Ctx(ctx).Info("memory capacity of node is unknown, skip load admission", zap.Int64("nodeID", nodeID))
+				return nil
+			}
+			used := la.getNodeUsage(ctx, nodeID)
+			memoryCapacity := uint64(info.MemCapacity() * 1024 * 1024 * memoryThreshold)
+			if memoryCapacity > used.MemorySize {
+				freeMemory += memoryCapacity - used.MemorySize
+			}
+			if diskCapacity > used.DiskSize {
+				freeDisk += diskCapacity - used.DiskSize
+			}
+			nodeNum++
+		}
+
+		requiredMemory := usage.MemorySize * uint64(replicaNum)
+		requiredDisk := usage.DiskSize * uint64(replicaNum)
+		breakdown := fmt.Sprintf("collection %d needs %s memory and %s disk for %d replica(s) in resource group %s "+
+			"(per replica: %d segments, %s memory, %s disk), but only %s memory and %s disk are free on %d node(s)",
+			collectionID, formatBytes(requiredMemory), formatBytes(requiredDisk), replicaNum, rg,
+			usage.SegmentCount, formatBytes(usage.MemorySize), formatBytes(usage.DiskSize),
+			formatBytes(freeMemory), formatBytes(freeDisk), nodeNum)
+		if requiredMemory > freeMemory {
+			return merr.WrapErrServiceMemoryLimitExceeded(float32(toMB(requiredMemory)), float32(toMB(freeMemory)), breakdown)
+		}
+		if requiredDisk > freeDisk {
+			return merr.WrapErrServiceDiskLimitExceeded(float32(toMB(requiredDisk)), float32(toMB(freeDisk)), breakdown)
+		}
+	}
+	return nil
+}
+
+// getNodeUsage estimates the resource usage of the segments which have been loaded on the node.
+func (la *loadAdmission) getNodeUsage(ctx context.Context, nodeID int64) loadResourceUsage {
+	usage := loadResourceUsage{}
+	estimators := make(map[int64]*segmentResourceEstimator)
+	for _, segment := range la.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(nodeID)) {
+		estimator, ok := estimators[segment.GetCollectionID()]
+		if !ok {
+			var loadFields []int64
+			if collection := la.meta.CollectionManager.GetCollection(ctx, segment.GetCollectionID()); collection != nil {
+				loadFields = collection.GetLoadFields()
+			}
+			schema := la.meta.CollectionManager.GetCollectionSchema(ctx, segment.GetCollectionID())
+			estimator = newSegmentResourceEstimator(schema, loadFields)
+			estimators[segment.GetCollectionID()] = estimator
+		}
+		usage.add(estimator.estimate(segment.SegmentInfo, lo.Values(segment.IndexInfo)))
+	}
+	return usage
+}
+
+// segmentResourceEstimator estimates the resource usage of a sealed segment in querynode,
+// it roughly follows the estimation of segment loader with the binlog and index sizes known by querycoord.
+type segmentResourceEstimator struct {
+	fields     map[int64]*schemapb.FieldSchema
+	loadFields typeutil.Set[int64]
+}
+
+func newSegmentResourceEstimator(schema *schemapb.CollectionSchema, loadFields []int64) *segmentResourceEstimator {
+	return &segmentResourceEstimator{
+		fields: lo.SliceToMap(schema.GetFields(), func(field *schemapb.FieldSchema) (int64, *schemapb.FieldSchema) {
+			return field.GetFieldID(), field
+		}),
+		loadFields: typeutil.NewSet(loadFields...),
+	}
+}
+
+func (e *segmentResourceEstimator) estimate(segment *datapb.SegmentInfo, indexes []*querypb.FieldIndexInfo) loadResourceUsage {
+	usage := loadResourceUsage{SegmentCount: 1}
+	indexedVectorFields := typeutil.NewSet[int64]()
+	for _, index := range indexes {
+		field := e.fields[index.GetFieldID()]
+		if !e.shouldLoad(index.GetFieldID()) {
+			continue
+		}
+		isVector := field != nil && typeutil.IsVectorType(field.GetDataType())
+		if isVector {
+			indexedVectorFields.Insert(index.GetFieldID())
+		}
+		if isIndexOnDisk(index, isVector) {
+			usage.DiskSize += uint64(index.GetIndexSize())
+		} else {
+			usage.MemorySize += uint64(index.GetIndexSize())
+		}
+	}
+
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		fieldID := fieldBinlog.GetFieldID()
+		// raw data of vector field is served by index for most index types
+		if indexedVectorFields.Contain(fieldID) || !e.shouldLoad(fieldID) {
+			continue
+		}
+		memorySize, diskSize := binlogSize(fieldBinlog)
+		field, ok := e.fields[fieldID]
+		if !ok || common.IsSystemField(fieldID) || !isDataOnDisk(field) {
+			usage.MemorySize += memorySize
+		} else {
+			usage.DiskSize += diskSize
+		}
+	}
+
+	for _, fieldBinlog := range segment.GetStatslogs() {
+		memorySize, _ := binlogSize(fieldBinlog)
+		usage.MemorySize += memorySize
+	}
+	for _, fieldBinlog := range segment.GetDeltalogs() {
+		memorySize, _ := binlogSize(fieldBinlog)
+		usage.MemorySize += memorySize
+	}
+	return usage
+}
+
+func (e *segmentResourceEstimator) shouldLoad(fieldID int64) bool {
+	return e.loadFields.Len() == 0 || common.IsSystemField(fieldID) || e.loadFields.Contain(fieldID)
+}
+
+func isIndexOnDisk(index *querypb.FieldIndexInfo, isVector bool) bool {
+	if common.GetIndexType(index.GetIndexParams()) == diskANNIndexType {
+		return true
+	}
+	if enabled, exist := common.IsMmapIndexEnabled(index.GetIndexParams()...); exist {
+		return enabled
+	}
+	if isVector {
+		return paramtable.Get().QueryNodeCfg.MmapVectorIndex.GetAsBool()
+	}
+	return paramtable.Get().QueryNodeCfg.MmapScalarIndex.GetAsBool()
+}
+
+func isDataOnDisk(field *schemapb.FieldSchema) bool {
+	if enabled, exist := common.IsMmapDataEnabled(field.GetTypeParams()...); exist {
+		return enabled
+	}
+	if typeutil.IsVectorType(field.GetDataType()) {
+		return paramtable.Get().QueryNodeCfg.MmapVectorField.GetAsBool()
+	}
+	return paramtable.Get().QueryNodeCfg.MmapScalarField.GetAsBool()
+}
+
+func binlogSize(fieldBinlog *datapb.FieldBinlog) (memorySize uint64, diskSize uint64) {
+	for _, binlog := range fieldBinlog.GetBinlogs() {
+		diskSize += uint64(binlog.GetLogSize())
+		if binlog.GetMemorySize() > 0 {
+			memorySize += uint64(binlog.GetMemorySize())
+		} else {
+			memorySize += uint64(binlog.GetLogSize())
+		}
+	}
+	return memorySize, diskSize
+}
+
+func toMB(size uint64) float64 {
+	return float64(size) / 1024 / 1024
+}
+
+func formatBytes(size uint64) string {
+	return fmt.Sprintf("%.2fMB", toMB(size))
+}
@@ -0,0 +1,238 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestSegmentResourceEstimator(t *testing.T) {
+	paramtable.Init()
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, DataType: schemapb.DataType_FloatVector},
+			{
+				FieldID:    102,
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MmapEnabledKey, Value: "true"}},
+			},
+			{FieldID: 103, DataType: schemapb.DataType_Int64},
+		},
+	}
+	fieldBinlog := func(fieldID int64, logSize, memorySize int64) *datapb.FieldBinlog {
+		return &datapb.FieldBinlog{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{{LogSize: logSize, MemorySize: memorySize}},
+		}
+	}
+	segment := &datapb.SegmentInfo{
+		ID: 1,
+		Binlogs: []*datapb.FieldBinlog{
+			fieldBinlog(common.RowIDField, 10, 0),
+			fieldBinlog(100, 10, 20),
+			fieldBinlog(101, 1000, 1000),
+			fieldBinlog(102, 50, 100),
+			fieldBinlog(103, 10, 30),
+		},
+		Statslogs: []*datapb.FieldBinlog{fieldBinlog(100, 5, 0)},
+		Deltalogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogSize: 7}}}},
+	}
+
+	t.Run("in memory index", func(t *testing.T) {
+		indexes := []*querypb.FieldIndexInfo{{FieldID: 101, IndexSize: 400}}
+		usage := newSegmentResourceEstimator(schema, nil).estimate(segment, indexes)
+		assert.Equal(t, 1, usage.SegmentCount)
+		// index(400) + rowID(10) + pk(20) + field 103(30) + stats(5) + delta(7)
+		assert.EqualValues(t, 472, usage.MemorySize)
+		// field 102 is mmapped, counted by log size
+		assert.EqualValues(t, 50, usage.DiskSize)
+	})
+
+	t.Run("diskann index", func(t *testing.T) {
+		indexes := []*querypb.FieldIndexInfo{{
+			FieldID:     101,
+			IndexSize:   400,
+			IndexParams: []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: diskANNIndexType}},
+		}}
+		usage := newSegmentResourceEstimator(schema, nil).estimate(segment, indexes)
+		assert.EqualValues(t, 72, usage.MemorySize)
+		assert.EqualValues(t, 450, usage.DiskSize)
+	})
+
+	t.Run("without index", func(t *testing.T) {
+		usage := newSegmentResourceEstimator(schema, nil).estimate(segment, nil)
+		assert.EqualValues(t, 1072, usage.MemorySize)
+		assert.EqualValues(t, 50, usage.DiskSize)
+	})
+
+	t.Run("partial load fields", func(t *testing.T) {
+		indexes := []*querypb.FieldIndexInfo{{FieldID: 101, IndexSize: 400}}
+		usage := newSegmentResourceEstimator(schema, []int64{100, 101}).estimate(segment, indexes)
+		assert.EqualValues(t, 442, usage.MemorySize)
+		assert.EqualValues(t, 0, usage.DiskSize)
+	})
+}
+
+func newTestLoadAdmission(t *testing.T, memCapacityMB float64) (*loadAdmission, *session.NodeInfo) {
+	ctx := context.Background()
+	store := mocks.NewQueryCoordCatalog(t)
+	store.EXPECT().SaveResourceGroup(mock.Anything, mock.Anything).Return(nil).Maybe()
+	store.EXPECT().SaveResourceGroup(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	nodeMgr := session.NewNodeManager()
+	m := meta.NewMeta(params.RandomIncrementIDAllocator(), store, nodeMgr)
+
+	node := session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1,
+		Address:  "localhost",
+		Hostname: "localhost",
+	})
+	node.UpdateStats(session.WithMemCapacity(memCapacityMB))
+	nodeMgr.Add(node)
+	m.ResourceManager.HandleNodeUp(ctx, 1)
+
+	// one segment with 100MB in memory raw data and a 2GB diskann index
+	broker := meta.NewMockBroker(t)
+	broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1000), int64(100)).
+		Return(nil, []*datapb.SegmentInfo{{ID: 1}, {ID: 2, Level: datapb.SegmentLevel_L0}}, nil).Maybe()
+	broker.EXPECT().GetSegmentInfo(mock.Anything, int64(1)).Return([]*datapb.SegmentInfo{{
+		ID: 1,
+		Binlogs: []*datapb.FieldBinlog{{
+			FieldID: 100,
+			Binlogs: []*datapb.Binlog{{LogSize: 50 * 1024 * 1024, MemorySize: 100 * 1024 * 1024}},
+		}},
+	}}, nil).Maybe()
+	broker.EXPECT().GetIndexInfo(mock.Anything, int64(1000), int64(1)).Return(map[int64][]*querypb.FieldIndexInfo{
+		1: {{
+			FieldID:     101,
+			IndexSize:   2 * 1024 * 1024 * 1024,
+			IndexParams: []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: diskANNIndexType}},
+		}},
+	}, nil).Maybe()
+
+	return newLoadAdmission(m, meta.NewDistributionManager(), broker, nodeMgr), node
+}
+
+func TestLoadAdmission(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	pt := paramtable.Get()
+	pt.Save(pt.QueryNodeCfg.DiskCapacityLimit.Key, "10")
+	defer pt.Reset(pt.QueryNodeCfg.DiskCapacityLimit.Key)
+
+	t.Run("admitted", func(t *testing.T) {
+		la, _ := newTestLoadAdmission(t, 1024)
+		err := la.Admit(ctx, 1000, []int64{100}, schema, nil, nil, 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("no partitions to load", func(t *testing.T) {
+		la, _ := newTestLoadAdmission(t, 1)
+		err := la.Admit(ctx, 1000, nil, schema, nil, nil, 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("memory capacity is unknown", func(t *testing.T) {
+		la, _ := newTestLoadAdmission(t, 0)
+		err := la.Admit(ctx, 1000, []int64{100}, schema, nil, nil, 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejected by memory", func(t *testing.T) {
+		la, _ := newTestLoadAdmission(t, 100)
+		err := la.Admit(ctx, 1000, []int64{100}, schema, nil, nil, 1)
+		assert.ErrorIs(t, err, merr.ErrServiceMemoryLimitExceeded)
+		assert.Contains(t, err.Error(), "resource group __default_resource_group")
+	})
+
+	t.Run("rejected by disk", func(t *testing.T) {
+		la, _ := newTestLoadAdmission(t, 1024)
+		// five replicas need 10GB disk, but only 9.5GB is usable
+		err := la.Admit(ctx, 1000, []int64{100}, schema, nil, nil, 5)
+		assert.ErrorIs(t, err, merr.ErrServiceDiskLimitExceeded)
+	})
+
+	t.Run("rejected by replica assignment", func(t *testing.T) {
+		la, _ := newTestLoadAdmission(t, 1024)
+		err := la.Admit(ctx, 1000, []int64{100}, schema, nil, []string{"rg1", "rg2"}, 3)
+		assert.Error(t, err)
+	})
+
+	t.Run("admitted after waiting", func(t *testing.T) {
+		pt.Save(pt.QueryCoordCfg.LoadAdmissionWaitTimeout.Key, "10")
+		defer pt.Reset(pt.QueryCoordCfg.LoadAdmissionWaitTimeout.Key)
+
+		la, node := newTestLoadAdmission(t, 100)
+		go func() {
+			time.Sleep(500 * time.Millisecond)
+			node.UpdateStats(session.WithMemCapacity(1024))
+		}()
+		start := time.Now()
+		err := la.Admit(ctx, 1000, []int64{100}, schema, nil, nil, 1)
+		assert.NoError(t, err)
+		// the admission is rechecked every second
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+		assert.Less(t, time.Since(start), 10*time.Second)
+	})
+
+	t.Run("rejected after waiting timeout", func(t *testing.T) {
+		pt.Save(pt.QueryCoordCfg.LoadAdmissionWaitTimeout.Key, "2")
+		defer pt.Reset(pt.QueryCoordCfg.LoadAdmissionWaitTimeout.Key)
+
+		la, _ := newTestLoadAdmission(t, 100)
+		start := time.Now()
+		err := la.Admit(ctx, 1000, []int64{100}, schema, nil, nil, 1)
+		assert.ErrorIs(t, err, merr.ErrServiceMemoryLimitExceeded)
+		assert.GreaterOrEqual(t, time.Since(start), 2*time.Second)
+	})
+
+	t.Run("context canceled while waiting", func(t *testing.T) {
+		pt.Save(pt.QueryCoordCfg.LoadAdmissionWaitTimeout.Key, "10")
+		defer pt.Reset(pt.QueryCoordCfg.LoadAdmissionWaitTimeout.Key)
+
+		la, _ := newTestLoadAdmission(t, 100)
+		ctx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+		err := la.Admit(ctx, 1000, []int64{100}, schema, nil, nil, 1)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,216 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// loadJob is the job which competes for the cluster level load slots,
+// jobs with higher load priority are always scheduled before the lower ones.
+type loadJob interface {
+	Job
+	DBID() int64
+	Priority() commonpb.LoadPriority
+	holdSlot(release func())
+	releaseSlot()
+}
+
+// loadSlot keeps the load slot granted to a load job, the slot is held until the collection or partitions are loaded,
+// so the job hands it over to the collection observer once the load task is registered,
+// otherwise it's released when the job finishes.
+type loadSlot struct {
+	mu      sync.Mutex
+	release func()
+}
+
+func (s *loadSlot) holdSlot(release func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.release = sync.OnceFunc(release)
+}
+
+// handOverSlot returns the release function of the slot, the caller takes over the ownership of the slot.
+func (s *loadSlot) handOverSlot() func() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	release := s.release
+	s.release = nil
+	if release == nil {
+		return func() {}
+	}
+	return release
+}
+
+// releaseSlot releases the slot if it hasn't been handed over.
+func (s *loadSlot) releaseSlot() {
+	s.handOverSlot()()
+}
+
+const (
+	waitReasonClusterLimit = "waiting for load slot"
+	waitReasonPreempted    = "preempted by higher priority load"
+)
+
+type slotWaiter struct {
+	job    loadJob
+	seq    int64
+	reason string
+	ready  chan struct{}
+}
+
+// loadLimiter limits the number of load jobs executed concurrently in the cluster and per database,
+// the preemption happens at the job boundary, a running job is never interrupted,
+// but the pending low priority jobs are held back as long as there are pending high priority ones.
+type loadLimiter struct {
+	mu        sync.Mutex
+	seq       int64
+	running   int
+	dbRunning map[int64]int
+	waiters   []*slotWaiter
+}
+
+func newLoadLimiter() *loadLimiter {
+	return &loadLimiter{
+		dbRunning: make(map[int64]int),
+	}
+}
+
+// Acquire blocks until the job gets a load slot or the context is done.
+func (l *loadLimiter) Acquire(ctx context.Context, job loadJob) error {
+	l.mu.Lock()
+	l.seq++
+	waiter := &slotWaiter{
+		job:   job,
+		seq:   l.seq,
+		ready: make(chan struct{}),
+	}
+	l.waiters = append(l.waiters, waiter)
+	l.dispatchLocked()
+	l.mu.Unlock()
+
+	select {
+	case <-waiter.ready:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		select {
+		case <-waiter.ready:
+			// granted concurrently, give the slot back
+			l.releaseLocked(job)
+		default:
+			l.removeLocked(waiter)
+		}
+		return ctx.Err()
+	}
+}
+
+// Release returns the slot held by the job.
+func (l *loadLimiter) Release(job loadJob) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.releaseLocked(job)
+}
+
+// Dispatch tries to grant slots to the waiting jobs,
+// it's called periodically to pick up the changes of limits.
+func (l *loadLimiter) Dispatch() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.dispatchLocked()
+}
+
+// WaitReason returns why the job is still waiting, empty if the job is not waiting.
+func (l *loadLimiter) WaitReason(job Job) string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, waiter := range l.waiters {
+		if waiter.job == job {
+			return waiter.reason
+		}
+	}
+	return ""
+}
+
+func (l *loadLimiter) releaseLocked(job loadJob) {
+	l.running--
+	l.dbRunning[job.DBID()]--
+	if l.dbRunning[job.DBID()] <= 0 {
+		delete(l.dbRunning, job.DBID())
+	}
+	l.dispatchLocked()
+}
+
+func (l *loadLimiter) removeLocked(waiter *slotWaiter) {
+	for i, w := range l.waiters {
+		if w == waiter {
+			l.waiters = append(l.waiters[:i], l.waiters[i+1:]...)
+			break
+		}
+	}
+	l.dispatchLocked()
+}
+
+func (l *loadLimiter) dispatchLocked() {
+	if len(l.waiters) == 0 {
+		return
+	}
+
+	maxRunning := paramtable.Get().QueryCoordCfg.MaxConcurrentLoadJobs.GetAsInt()
+	dbMaxRunning := paramtable.Get().QueryCoordCfg.DBMaxConcurrentLoadJobs.GetAsInt()
+
+	// LoadPriority_HIGH is the smaller value
+	sort.SliceStable(l.waiters, func(i, j int) bool {
+		pi, pj := l.waiters[i].job.Priority(), l.waiters[j].job.Priority()
+		if pi != pj {
+			return pi < pj
+		}
+		return l.waiters[i].seq < l.waiters[j].seq
+	})
+
+	// the highest priority of the jobs blocked by the cluster limit
+	var blocked *commonpb.LoadPriority
+	remain := l.waiters[:0]
+	for _, waiter := range l.waiters {
+		dbID, priority := waiter.job.DBID(), waiter.job.Priority()
+		switch {
+		case maxRunning > 0 && l.running >= maxRunning:
+			waiter.reason = waitReasonClusterLimit
+			if blocked == nil {
+				blocked = &priority
+			} else if *blocked < priority {
+				waiter.reason = waitReasonPreempted
+			}
+		case dbMaxRunning > 0 && l.dbRunning[dbID] >= dbMaxRunning:
+			waiter.reason = fmt.Sprintf("database %d reaches the quota of %d concurrent load jobs", dbID, dbMaxRunning)
+		default:
+			l.running++
+			l.dbRunning[dbID]++
+			close(waiter.ready)
+			continue
+		}
+		remain = append(remain, waiter)
+	}
+	l.waiters = remain
+}
@@ -0,0 +1,244 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type fakeLoadJob struct {
+	*BaseJob
+	loadSlot
+	dbID     int64
+	priority commonpb.LoadPriority
+
+	holdUntilLoaded bool
+	finishLoad      func()
+}
+
+func newFakeLoadJob(collectionID, dbID int64, priority commonpb.LoadPriority) *fakeLoadJob {
+	return &fakeLoadJob{
+		BaseJob:  NewBaseJob(context.Background(), 0, collectionID),
+		dbID:     dbID,
+		priority: priority,
+	}
+}
+
+func (job *fakeLoadJob) DBID() int64 {
+	return job.dbID
+}
+
+func (job *fakeLoadJob) Priority() commonpb.LoadPriority {
+	return job.priority
+}
+
+func (job *fakeLoadJob) Execute() error {
+	if job.holdUntilLoaded {
+		// hand over the slot like registering the load task into collection observer
+		job.finishLoad = job.handOverSlot()
+	}
+	return nil
+}
+
+type LoadLimiterSuite struct {
+	suite.Suite
+	limiter *loadLimiter
+}
+
+func (suite *LoadLimiterSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *LoadLimiterSuite) SetupTest() {
+	suite.limiter = newLoadLimiter()
+}
+
+func (suite *LoadLimiterSuite) TearDownTest() {
+	paramtable.Get().Reset(paramtable.Get().QueryCoordCfg.MaxConcurrentLoadJobs.Key)
+	paramtable.Get().Reset(paramtable.Get().QueryCoordCfg.DBMaxConcurrentLoadJobs.Key)
+}
+
+// acquireAsync starts to acquire a slot for the job and waits until the job is blocked,
+// the returned channel receives the result.
+func (suite *LoadLimiterSuite) acquireAsync(ctx context.Context, job loadJob) chan error {
+	ch := make(chan error, 1)
+	go func() {
+		ch <- suite.limiter.Acquire(ctx, job)
+	}()
+	suite.Eventually(func() bool {
+		return suite.limiter.WaitReason(job) != ""
+	}, time.Second, 10*time.Millisecond)
+	return ch
+}
+
+func (suite *LoadLimiterSuite) TestNoLimit() {
+	ctx := context.Background()
+	for i := 0; i < 10; i++ {
+		suite.NoError(suite.limiter.Acquire(ctx, newFakeLoadJob(int64(i), 1, commonpb.LoadPriority_LOW)))
+	}
+}
+
+func (suite *LoadLimiterSuite) TestPriority() {
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.MaxConcurrentLoadJobs.Key, "1")
+	ctx := context.Background()
+
+	running := newFakeLoadJob(1, 1, commonpb.LoadPriority_LOW)
+	suite.NoError(suite.limiter.Acquire(ctx, running))
+
+	low := newFakeLoadJob(2, 1, commonpb.LoadPriority_LOW)
+	lowCh := suite.acquireAsync(ctx, low)
+	high := newFakeLoadJob(3, 2, commonpb.LoadPriority_HIGH)
+	highCh := suite.acquireAsync(ctx, high)
+
+	suite.Equal(waitReasonClusterLimit, suite.limiter.WaitReason(high))
+	suite.Equal(waitReasonPreempted, suite.limiter.WaitReason(low))
+
+	// the high priority job jumps ahead of the earlier low priority one
+	suite.limiter.Release(running)
+	suite.NoError(<-highCh)
+	suite.Len(lowCh, 0)
+
+	suite.limiter.Release(high)
+	suite.NoError(<-lowCh)
+	suite.Empty(suite.limiter.WaitReason(low))
+}
+
+func (suite *LoadLimiterSuite) TestDatabaseQuota() {
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.DBMaxConcurrentLoadJobs.Key, "1")
+	ctx := context.Background()
+
+	running := newFakeLoadJob(1, 1, commonpb.LoadPriority_HIGH)
+	suite.NoError(suite.limiter.Acquire(ctx, running))
+
+	sameDB := newFakeLoadJob(2, 1, commonpb.LoadPriority_HIGH)
+	sameDBCh := suite.acquireAsync(ctx, sameDB)
+	suite.Contains(suite.limiter.WaitReason(sameDB), "quota")
+
+	// jobs of other databases are not blocked
+	suite.NoError(suite.limiter.Acquire(ctx, newFakeLoadJob(3, 2, commonpb.LoadPriority_LOW)))
+
+	suite.limiter.Release(running)
+	suite.NoError(<-sameDBCh)
+}
+
+func (suite *LoadLimiterSuite) TestCancel() {
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.MaxConcurrentLoadJobs.Key, "1")
+
+	running := newFakeLoadJob(1, 1, commonpb.LoadPriority_HIGH)
+	suite.NoError(suite.limiter.Acquire(context.Background(), running))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	waiting := newFakeLoadJob(2, 1, commonpb.LoadPriority_HIGH)
+	ch := suite.acquireAsync(ctx, waiting)
+	cancel()
+	suite.ErrorIs(<-ch, context.Canceled)
+	suite.Empty(suite.limiter.WaitReason(waiting))
+
+	suite.limiter.Release(running)
+	suite.NoError(suite.limiter.Acquire(context.Background(), newFakeLoadJob(3, 1, commonpb.LoadPriority_LOW)))
+}
+
+func (suite *LoadLimiterSuite) TestDispatchOnLimitChange() {
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.MaxConcurrentLoadJobs.Key, "1")
+	ctx := context.Background()
+
+	suite.NoError(suite.limiter.Acquire(ctx, newFakeLoadJob(1, 1, commonpb.LoadPriority_HIGH)))
+	waiting := newFakeLoadJob(2, 1, commonpb.LoadPriority_HIGH)
+	ch := suite.acquireAsync(ctx, waiting)
+
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.MaxConcurrentLoadJobs.Key, "2")
+	suite.limiter.Dispatch()
+	suite.NoError(<-ch)
+}
+
+func TestLoadLimiter(t *testing.T) {
+	suite.Run(t, new(LoadLimiterSuite))
+}
+
+func TestSchedulerJobStats(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.MaxConcurrentLoadJobs.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().QueryCoordCfg.MaxConcurrentLoadJobs.Key)
+
+	scheduler := NewScheduler()
+	running := newFakeLoadJob(1, 1, commonpb.LoadPriority_HIGH)
+	assert.NoError(t, scheduler.limiter.Acquire(context.Background(), running))
+
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	job := newFakeLoadJob(2, 1, commonpb.LoadPriority_LOW)
+	scheduler.Add(job)
+	assert.Eventually(t, func() bool {
+		stats := scheduler.GetJobStats()
+		return len(stats) == 1 && stats[0].TaskStatus == jobStatusWaiting && stats[0].Reason != ""
+	}, time.Second, 10*time.Millisecond)
+
+	stats := scheduler.GetJobStats()
+	assert.Equal(t, "fakeLoadJob", stats[0].TaskName)
+	assert.EqualValues(t, 2, stats[0].CollectionID)
+	assert.EqualValues(t, 1, stats[0].DatabaseID)
+	assert.Equal(t, commonpb.LoadPriority_LOW.String(), stats[0].Priority)
+	assert.Equal(t, waitReasonClusterLimit, stats[0].Reason)
+
+	scheduler.limiter.Release(running)
+	assert.NoError(t, job.Wait())
+	assert.Empty(t, scheduler.GetJobStats())
+}
+
+func TestSchedulerHoldSlotUntilLoaded(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.MaxConcurrentLoadJobs.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().QueryCoordCfg.MaxConcurrentLoadJobs.Key)
+
+	scheduler := NewScheduler()
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	loading := newFakeLoadJob(1, 1, commonpb.LoadPriority_HIGH)
+	loading.holdUntilLoaded = true
+	scheduler.Add(loading)
+	assert.NoError(t, loading.Wait())
+
+	// the slot is still held by the loading collection after the job returns
+	job := newFakeLoadJob(2, 1, commonpb.LoadPriority_HIGH)
+	scheduler.Add(job)
+	assert.Eventually(t, func() bool {
+		stats := scheduler.GetJobStats()
+		return len(stats) == 1 && stats[0].TaskStatus == jobStatusWaiting && stats[0].Reason == waitReasonClusterLimit
+	}, time.Second, 10*time.Millisecond)
+
+	loading.finishLoad()
+	assert.NoError(t, job.Wait())
+
+	// the slot of the job which doesn't hand it over is released once the job is done
+	next := newFakeLoadJob(3, 1, commonpb.LoadPriority_HIGH)
+	scheduler.Add(next)
+	assert.NoError(t, next.Wait())
+
+	// releasing twice doesn't free an extra slot
+	loading.finishLoad()
+	assert.Equal(t, 0, scheduler.limiter.running)
+}
@@ -18,12 +18,16 @@ package job
 
 import (
 	"context"
+	"reflect"
+	"sort"
 	"sync"
 	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/contextutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
@@ -34,8 +38,19 @@ const (
 	waitQueueCap       = 512
 )
 
+const (
+	jobStatusQueued  = "queued"
+	jobStatusWaiting = "waiting"
+	jobStatusRunning = "running"
+)
+
 type jobQueue chan Job
 
+type jobStat struct {
+	seq    int64
+	status string
+}
+
 type Scheduler struct {
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
@@ -43,6 +58,11 @@ type Scheduler struct {
 	processors *typeutil.ConcurrentSet[int64] // Collections of having processor
 	queues     map[int64]jobQueue             // CollectionID -> Queue
 	waitQueue  jobQueue
+	limiter    *loadLimiter
+
+	statsMu sync.Mutex
+	statSeq int64
+	stats   map[Job]*jobStat // unfinished jobs
 
 	stopOnce sync.Once
 }
@@ -52,6 +72,8 @@ func NewScheduler() *Scheduler {
 		processors: typeutil.NewConcurrentSet[int64](),
 		queues:     make(map[int64]jobQueue),
 		waitQueue:  make(jobQueue, waitQueueCap),
+		limiter:    newLoadLimiter(),
+		stats:      make(map[Job]*jobStat),
 	}
 }
 
@@ -94,12 +116,13 @@ func (scheduler *Scheduler) schedule(ctx context.Context) {
 				scheduler.queues[job.CollectionID()] = queue
 			}
 			queue <- job
-			scheduler.startProcessor(job.CollectionID(), queue)
+			scheduler.startProcessor(ctx, job.CollectionID(), queue)
 
 		case <-ticker.C:
+			scheduler.limiter.Dispatch()
 			for collection, queue := range scheduler.queues {
 				if len(queue) > 0 {
-					scheduler.startProcessor(collection, queue)
+					scheduler.startProcessor(ctx, collection, queue)
 				} else {
 					// Release resource if no job for the collection
 					close(queue)
@@ -111,32 +134,33 @@ func (scheduler *Scheduler) schedule(ctx context.Context) {
 }
 
 func (scheduler *Scheduler) Add(job Job) {
+	scheduler.setJobStatus(job, jobStatusQueued)
 	scheduler.waitQueue <- job
 }
 
-func (scheduler *Scheduler) startProcessor(collection int64, queue jobQueue) {
+func (scheduler *Scheduler) startProcessor(ctx context.Context, collection int64, queue jobQueue) {
 	if !scheduler.processors.Insert(collection) {
 		return
 	}
 
 	scheduler.wg.Add(1)
-	go scheduler.processQueue(collection, queue)
+	go scheduler.processQueue(ctx, collection, queue)
 }
 
 // processQueue processes jobs in the given queue,
 // it only processes jobs with the number of the length of queue at the time,
 // to avoid leaking goroutines
-func (scheduler *Scheduler) processQueue(collection int64, queue jobQueue) {
+func (scheduler *Scheduler) processQueue(ctx context.Context, collection int64, queue jobQueue) {
 	defer scheduler.wg.Done()
 	defer scheduler.processors.Remove(collection)
 
 	len := len(queue)
 	for i := 0; i < len; i++ {
-		scheduler.process(<-queue)
+		scheduler.process(ctx, <-queue)
 	}
 }
 
-func (scheduler *Scheduler) process(job Job) {
+func (scheduler *Scheduler) process(ctx context.Context, job Job) {
 	log := log.Ctx(job.Context()).With(
 		zap.Int64("collectionID", job.CollectionID()))
 
@@ -144,9 +168,25 @@ func (scheduler *Scheduler) process(job Job) {
 		log.Info("start to post-execute job")
 		job.PostExecute()
 		log.Info("job finished")
+		scheduler.removeJobStatus(job)
 		job.Done()
 	}()
 
+	if loadJob, ok := job.(loadJob); ok {
+		scheduler.setJobStatus(job, jobStatusWaiting)
+		err := scheduler.acquireLoadSlot(ctx, loadJob)
+		if err != nil {
+			log.Warn("failed to acquire load slot", zap.Error(err))
+			job.SetError(err)
+			return
+		}
+		loadJob.holdSlot(func() {
+			scheduler.limiter.Release(loadJob)
+		})
+		defer loadJob.releaseSlot()
+	}
+	scheduler.setJobStatus(job, jobStatusRunning)
+
 	log.Info("start to pre-execute job")
 	err := job.PreExecute()
 	if err != nil {
@@ -162,3 +202,64 @@ func (scheduler *Scheduler) process(job Job) {
 		job.SetError(err)
 	}
 }
+
+// acquireLoadSlot waits for a load slot until the job or the scheduler is canceled.
+func (scheduler *Scheduler) acquireLoadSlot(ctx context.Context, job loadJob) error {
+	ctx, cancel := contextutil.MergeContext(ctx, job.Context())
+	defer cancel()
+	return scheduler.limiter.Acquire(ctx, job)
+}
+
+func (scheduler *Scheduler) setJobStatus(job Job, status string) {
+	scheduler.statsMu.Lock()
+	defer scheduler.statsMu.Unlock()
+	stat, ok := scheduler.stats[job]
+	if !ok {
+		scheduler.statSeq++
+		stat = &jobStat{seq: scheduler.statSeq}
+		scheduler.stats[job] = stat
+	}
+	stat.status = status
+}
+
+func (scheduler *Scheduler) removeJobStatus(job Job) {
+	scheduler.statsMu.Lock()
+	defer scheduler.statsMu.Unlock()
+	delete(scheduler.stats, job)
+}
+
+// GetJobStats returns the unfinished jobs in the order of submission.
+func (scheduler *Scheduler) GetJobStats() []*metricsinfo.QueryCoordTask {
+	type entry struct {
+		job Job
+		jobStat
+	}
+	scheduler.statsMu.Lock()
+	entries := make([]entry, 0, len(scheduler.stats))
+	for job, stat := range scheduler.stats {
+		entries = append(entries, entry{job: job, jobStat: *stat})
+	}
+	scheduler.statsMu.Unlock()
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].seq < entries[j].seq
+	})
+
+	ret := make([]*metricsinfo.QueryCoordTask, 0, len(entries))
+	for _, e := range entries {
+		task := &metricsinfo.QueryCoordTask{
+			TaskName:     reflect.Indirect(reflect.ValueOf(e.job)).Type().Name(),
+			CollectionID: e.job.CollectionID(),
+			TaskType:     "Job",
+			TaskStatus:   e.status,
+		}
+		if loadJob, ok := e.job.(loadJob); ok {
+			task.DatabaseID = loadJob.DBID()
+			task.Priority = loadJob.Priority().String()
+			if e.status == jobStatusWaiting {
+				task.Reason = scheduler.limiter.WaitReason(e.job)
+			}
+		}
+		ret = append(ret, task)
+	}
+	return ret
+}
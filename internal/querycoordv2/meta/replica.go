@@ -132,6 +132,18 @@ func (replica *Replica) GetResourceGroup() string {
 	return replica.replicaPB.GetResourceGroup()
 }
 
+// IsFollower returns whether the replica is a read-only follower,
+// a replica is follower if it's located in one of the configured follower resource groups.
+// Follower is a routing attribute only, the follower replicas are loaded and kept up to date as the other replicas.
+func (replica *Replica) IsFollower() bool {
+	for _, rg := range paramtable.Get().QueryCoordCfg.FollowerResourceGroups.GetAsStrings() {
+		if rg == replica.GetResourceGroup() {
+			return true
+		}
+	}
+	return false
+}
+
 // GetNodes returns the rw nodes of the replica.
 // readonly, don't modify the returned slice.
 func (replica *Replica) GetNodes() []int64 {
@@ -83,6 +83,19 @@ func (suite *ReplicaSuite) TestReadOperations() {
 	suite.testRead(mutableReplica.IntoReplica())
 }
 
+func (suite *ReplicaSuite) TestIsFollower() {
+	r := newReplica(suite.replicaPB)
+	suite.False(r.IsFollower())
+
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.FollowerResourceGroups.Key, "rg1,"+DefaultResourceGroupName)
+	defer paramtable.Get().Reset(paramtable.Get().QueryCoordCfg.FollowerResourceGroups.Key)
+	suite.True(r.IsFollower())
+
+	mutableReplica := r.CopyForWrite()
+	mutableReplica.SetResourceGroup("rg2")
+	suite.False(mutableReplica.IntoReplica().IsFollower())
+}
+
 func (suite *ReplicaSuite) TestClone() {
 	r := newReplica(suite.replicaPB)
 	r2 := r.CopyForWrite()
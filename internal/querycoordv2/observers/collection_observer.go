@@ -64,6 +64,8 @@ type LoadTask struct {
 	LoadType     querypb.LoadType
 	CollectionID int64
 	PartitionIDs []int64
+	// OnFinished are called once the task is finished, timeout or canceled
+	OnFinished []func()
 }
 
 func NewCollectionObserver(
@@ -129,7 +131,9 @@ func (ob *CollectionObserver) Stop() {
 	})
 }
 
-func (ob *CollectionObserver) LoadCollection(ctx context.Context, collectionID int64) {
+// LoadCollection registers the load task of the collection,
+// the onFinished callbacks are called after the collection is loaded, timeout or released.
+func (ob *CollectionObserver) LoadCollection(ctx context.Context, collectionID int64, onFinished ...func()) {
 	span := trace.SpanFromContext(ctx)
 
 	traceID := span.SpanContext().TraceID()
@@ -139,11 +143,13 @@ func (ob *CollectionObserver) LoadCollection(ctx context.Context, collectionID i
 		key = fmt.Sprintf("LoadCollection_%d", collectionID)
 	}
 
-	ob.loadTasks.Insert(key, LoadTask{LoadType: querypb.LoadType_LoadCollection, CollectionID: collectionID})
+	ob.addLoadTask(key, LoadTask{LoadType: querypb.LoadType_LoadCollection, CollectionID: collectionID, OnFinished: onFinished})
 	ob.checkerController.Check()
 }
 
-func (ob *CollectionObserver) LoadPartitions(ctx context.Context, collectionID int64, partitionIDs []int64) {
+// LoadPartitions registers the load task of the partitions,
+// the onFinished callbacks are called after the partitions are loaded, timeout or released.
+func (ob *CollectionObserver) LoadPartitions(ctx context.Context, collectionID int64, partitionIDs []int64, onFinished ...func()) {
 	span := trace.SpanFromContext(ctx)
 
 	traceID := span.SpanContext().TraceID()
@@ -152,10 +158,29 @@ func (ob *CollectionObserver) LoadPartitions(ctx context.Context, collectionID i
 		key = fmt.Sprintf("LoadPartition_%d_%v", collectionID, partitionIDs)
 	}
 
-	ob.loadTasks.Insert(key, LoadTask{LoadType: querypb.LoadType_LoadPartition, CollectionID: collectionID, PartitionIDs: partitionIDs})
+	ob.addLoadTask(key, LoadTask{LoadType: querypb.LoadType_LoadPartition, CollectionID: collectionID, PartitionIDs: partitionIDs, OnFinished: onFinished})
 	ob.checkerController.Check()
 }
 
+// addLoadTask adds the load task, the callbacks of the replaced task with the same key are kept.
+func (ob *CollectionObserver) addLoadTask(key string, task LoadTask) {
+	if old, ok := ob.loadTasks.Get(key); ok {
+		task.OnFinished = append(old.OnFinished, task.OnFinished...)
+	}
+	ob.loadTasks.Insert(key, task)
+}
+
+// removeLoadTask removes the load task and calls its callbacks.
+func (ob *CollectionObserver) removeLoadTask(key string) {
+	task, ok := ob.loadTasks.GetAndRemove(key)
+	if !ok {
+		return
+	}
+	for _, onFinished := range task.OnFinished {
+		onFinished()
+	}
+}
+
 func (ob *CollectionObserver) Observe(ctx context.Context) {
 	ob.observeTimeout(ctx)
 	ob.observeLoadStatus(ctx)
@@ -167,7 +192,7 @@ func (ob *CollectionObserver) observeTimeout(ctx context.Context) {
 		// collection released
 		if collection == nil {
 			log.Info("Load Collection Task canceled, collection removed from meta", zap.Int64("collectionID", task.CollectionID), zap.String("traceID", traceID))
-			ob.loadTasks.Remove(traceID)
+			ob.removeLoadTask(traceID)
 			return true
 		}
 
@@ -181,7 +206,7 @@ func (ob *CollectionObserver) observeTimeout(ctx context.Context) {
 				ob.meta.CollectionManager.RemoveCollection(ctx, collection.GetCollectionID())
 				ob.meta.ReplicaManager.RemoveCollection(ctx, collection.GetCollectionID())
 				ob.targetObserver.ReleaseCollection(collection.GetCollectionID())
-				ob.loadTasks.Remove(traceID)
+				ob.removeLoadTask(traceID)
 			}
 		case querypb.LoadType_LoadPartition:
 			partitionIDs := typeutil.NewSet(task.PartitionIDs...)
@@ -196,7 +221,7 @@ func (ob *CollectionObserver) observeTimeout(ctx context.Context) {
 					zap.Int64("collectionID", task.CollectionID),
 					zap.Int64s("partitionIDs", task.PartitionIDs),
 					zap.String("traceID", traceID))
-				ob.loadTasks.Remove(traceID)
+				ob.removeLoadTask(traceID)
 				return true
 			}
 
@@ -297,7 +322,7 @@ func (ob *CollectionObserver) observeLoadStatus(ctx context.Context) {
 				zap.Int64("collectionID", task.CollectionID),
 				zap.Int64s("partitionIDs", task.PartitionIDs),
 				zap.Stringer("loadType", task.LoadType))
-			ob.loadTasks.Remove(traceID)
+			ob.removeLoadTask(traceID)
 		}
 
 		log.Info("observe collection done", zap.Int64("collectionID", task.CollectionID), zap.Duration("dur", time.Since(start)))
@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/etcd"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 type CollectionObserverSuite struct {
@@ -77,6 +78,9 @@ type CollectionObserverSuite struct {
 	// Test object
 	ob *CollectionObserver
 
+	// collections whose load task finished
+	loadFinished *typeutil.ConcurrentSet[int64]
+
 	ctx context.Context
 }
 
@@ -229,6 +233,7 @@ func (suite *CollectionObserverSuite) SetupTest() {
 	}
 	suite.targetObserver.Start()
 	suite.ob.Start()
+	suite.loadFinished = typeutil.NewConcurrentSet[int64]()
 	suite.loadAll()
 
 	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
@@ -363,6 +368,13 @@ func (suite *CollectionObserverSuite) TestObserve() {
 	suite.Eventually(func() bool {
 		return suite.isCollectionLoaded(suite.collections[3])
 	}, timeout*2, timeout/10)
+
+	// the callbacks are called for both loaded and timeout collections
+	suite.Eventually(func() bool {
+		return suite.loadFinished.Contain(suite.collections[0]) &&
+			suite.loadFinished.Contain(suite.collections[1]) &&
+			suite.loadFinished.Contain(suite.collections[3])
+	}, timeout*2, timeout/10)
 }
 
 func (suite *CollectionObserverSuite) TestObservePartition() {
@@ -548,7 +560,9 @@ func (suite *CollectionObserverSuite) load(collection int64) {
 	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collection).Return(dmChannels, allSegments, nil)
 	suite.targetMgr.UpdateCollectionNextTarget(ctx, collection)
 
-	suite.ob.LoadCollection(context.Background(), collection)
+	suite.ob.LoadCollection(context.Background(), collection, func() {
+		suite.loadFinished.Insert(collection)
+	})
 }
 
 func TestCollectionObserver(t *testing.T) {
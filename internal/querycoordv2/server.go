@@ -169,7 +169,7 @@ func (s *Server) registerMetricsRequest() {
 	}
 
 	QueryTasksAction := func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
-		return s.taskScheduler.GetTasksJSON(), nil
+		return s.getTasksJSON()
 	}
 
 	QueryDistAction := func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
@@ -204,6 +204,10 @@ func (s *Server) registerMetricsRequest() {
 		return s.getChannelsFromQueryNode(ctx, req)
 	}
 
+	QueryBalancePlanAction := func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+		return s.getBalancePlanJSON(ctx, jsonReq)
+	}
+
 	// register actions that requests are processed in querycoord
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.SystemInfoMetrics, getSystemInfoAction)
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.AllTaskKey, QueryTasksAction)
@@ -211,6 +215,7 @@ func (s *Server) registerMetricsRequest() {
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.TargetKey, QueryTargetAction)
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.ReplicaKey, QueryReplicasAction)
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.ResourceGroupKey, QueryResourceGroupsAction)
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.BalancePlanKey, QueryBalancePlanAction)
 
 	// register actions that requests are processed in querynode
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.SegmentKey, QuerySegmentsAction)
@@ -314,34 +319,7 @@ func (s *Server) initQueryCoord() error {
 	// Init checker controller
 	log.Info("init checker controller")
 	s.getBalancerFunc = func() balance.Balance {
-		balanceKey := paramtable.Get().QueryCoordCfg.Balancer.GetValue()
-		s.balancerLock.Lock()
-		defer s.balancerLock.Unlock()
-
-		balancer, ok := s.balancerMap[balanceKey]
-		if ok {
-			return balancer
-		}
-
-		log.Info("switch to new balancer", zap.String("name", balanceKey))
-		switch balanceKey {
-		case meta.RoundRobinBalancerName:
-			balancer = balance.NewRoundRobinBalancer(s.taskScheduler, s.nodeMgr)
-		case meta.RowCountBasedBalancerName:
-			balancer = balance.NewRowCountBasedBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
-		case meta.ScoreBasedBalancerName:
-			balancer = balance.NewScoreBasedBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
-		case meta.MultiTargetBalancerName:
-			balancer = balance.NewMultiTargetBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
-		case meta.ChannelLevelScoreBalancerName:
-			balancer = balance.NewChannelLevelScoreBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
-		default:
-			log.Info(fmt.Sprintf("default to use %s", meta.ScoreBasedBalancerName))
-			balancer = balance.NewScoreBasedBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
-		}
-
-		s.balancerMap[balanceKey] = balancer
-		return balancer
+		return s.getBalancerByName(paramtable.Get().QueryCoordCfg.Balancer.GetValue())
 	}
 	s.checkerController = checkers.NewCheckerController(
 		s.meta,
@@ -374,6 +352,48 @@ func (s *Server) initQueryCoord() error {
 	return err
 }
 
+// balancerNames lists the balancers which could be selected by QueryCoordCfg.Balancer.
+var balancerNames = []string{
+	meta.RoundRobinBalancerName,
+	meta.RowCountBasedBalancerName,
+	meta.ScoreBasedBalancerName,
+	meta.MultiTargetBalancerName,
+	meta.ChannelLevelScoreBalancerName,
+}
+
+// getBalancerByName returns the balancer with the given name, the balancer is created at first use and cached.
+// Unknown names fall back to the score based balancer.
+func (s *Server) getBalancerByName(balanceKey string) balance.Balance {
+	s.balancerLock.Lock()
+	defer s.balancerLock.Unlock()
+
+	balancer, ok := s.balancerMap[balanceKey]
+	if ok {
+		return balancer
+	}
+
+	log := log.Ctx(s.ctx)
+	log.Info("switch to new balancer", zap.String("name", balanceKey))
+	switch balanceKey {
+	case meta.RoundRobinBalancerName:
+		balancer = balance.NewRoundRobinBalancer(s.taskScheduler, s.nodeMgr)
+	case meta.RowCountBasedBalancerName:
+		balancer = balance.NewRowCountBasedBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
+	case meta.ScoreBasedBalancerName:
+		balancer = balance.NewScoreBasedBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
+	case meta.MultiTargetBalancerName:
+		balancer = balance.NewMultiTargetBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
+	case meta.ChannelLevelScoreBalancerName:
+		balancer = balance.NewChannelLevelScoreBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
+	default:
+		log.Info(fmt.Sprintf("default to use %s", meta.ScoreBasedBalancerName))
+		balancer = balance.NewScoreBasedBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
+	}
+
+	s.balancerMap[balanceKey] = balancer
+	return balancer
+}
+
 func (s *Server) initMeta() error {
 	log := log.Ctx(s.ctx)
 	record := timerecord.NewTimeRecorder("querycoord")
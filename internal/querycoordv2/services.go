@@ -19,6 +19,8 @@ package querycoordv2
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/cockroachdb/errors"
@@ -34,6 +36,8 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/internal/util/componentutil"
+	"github.com/milvus-io/milvus/internal/util/reduce"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
@@ -910,11 +914,29 @@ func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeade
 		}, nil
 	}
 
-	leaders, err := utils.GetShardLeaders(ctx, s.meta, s.targetMgr, s.dist, s.nodeMgr, req.GetCollectionID(), req.GetWithUnserviceableShards())
-	return &querypb.GetShardLeadersResponse{
+	leaders, partial, err := utils.GetShardLeaders(ctx, s.meta, s.targetMgr, s.dist, s.nodeMgr,
+		req.GetCollectionID(), req.GetWithUnserviceableShards(), reduce.IsServePartial(req.GetBase()))
+	resp := &querypb.GetShardLeadersResponse{
 		Status: merr.Status(err),
 		Shards: leaders,
-	}, nil
+	}
+	if err != nil {
+		return resp, nil
+	}
+	extraInfo := make(map[string]string)
+	if followers := utils.GetFollowerNodes(ctx, s.meta, req.GetCollectionID()); len(followers) > 0 {
+		extraInfo[common.FollowerNodesKey] = strings.Join(lo.Map(followers, func(node int64, _ int) string {
+			return strconv.FormatInt(node, 10)
+		}), ",")
+	}
+	if partial {
+		// tell proxy the collection is not fully loaded, so the shard leaders won't be cached for the other requests
+		extraInfo[common.ServePartialKey] = strconv.FormatBool(true)
+	}
+	if len(extraInfo) > 0 {
+		resp.Status.ExtraInfo = extraInfo
+	}
+	return resp, nil
 }
 
 func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
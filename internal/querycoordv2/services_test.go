@@ -19,6 +19,8 @@ package querycoordv2
 import (
 	"context"
 	"sort"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -47,7 +49,9 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/internal/util/reduce"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/kv"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
@@ -1766,6 +1770,21 @@ func (suite *ServiceSuite) TestGetShardLeaders() {
 		for _, shard := range resp.Shards {
 			suite.Len(shard.NodeIds, int(suite.replicaNumber[collection]))
 		}
+		suite.NotContains(resp.GetStatus().GetExtraInfo(), common.FollowerNodesKey)
+	}
+
+	// Test with follower replicas
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.FollowerResourceGroups.Key, meta.DefaultResourceGroupName)
+	resp, err := server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: suite.collections[0],
+	})
+	paramtable.Get().Reset(paramtable.Get().QueryCoordCfg.FollowerResourceGroups.Key)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	for _, shard := range resp.Shards {
+		for _, node := range shard.NodeIds {
+			suite.Contains(strings.Split(resp.GetStatus().GetExtraInfo()[common.FollowerNodesKey], ","), strconv.FormatInt(node, 10))
+		}
 	}
 
 	// Test when server is not healthy
@@ -1773,11 +1792,49 @@ func (suite *ServiceSuite) TestGetShardLeaders() {
 	req := &querypb.GetShardLeadersRequest{
 		CollectionID: suite.collections[0],
 	}
-	resp, err := server.GetShardLeaders(ctx, req)
+	resp, err = server.GetShardLeaders(ctx, req)
 	suite.NoError(err)
 	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
 }
 
+func (suite *ServiceSuite) TestGetShardLeadersServePartial() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	collection := suite.collections[0]
+
+	suite.updateCollectionStatus(ctx, collection, querypb.LoadStatus_Loading)
+	suite.updateChannelDist(ctx, collection)
+	suite.fetchHeartbeats(time.Now())
+
+	// the loading collection is rejected without serve partial
+	resp, err := server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID:            collection,
+		WithUnserviceableShards: true,
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrCollectionNotFullyLoaded), resp.GetStatus().GetCode())
+
+	servePartialReq := &querypb.GetShardLeadersRequest{
+		Base:                    &commonpb.MsgBase{},
+		CollectionID:            collection,
+		WithUnserviceableShards: true,
+	}
+	reduce.SetServePartial(servePartialReq.GetBase())
+	resp, err = server.GetShardLeaders(ctx, servePartialReq)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.Len(resp.Shards, len(suite.channels[collection]))
+	suite.Equal("true", resp.GetStatus().GetExtraInfo()[common.ServePartialKey])
+
+	// no partial mark once the collection is loaded
+	suite.updateCollectionStatus(ctx, collection, querypb.LoadStatus_Loaded)
+	resp, err = server.GetShardLeaders(ctx, servePartialReq)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.NotContains(resp.GetStatus().GetExtraInfo(), common.ServePartialKey)
+}
+
 func (suite *ServiceSuite) TestGetShardLeadersFailed() {
 	suite.loadAll()
 	ctx := context.Background()
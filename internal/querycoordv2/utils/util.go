@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
@@ -151,6 +152,9 @@ func GetShardLeadersWithChannels(
 	return ret, nil
 }
 
+// GetShardLeaders returns the shard leaders of the loaded collection,
+// if servePartial is true, the collection which is still loading is accepted as well,
+// and the returned partial reports whether the collection is not fully loaded.
 func GetShardLeaders(ctx context.Context,
 	m *meta.Meta,
 	targetMgr meta.TargetManagerInterface,
@@ -158,20 +162,45 @@ func GetShardLeaders(ctx context.Context,
 	nodeMgr *session.NodeManager,
 	collectionID int64,
 	withUnserviceableShards bool,
-) ([]*querypb.ShardLeadersList, error) {
+	servePartial bool,
+) (leaders []*querypb.ShardLeadersList, partial bool, err error) {
 	// skip check load status if withUnserviceableShards is true
 	if err := checkLoadStatus(ctx, m, collectionID); err != nil {
-		return nil, err
+		// the loaded segments of a loading collection could serve the request in serve partial mode
+		if !servePartial || !errors.Is(err, merr.ErrCollectionNotFullyLoaded) {
+			return nil, false, err
+		}
+		partial = true
 	}
 
 	channels := targetMgr.GetDmChannelsByCollection(ctx, collectionID, meta.CurrentTarget)
+	if len(channels) == 0 && partial {
+		// the current target is not ready until the first load finishes
+		channels = targetMgr.GetDmChannelsByCollection(ctx, collectionID, meta.NextTarget)
+	}
 	if len(channels) == 0 {
 		msg := "loaded collection do not found any channel in target, may be in recovery"
 		err := merr.WrapErrCollectionOnRecovering(collectionID, msg)
 		log.Ctx(ctx).Warn("failed to get channels", zap.Error(err))
-		return nil, err
+		return nil, false, err
+	}
+	leaders, err = GetShardLeadersWithChannels(ctx, m, dist, nodeMgr, collectionID, channels, withUnserviceableShards)
+	if err != nil {
+		return nil, false, err
+	}
+	return leaders, partial, nil
+}
+
+// GetFollowerNodes returns the nodes of the read-only follower replicas of the collection,
+// proxy uses it to pick out the shard leaders which could only serve the relaxed consistency requests.
+func GetFollowerNodes(ctx context.Context, m *meta.Meta, collectionID int64) []int64 {
+	nodes := make([]int64, 0)
+	for _, replica := range m.ReplicaManager.GetByCollection(ctx, collectionID) {
+		if replica.IsFollower() {
+			nodes = append(nodes, replica.GetNodes()...)
+		}
 	}
-	return GetShardLeadersWithChannels(ctx, m, dist, nodeMgr, collectionID, channels, withUnserviceableShards)
+	return nodes
 }
 
 // CheckCollectionsQueryable check all channels are watched and all segments are loaded for this collection
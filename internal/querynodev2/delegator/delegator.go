@@ -108,6 +108,8 @@ type ShardDelegator interface {
 
 	// control
 	Serviceable() bool
+	// GetCoverage returns the loaded and total sealed row count of the readable data view
+	GetCoverage() (loadedRows int64, totalRows int64)
 	Start()
 	Close()
 }
@@ -216,6 +218,11 @@ func (sd *shardDelegator) Version() int64 {
 	return sd.version
 }
 
+// GetCoverage returns the loaded and total sealed row count of the readable data view.
+func (sd *shardDelegator) GetCoverage() (int64, int64) {
+	return sd.distribution.GetCoverage()
+}
+
 // GetSegmentInfo returns current segment distribution snapshot.
 func (sd *shardDelegator) GetSegmentInfo(readable bool) ([]SnapshotItem, []SegmentEntry) {
 	return sd.distribution.PeekSegments(readable)
@@ -396,7 +403,7 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 		req.Req.GetIsIterator(),
 	)
 
-	partialResultRequiredDataRatio := paramtable.Get().QueryNodeCfg.PartialResultRequiredDataRatio.GetAsFloat()
+	partialResultRequiredDataRatio := sd.requiredDataRatio(req.GetReq().GetBase())
 	// wait tsafe
 	waitTr := timerecord.NewTimeRecorder("wait tSafe")
 	var tSafe uint64
@@ -427,6 +434,9 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 		return nil, err
 	}
 	defer sd.distribution.Unpin(version)
+	if reduce.IsServePartial(req.GetReq().GetBase()) {
+		sealed = excludeUnloadedSegments(sealed)
+	}
 
 	if req.GetReq().GetIsAdvanced() {
 		futures := make([]*conc.Future[*internalpb.SearchResults], len(req.GetReq().GetSubReqs()))
@@ -602,7 +612,7 @@ func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest)
 		req.Req.GetIsIterator(),
 	)
 
-	partialResultRequiredDataRatio := paramtable.Get().QueryNodeCfg.PartialResultRequiredDataRatio.GetAsFloat()
+	partialResultRequiredDataRatio := sd.requiredDataRatio(req.GetReq().GetBase())
 	// wait tsafe
 	waitTr := timerecord.NewTimeRecorder("wait tSafe")
 	var tSafe uint64
@@ -632,6 +642,9 @@ func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest)
 		return nil, err
 	}
 	defer sd.distribution.Unpin(version)
+	if reduce.IsServePartial(req.GetReq().GetBase()) {
+		sealed = excludeUnloadedSegments(sealed)
+	}
 
 	if req.Req.IgnoreGrowing {
 		growing = []SegmentEntry{}
@@ -754,6 +767,22 @@ type subTask[T any] struct {
 	worker   cluster.Worker
 }
 
+// requiredDataRatio returns the min loaded ratio of the shard to serve a search/query,
+// the request in serve partial mode could be served as long as the delegator is working.
+func (sd *shardDelegator) requiredDataRatio(base *commonpb.MsgBase) float64 {
+	if reduce.IsServePartial(base) {
+		return 0
+	}
+	return paramtable.Get().QueryNodeCfg.PartialResultRequiredDataRatio.GetAsFloat()
+}
+
+// excludeUnloadedSegments removes the sealed segments which are not loaded by any worker.
+func excludeUnloadedSegments(sealed []SnapshotItem) []SnapshotItem {
+	return lo.Filter(sealed, func(item SnapshotItem, _ int) bool {
+		return item.NodeID != -1
+	})
+}
+
 func organizeSubTask[T any](ctx context.Context,
 	req T,
 	sealed []SnapshotItem,
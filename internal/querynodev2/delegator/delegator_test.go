@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querynodev2/cluster"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/reduce"
 	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
@@ -47,6 +48,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/metric"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 type DelegatorSuite struct {
@@ -671,6 +673,83 @@ func (s *DelegatorSuite) TestSearch() {
 	})
 }
 
+func (s *DelegatorSuite) TestServePartial() {
+	s.delegator.Start()
+	paramtable.SetNodeID(1)
+	// segment 1002 is in target but not loaded by any worker
+	s.delegator.SyncDistribution(context.Background(),
+		SegmentEntry{
+			NodeID:      1,
+			SegmentID:   1000,
+			PartitionID: 500,
+			Version:     2001,
+		},
+		SegmentEntry{
+			NodeID:      2,
+			SegmentID:   1001,
+			PartitionID: 501,
+			Version:     2001,
+		},
+	)
+	s.delegator.SyncTargetVersion(&querypb.SyncAction{
+		TargetVersion: 2001,
+		SealedSegmentRowCount: map[int64]int64{
+			1000: 100,
+			1001: 100,
+			1002: 200,
+		},
+		DroppedInTarget: []int64{},
+		Checkpoint:      &msgpb.MsgPosition{},
+		DeleteCP:        &msgpb.MsgPosition{},
+	}, []int64{500, 501})
+
+	loadedRows, totalRows := s.delegator.GetCoverage()
+	s.EqualValues(200, loadedRows)
+	s.EqualValues(400, totalRows)
+
+	s.Run("not serve partial", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, err := s.delegator.Search(ctx, &querypb.SearchRequest{
+			Req:         &internalpb.SearchRequest{Base: commonpbutil.NewMsgBase()},
+			DmlChannels: []string{s.vchannelName},
+		})
+		s.ErrorIs(err, merr.ErrChannelNotAvailable)
+	})
+
+	s.Run("serve partial", func() {
+		defer func() {
+			s.workerManager.ExpectedCalls = nil
+		}()
+		searched := typeutil.NewConcurrentSet[int64]()
+		workers := make(map[int64]*cluster.MockWorker)
+		for _, nodeID := range []int64{1, 2} {
+			worker := &cluster.MockWorker{}
+			worker.EXPECT().SearchSegments(mock.Anything, mock.AnythingOfType("*querypb.SearchRequest")).
+				Run(func(_ context.Context, req *querypb.SearchRequest) {
+					for _, segmentID := range req.GetSegmentIDs() {
+						searched.Insert(segmentID)
+					}
+				}).Return(&internalpb.SearchResults{}, nil)
+			workers[nodeID] = worker
+		}
+		s.workerManager.EXPECT().GetWorker(mock.Anything, mock.AnythingOfType("int64")).Call.Return(func(_ context.Context, nodeID int64) cluster.Worker {
+			return workers[nodeID]
+		}, nil)
+
+		base := commonpbutil.NewMsgBase()
+		reduce.SetServePartial(base)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, err := s.delegator.Search(ctx, &querypb.SearchRequest{
+			Req:         &internalpb.SearchRequest{Base: base},
+			DmlChannels: []string{s.vchannelName},
+		})
+		s.NoError(err)
+		s.ElementsMatch([]int64{1000, 1001}, searched.Collect())
+	})
+}
+
 func (s *DelegatorSuite) TestQuery() {
 	s.delegator.Start()
 	paramtable.SetNodeID(1)
@@ -69,6 +69,8 @@ type channelQueryView struct {
 
 	loadedRatio            *atomic.Float64 // loaded ratio of current query view, set serviceable to true if loadedRatio == 1.0
 	unloadedSealedSegments []SegmentEntry  // workerID -> -1
+	loadedSealedRowCount   int64           // row count of loaded sealed segments in query view
+	totalSealedRowCount    int64           // row count of all sealed segments in query view
 
 	syncedByCoord bool // if the query view is synced by coord
 }
@@ -210,6 +212,13 @@ func (d *distribution) PinReadableSegments(requiredLoadRatio float64, partitions
 	return
 }
 
+// GetCoverage returns the loaded and total sealed row count of current query view.
+func (d *distribution) GetCoverage() (loadedRows int64, totalRows int64) {
+	d.mut.RLock()
+	defer d.mut.RUnlock()
+	return d.queryView.loadedSealedRowCount, d.queryView.totalSealedRowCount
+}
+
 func (d *distribution) PinOnlineSegments(partitions ...int64) (sealed []SnapshotItem, growing []SegmentEntry, version int64) {
 	d.mut.RLock()
 	defer d.mut.RUnlock()
@@ -287,6 +296,8 @@ func (d *distribution) updateServiceable(triggerAction string) {
 
 	// unloaded segment entry list for partial result
 	d.queryView.unloadedSealedSegments = unloadedSealedSegments
+	d.queryView.loadedSealedRowCount = loadedSealedSegments
+	d.queryView.totalSealedRowCount = totalSealedRowCount
 
 	loadedRatio := 0.0
 	if len(d.queryView.sealedSegmentRowCount) == 0 {
@@ -869,6 +869,30 @@ func TestDistribution_UpdateServiceable(t *testing.T) {
 	assert.True(t, dist.Serviceable())
 }
 
+func TestDistribution_GetCoverage(t *testing.T) {
+	view := NewChannelQueryView(nil, map[int64]int64{1: 100, 2: 300}, []int64{10}, 1)
+	dist := NewDistribution("test_channel", view)
+
+	loaded, total := dist.GetCoverage()
+	assert.EqualValues(t, 0, loaded)
+	assert.EqualValues(t, 400, total)
+
+	dist.AddDistributions(SegmentEntry{NodeID: 1, SegmentID: 2, PartitionID: 10})
+	loaded, total = dist.GetCoverage()
+	assert.EqualValues(t, 300, loaded)
+	assert.EqualValues(t, 400, total)
+
+	// unloaded segments are excluded in serve partial mode
+	sealed, _, version, err := dist.PinReadableSegments(0)
+	assert.NoError(t, err)
+	defer dist.Unpin(version)
+	sealed = excludeUnloadedSegments(sealed)
+	assert.Len(t, sealed, 1)
+	assert.EqualValues(t, 1, sealed[0].NodeID)
+	assert.Len(t, sealed[0].Segments, 1)
+	assert.EqualValues(t, 2, sealed[0].Segments[0].SegmentID)
+}
+
 func TestDistribution_SyncTargetVersion(t *testing.T) {
 	channelName := "test_channel"
 	growings := []int64{1, 2, 3}
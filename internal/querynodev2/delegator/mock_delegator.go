@@ -187,6 +187,61 @@ func (_c *MockShardDelegator_GetChannelQueryView_Call) RunAndReturn(run func() *
 	return _c
 }
 
+// GetCoverage provides a mock function with no fields
+func (_m *MockShardDelegator) GetCoverage() (int64, int64) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCoverage")
+	}
+
+	var r0 int64
+	var r1 int64
+	if rf, ok := ret.Get(0).(func() (int64, int64)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() int64); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	return r0, r1
+}
+
+// MockShardDelegator_GetCoverage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCoverage'
+type MockShardDelegator_GetCoverage_Call struct {
+	*mock.Call
+}
+
+// GetCoverage is a helper method to define mock.On call
+func (_e *MockShardDelegator_Expecter) GetCoverage() *MockShardDelegator_GetCoverage_Call {
+	return &MockShardDelegator_GetCoverage_Call{Call: _e.mock.On("GetCoverage")}
+}
+
+func (_c *MockShardDelegator_GetCoverage_Call) Run(run func()) *MockShardDelegator_GetCoverage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockShardDelegator_GetCoverage_Call) Return(loadedRows int64, totalRows int64) *MockShardDelegator_GetCoverage_Call {
+	_c.Call.Return(loadedRows, totalRows)
+	return _c
+}
+
+func (_c *MockShardDelegator_GetCoverage_Call) RunAndReturn(run func() (int64, int64)) *MockShardDelegator_GetCoverage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDeleteBufferSize provides a mock function with no fields
 func (_m *MockShardDelegator) GetDeleteBufferSize() (int64, int64) {
 	ret := _m.Called()
@@ -281,6 +281,9 @@ func (node *QueryNode) queryChannel(ctx context.Context, req *querypb.QueryReque
 	if err != nil {
 		return nil, err
 	}
+	if reduce.IsServePartial(req.GetReq().GetBase()) {
+		resp.Status = withCoverage(resp.GetStatus(), sd)
+	}
 
 	tr.CtxElapse(ctx, fmt.Sprintf("do query with channel done , vChannel = %s, segmentIDs = %v",
 		channel,
@@ -438,6 +441,9 @@ func (node *QueryNode) searchChannel(ctx context.Context, req *querypb.SearchReq
 	if err != nil {
 		return nil, err
 	}
+	if reduce.IsServePartial(req.GetReq().GetBase()) {
+		resp.Status = withCoverage(resp.GetStatus(), sd)
+	}
 
 	tr.CtxElapse(ctx, fmt.Sprintf("do search with channel done , vChannel = %s, segmentIDs = %v",
 		channel,
@@ -453,6 +459,16 @@ func (node *QueryNode) searchChannel(ctx context.Context, req *querypb.SearchReq
 	return resp, nil
 }
 
+// withCoverage attaches the data coverage of delegator to the response status of serve partial request.
+func withCoverage(status *commonpb.Status, sd delegator.ShardDelegator) *commonpb.Status {
+	if status == nil {
+		status = merr.Success()
+	}
+	loadedRows, totalRows := sd.GetCoverage()
+	reduce.SetCoverage(status, reduce.Coverage{LoadedRows: loadedRows, TotalRows: totalRows})
+	return status
+}
+
 func (node *QueryNode) getChannelStatistics(ctx context.Context, req *querypb.GetStatisticsRequest, channel string) (*internalpb.GetStatisticsResponse, error) {
 	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", req.Req.GetCollectionID()),
@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/internal/querynodev2/tasks"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/reduce"
 	"github.com/milvus-io/milvus/internal/util/searchutil/scheduler"
 	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/v2/common"
@@ -878,7 +879,11 @@ func (node *QueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (
 	}
 
 	tr.RecordSpan()
+	coverage, hasCoverage := reduce.GetCoverage(ret.GetStatus())
 	ret.Status = merr.Success()
+	if hasCoverage {
+		reduce.SetCoverage(ret.Status, coverage)
+	}
 
 	reduceLatency := tr.RecordSpan()
 	metrics.QueryNodeReduceLatency.
@@ -1029,6 +1034,12 @@ func (node *QueryNode) Query(ctx context.Context, req *querypb.QueryRequest) (*i
 			Status: merr.Status(err),
 		}, nil
 	}
+	if coverage, ok := reduce.MergeCoverage(toMergeResults...); ok {
+		if ret.Status == nil {
+			ret.Status = merr.Success()
+		}
+		reduce.SetCoverage(ret.Status, coverage)
+	}
 	reduceLatency := tr.RecordSpan()
 	metrics.QueryNodeReduceLatency.WithLabelValues(fmt.Sprint(node.GetNodeID()),
 		metrics.QueryLabel, metrics.ReduceShards, metrics.BatchReduce).
@@ -13,6 +13,8 @@ import (
 var (
 	_                 Balancer = (*balancerImpl)(nil)
 	ErrBalancerClosed          = errors.New("balancer is closed")
+
+	errNoStreamingNodeToTakeOver = errors.New("no available streaming node to take over the pchannels")
 )
 
 // Balancer is a load balancer to balance the load of log node.
@@ -42,6 +44,16 @@ type Balancer interface {
 	// Trigger is a hint to trigger a balance.
 	Trigger(ctx context.Context) error
 
+	// DrainStreamingNode marks the streaming node as unschedulable,
+	// and hands off the pchannels on it to other streaming nodes one by one at background.
+	DrainStreamingNode(ctx context.Context, serverID int64) error
+
+	// UndrainStreamingNode re-admits the draining streaming node into scheduling.
+	UndrainStreamingNode(ctx context.Context, serverID int64) error
+
+	// GetDrainStatus returns the progress of all draining streaming nodes.
+	GetDrainStatus(ctx context.Context) ([]DrainStatus, error)
+
 	// Close close the balancer.
 	Close()
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v2/util/contextutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/syncutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -41,6 +42,10 @@ func RecoverBalancer(
 	if err != nil {
 		return nil, errors.Wrap(err, "fail to recover channel manager")
 	}
+	drainingNodes, err := recoverDrainingNodes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to recover draining nodes")
+	}
 	ctx, cancel := context.WithCancelCause(context.Background())
 	b := &balancerImpl{
 		ctx:                    ctx,
@@ -50,6 +55,7 @@ func RecoverBalancer(
 		policy:                 policy,
 		reqCh:                  make(chan *request, 5),
 		backgroundTaskNotifier: syncutil.NewAsyncTaskNotifier[struct{}](),
+		drainingNodes:          drainingNodes,
 	}
 	b.SetLogger(logger)
 	ready260Future, err := b.checkIfAllNodeGreaterThan260AndWatch(ctx)
@@ -71,6 +77,7 @@ type balancerImpl struct {
 	policy                 Policy                                // policy is the balance policy, TODO: should be dynamic in future.
 	reqCh                  chan *request                         // reqCh is the request channel, send the operation to background task.
 	backgroundTaskNotifier *syncutil.AsyncTaskNotifier[struct{}] // backgroundTaskNotifier is used to conmunicate with the background task.
+	drainingNodes          *drainingNodes                        // drainingNodes is the streaming nodes that are unschedulable and being evacuated.
 }
 
 // RegisterStreamingEnabledNotifier registers a notifier into the balancer.
@@ -118,6 +125,47 @@ func (b *balancerImpl) Trigger(ctx context.Context) error {
 	return b.sendRequestAndWaitFinish(ctx, newOpTrigger(ctx))
 }
 
+// DrainStreamingNode marks the streaming node as unschedulable and evacuates the pchannels on it.
+func (b *balancerImpl) DrainStreamingNode(ctx context.Context, serverID int64) error {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	ctx, cancel := contextutil.MergeContext(ctx, b.ctx)
+	defer cancel()
+	nodeStatus, err := resource.Resource().StreamingNodeManagerClient().CollectAllStatus(ctx)
+	if err != nil {
+		return errors.Wrap(err, "fail to collect all status")
+	}
+	if _, ok := nodeStatus[serverID]; !ok {
+		return merr.WrapErrNodeNotFound(serverID, "streaming node not found")
+	}
+	return b.sendRequestAndWaitFinish(ctx, newOpDrainStreamingNode(ctx, serverID))
+}
+
+// UndrainStreamingNode re-admits the draining streaming node into scheduling.
+func (b *balancerImpl) UndrainStreamingNode(ctx context.Context, serverID int64) error {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	ctx, cancel := contextutil.MergeContext(ctx, b.ctx)
+	defer cancel()
+	return b.sendRequestAndWaitFinish(ctx, newOpUndrainStreamingNode(ctx, serverID))
+}
+
+// GetDrainStatus returns the progress of all draining streaming nodes.
+func (b *balancerImpl) GetDrainStatus(ctx context.Context) ([]DrainStatus, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	return b.drainingNodes.Status(b.channelMetaManager.CurrentPChannelsView()), nil
+}
+
 // sendRequestAndWaitFinish send a request to the background task and wait for it to finish.
 func (b *balancerImpl) sendRequestAndWaitFinish(ctx context.Context, newReq *request) error {
 	select {
@@ -341,10 +389,13 @@ func (b *balancerImpl) balance(ctx context.Context) (bool, error) {
 		accessMode = types.AccessModeRW
 	}
 	currentLayout := generateCurrentLayout(pchannelView, nodeStatus, accessMode)
+	// hide the draining nodes from the policy, only one pchannel of draining node can be moved at one round.
+	drainPlan := b.drainingNodes.ApplyToLayout(&currentLayout, pchannelView, accessMode)
 	expectedLayout, err := b.policy.Balance(currentLayout)
 	if err != nil {
 		return false, errors.Wrap(err, "fail to balance")
 	}
+	b.drainingNodes.ApplyToExpectedLayout(drainPlan, &expectedLayout)
 
 	b.Logger().Info("balance policy generate result success, try to assign...", zap.Stringer("expectedLayout", expectedLayout))
 	// bookkeeping the meta assignment started.
@@ -357,7 +408,10 @@ func (b *balancerImpl) balance(ctx context.Context) (bool, error) {
 		b.Logger().Info("no change of balance result need to be applied")
 		return false, nil
 	}
-	return true, b.applyBalanceResultToStreamingNode(ctx, modifiedChannels)
+	// the handoff of draining node is done after the target node recovers the wal.
+	err = b.applyBalanceResultToStreamingNode(ctx, modifiedChannels)
+	b.drainingNodes.Done(drainPlan, err)
+	return true, err
 }
 
 // applyBalanceResultToStreamingNode apply the balance result to streaming node.
@@ -108,6 +108,16 @@ func TestBalancer(t *testing.T) {
 		}, nil
 	})
 	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil).Maybe()
+	drainingNodes := make(map[int64]*streamingpb.StreamingNodeDrainMeta)
+	catalog.EXPECT().ListDrainingNode(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SaveDrainingNode(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, meta *streamingpb.StreamingNodeDrainMeta) error {
+		drainingNodes[meta.GetServerId()] = meta
+		return nil
+	})
+	catalog.EXPECT().RemoveDrainingNode(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, serverID int64) error {
+		delete(drainingNodes, serverID)
+		return nil
+	})
 
 	// Test for lower datanode and proxy version protection.
 	metaRoot := paramtable.Get().EtcdCfg.MetaRootPath.GetValue()
@@ -173,6 +183,44 @@ func TestBalancer(t *testing.T) {
 	b.Trigger(ctx)
 	checkReady()
 
+	// drain the streaming node 1, all pchannels on it should be handed off.
+	assert.Error(t, b.DrainStreamingNode(ctx, 10))
+	assert.Error(t, b.UndrainStreamingNode(ctx, 1))
+	assert.NoError(t, b.DrainStreamingNode(ctx, 1))
+	err = b.WatchChannelAssignments(ctx, func(version typeutil.VersionInt64Pair, relations []types.PChannelInfoAssigned) error {
+		for _, relation := range relations {
+			if relation.Node.ServerID == 1 {
+				return nil
+			}
+		}
+		return doneErr
+	})
+	assert.ErrorIs(t, err, doneErr)
+	statuses, err := b.GetDrainStatus(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 1)
+	assert.Equal(t, int64(1), statuses[0].ServerID)
+	assert.Equal(t, balancer.DrainStateDrained, statuses[0].State)
+	assert.Zero(t, statuses[0].RemainingChannels)
+	assert.Contains(t, drainingNodes, int64(1))
+
+	// the drain state is not changed if the catalog fails.
+	catalog.EXPECT().RemoveDrainingNode(mock.Anything, mock.Anything).Unset()
+	catalog.EXPECT().RemoveDrainingNode(mock.Anything, mock.Anything).Return(errors.New("remove error")).Once()
+	assert.Error(t, b.UndrainStreamingNode(ctx, 1))
+	statuses, err = b.GetDrainStatus(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 1)
+	catalog.EXPECT().RemoveDrainingNode(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, serverID int64) error {
+		delete(drainingNodes, serverID)
+		return nil
+	})
+	assert.NoError(t, b.UndrainStreamingNode(ctx, 1))
+	statuses, err = b.GetDrainStatus(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, statuses)
+	assert.Empty(t, drainingNodes)
+
 	// create a inifite block watcher and can be interrupted by close of balancer.
 	f := syncutil.NewFuture[error]()
 	go func() {
@@ -280,6 +328,7 @@ func TestBalancer_WithRecoveryLag(t *testing.T) {
 		}, nil
 	})
 	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil).Maybe()
+	catalog.EXPECT().ListDrainingNode(mock.Anything).Return(nil, nil)
 
 	ctx := context.Background()
 	b, err := balancer.RecoverBalancer(ctx)
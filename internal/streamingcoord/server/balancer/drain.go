@@ -0,0 +1,275 @@
+package balancer
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+)
+
+const (
+	DrainStateDraining = "draining"
+	DrainStateDrained  = "drained"
+)
+
+// DrainStatus is the progress of draining a streaming node.
+type DrainStatus struct {
+	ServerID           int64     `json:"server_id"`
+	State              string    `json:"state"`
+	TotalChannels      int       `json:"total_channels"`
+	RemainingChannels  int       `json:"remaining_channels"`
+	RemainingPChannels []string  `json:"remaining_pchannels,omitempty"`
+	MovingPChannel     string    `json:"moving_pchannel,omitempty"`
+	MovingTarget       int64     `json:"moving_target,omitempty"`
+	LastError          string    `json:"last_error,omitempty"`
+	StartedAt          time.Time `json:"started_at"`
+}
+
+// drainingNode is the drain state of a streaming node.
+type drainingNode struct {
+	serverID      int64
+	totalChannels int
+	movingChannel types.ChannelID
+	movingTarget  int64
+	lastError     error
+	startedAt     time.Time
+}
+
+// recoverDrainingNodes recovers the draining node set from the catalog.
+func recoverDrainingNodes(ctx context.Context) (*drainingNodes, error) {
+	metas, err := resource.Resource().StreamingCatalog().ListDrainingNode(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d := &drainingNodes{
+		nodes: make(map[int64]*drainingNode, len(metas)),
+	}
+	for _, meta := range metas {
+		d.nodes[meta.GetServerId()] = &drainingNode{
+			serverID:      meta.GetServerId(),
+			totalChannels: int(meta.GetTotalChannels()),
+			startedAt:     time.UnixMilli(meta.GetStartTimestamp()),
+		}
+	}
+	return d, nil
+}
+
+// drainingNodes is the set of streaming nodes that are marked as unschedulable by operator.
+// The draining nodes are persisted in the catalog, so the drain is continued after the streamingcoord restarts,
+// the node is kept unschedulable until it's undrained by operator.
+type drainingNodes struct {
+	mu    sync.Mutex
+	nodes map[int64]*drainingNode
+}
+
+// Add marks the streaming node as draining, return false if the node is already draining.
+func (d *drainingNodes) Add(ctx context.Context, serverID int64, view *channel.PChannelView) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.nodes[serverID]; ok {
+		return false, nil
+	}
+	node := &drainingNode{
+		serverID:      serverID,
+		totalChannels: len(channelsOnNode(view, serverID)),
+		startedAt:     time.Now(),
+	}
+	if err := resource.Resource().StreamingCatalog().SaveDrainingNode(ctx, &streamingpb.StreamingNodeDrainMeta{
+		ServerId:       node.serverID,
+		TotalChannels:  int64(node.totalChannels),
+		StartTimestamp: node.startedAt.UnixMilli(),
+	}); err != nil {
+		return false, errors.Wrapf(err, "fail to save draining streaming node %d", serverID)
+	}
+	d.nodes[serverID] = node
+	return true, nil
+}
+
+// Remove re-admits the streaming node into scheduling, return false if the node is not draining.
+func (d *drainingNodes) Remove(ctx context.Context, serverID int64) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.nodes[serverID]; !ok {
+		return false, nil
+	}
+	if err := resource.Resource().StreamingCatalog().RemoveDrainingNode(ctx, serverID); err != nil {
+		return false, errors.Wrapf(err, "fail to remove draining streaming node %d", serverID)
+	}
+	delete(d.nodes, serverID)
+	return true, nil
+}
+
+// Status returns the drain status of all draining nodes.
+func (d *drainingNodes) Status(view *channel.PChannelView) []DrainStatus {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	statuses := make([]DrainStatus, 0, len(d.nodes))
+	for _, node := range d.nodes {
+		remaining := channelsOnNode(view, node.serverID)
+		status := DrainStatus{
+			ServerID:          node.serverID,
+			State:             DrainStateDraining,
+			TotalChannels:     node.totalChannels,
+			RemainingChannels: len(remaining),
+			StartedAt:         node.startedAt,
+		}
+		for _, id := range remaining {
+			status.RemainingPChannels = append(status.RemainingPChannels, id.Name)
+		}
+		if len(remaining) == 0 {
+			status.State = DrainStateDrained
+		}
+		if node.movingTarget != 0 {
+			status.MovingPChannel = node.movingChannel.Name
+			status.MovingTarget = node.movingTarget
+		}
+		if node.lastError != nil {
+			status.LastError = node.lastError.Error()
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].ServerID < statuses[j].ServerID
+	})
+	return statuses
+}
+
+// drainPlan is the modification of a balance round to evacuate the draining nodes.
+type drainPlan struct {
+	moving map[int64]types.ChannelID                      // moving is the channel that is moving out of the draining node at current round.
+	pinned map[types.ChannelID]types.PChannelInfoAssigned // pinned is the channels that stay on the draining nodes at current round.
+}
+
+// ApplyToLayout removes the draining nodes from the layout before the layout is given to the policy.
+// Only one pchannel of every draining node is released to the policy at one balance round,
+// the other pchannels are pinned on the draining node and not visible to the policy.
+// The balancer applies one round until the assignment is done at target node,
+// so the pchannels are handed off one by one.
+func (d *drainingNodes) ApplyToLayout(layout *CurrentLayout, view *channel.PChannelView, accessMode types.AccessMode) *drainPlan {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.nodes) == 0 {
+		return nil
+	}
+	hasCandidate := false
+	for serverID := range layout.AllNodesInfo {
+		if _, ok := d.nodes[serverID]; !ok {
+			hasCandidate = true
+			break
+		}
+	}
+	if !hasCandidate {
+		// Keep the layout as it is, otherwise the policy will fail to assign any pchannel.
+		for _, node := range d.nodes {
+			node.movingChannel, node.movingTarget = types.ChannelID{}, 0
+			node.lastError = errNoStreamingNodeToTakeOver
+		}
+		log.Warn("no available streaming node to take over the pchannels of draining nodes")
+		return nil
+	}
+
+	plan := &drainPlan{
+		moving: make(map[int64]types.ChannelID),
+		pinned: make(map[types.ChannelID]types.PChannelInfoAssigned),
+	}
+	stats := make(map[channel.ChannelID]channel.PChannelStatsView, len(layout.Stats))
+	for id, s := range layout.Stats {
+		stats[id] = s
+	}
+	layout.Stats = stats
+	for serverID, node := range d.nodes {
+		delete(layout.AllNodesInfo, serverID)
+		node.movingChannel, node.movingTarget = types.ChannelID{}, 0
+
+		for _, id := range channelsOnNode(view, serverID) {
+			if assigned, ok := layout.ChannelsToNodes[id]; !ok || assigned != serverID {
+				// the channel is not alive on the draining node, the policy will assign it to other node.
+				continue
+			}
+			delete(layout.ChannelsToNodes, id)
+			if _, ok := plan.moving[serverID]; !ok {
+				plan.moving[serverID] = id
+				node.movingChannel = id
+				continue
+			}
+			current := view.Channels[id].CurrentAssignment()
+			current.Channel.AccessMode = accessMode
+			plan.pinned[id] = current
+			delete(layout.Channels, id)
+			delete(layout.Stats, id)
+			delete(layout.ExpectedAccessMode, id)
+		}
+	}
+	return plan
+}
+
+// ApplyToExpectedLayout adds the pinned channels back into the expected layout generated by the policy.
+func (d *drainingNodes) ApplyToExpectedLayout(plan *drainPlan, expectedLayout *ExpectedLayout) {
+	if plan == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for id, assignment := range plan.pinned {
+		expectedLayout.ChannelAssignment[id] = assignment
+	}
+	for serverID, id := range plan.moving {
+		if node, ok := d.nodes[serverID]; ok {
+			if target, ok := expectedLayout.ChannelAssignment[id]; ok {
+				node.movingTarget = target.Node.ServerID
+			}
+		}
+	}
+}
+
+// Done records the result of the handoff of current round.
+func (d *drainingNodes) Done(plan *drainPlan, err error) {
+	if plan == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for serverID, id := range plan.moving {
+		node, ok := d.nodes[serverID]
+		if !ok {
+			continue
+		}
+		node.lastError = err
+		if err == nil {
+			log.Info("pchannel of draining streaming node is handed off",
+				zap.Int64("serverID", serverID),
+				zap.Stringer("channel", id),
+				zap.Int64("target", node.movingTarget))
+			node.movingChannel, node.movingTarget = types.ChannelID{}, 0
+		}
+	}
+}
+
+// channelsOnNode returns the pchannels located on the streaming node sorted by channel id.
+func channelsOnNode(view *channel.PChannelView, serverID int64) []types.ChannelID {
+	ids := make([]types.ChannelID, 0)
+	for id, meta := range view.Channels {
+		if meta.CurrentServerID() == serverID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].LT(ids[j])
+	})
+	return ids
+}
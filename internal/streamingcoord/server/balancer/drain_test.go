@@ -0,0 +1,82 @@
+package balancer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/pkg/v2/proto/streamingpb"
+)
+
+func TestDrainingNodesRecovery(t *testing.T) {
+	ctx := context.Background()
+	startedAt := time.Now().Add(-time.Minute).Truncate(time.Millisecond)
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog))
+
+	catalog.EXPECT().ListDrainingNode(mock.Anything).Return(nil, errors.New("list error")).Once()
+	d, err := recoverDrainingNodes(ctx)
+	assert.Error(t, err)
+	assert.Nil(t, d)
+
+	catalog.EXPECT().ListDrainingNode(mock.Anything).Return([]*streamingpb.StreamingNodeDrainMeta{
+		{ServerId: 1, TotalChannels: 2, StartTimestamp: startedAt.UnixMilli()},
+	}, nil)
+	d, err = recoverDrainingNodes(ctx)
+	assert.NoError(t, err)
+
+	view := &channel.PChannelView{Channels: map[channel.ChannelID]*channel.PChannelMeta{}}
+	statuses := d.Status(view)
+	assert.Len(t, statuses, 1)
+	assert.Equal(t, int64(1), statuses[0].ServerID)
+	assert.Equal(t, 2, statuses[0].TotalChannels)
+	assert.Equal(t, DrainStateDrained, statuses[0].State)
+	assert.True(t, startedAt.Equal(statuses[0].StartedAt))
+
+	// the recovered node is already draining.
+	added, err := d.Add(ctx, 1, view)
+	assert.NoError(t, err)
+	assert.False(t, added)
+
+	// the node is not draining if the catalog fails.
+	catalog.EXPECT().SaveDrainingNode(mock.Anything, mock.Anything).Return(errors.New("save error")).Once()
+	added, err = d.Add(ctx, 2, view)
+	assert.Error(t, err)
+	assert.False(t, added)
+	assert.Len(t, d.Status(view), 1)
+
+	catalog.EXPECT().SaveDrainingNode(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, meta *streamingpb.StreamingNodeDrainMeta) error {
+		assert.Equal(t, int64(2), meta.GetServerId())
+		assert.NotZero(t, meta.GetStartTimestamp())
+		return nil
+	}).Once()
+	added, err = d.Add(ctx, 2, view)
+	assert.NoError(t, err)
+	assert.True(t, added)
+	assert.Len(t, d.Status(view), 2)
+
+	removed, err := d.Remove(ctx, 3)
+	assert.NoError(t, err)
+	assert.False(t, removed)
+
+	catalog.EXPECT().RemoveDrainingNode(mock.Anything, int64(1)).Return(errors.New("remove error")).Once()
+	removed, err = d.Remove(ctx, 1)
+	assert.Error(t, err)
+	assert.False(t, removed)
+	assert.Len(t, d.Status(view), 2)
+
+	catalog.EXPECT().RemoveDrainingNode(mock.Anything, int64(1)).Return(nil).Once()
+	removed, err = d.Remove(ctx, 1)
+	assert.NoError(t, err)
+	assert.True(t, removed)
+	statuses = d.Status(view)
+	assert.Len(t, statuses, 1)
+	assert.Equal(t, int64(2), statuses[0].ServerID)
+}
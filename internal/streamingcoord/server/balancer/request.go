@@ -3,7 +3,10 @@ package balancer
 import (
 	"context"
 
+	"go.uber.org/zap"
+
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/syncutil"
 )
 
@@ -40,3 +43,35 @@ func newOpTrigger(ctx context.Context) *request {
 		future: future,
 	}
 }
+
+// newOpDrainStreamingNode is a operation to mark a streaming node as draining.
+func newOpDrainStreamingNode(ctx context.Context, serverID int64) *request {
+	future := syncutil.NewFuture[error]()
+	return &request{
+		ctx: ctx,
+		apply: func(impl *balancerImpl) {
+			added, err := impl.drainingNodes.Add(ctx, serverID, impl.channelMetaManager.CurrentPChannelsView())
+			if err == nil && !added {
+				impl.Logger().Info("streaming node is already draining", zap.Int64("serverID", serverID))
+			}
+			future.Set(err)
+		},
+		future: future,
+	}
+}
+
+// newOpUndrainStreamingNode is a operation to re-admit a draining streaming node.
+func newOpUndrainStreamingNode(ctx context.Context, serverID int64) *request {
+	future := syncutil.NewFuture[error]()
+	return &request{
+		ctx: ctx,
+		apply: func(impl *balancerImpl) {
+			removed, err := impl.drainingNodes.Remove(ctx, serverID)
+			if err == nil && !removed {
+				err = merr.WrapErrParameterInvalidMsg("streaming node %d is not draining", serverID)
+			}
+			future.Set(err)
+		},
+		future: future,
+	}
+}
@@ -3,6 +3,7 @@ package broadcaster
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/cockroachdb/errors"
@@ -12,6 +13,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/syncutil"
 )
 
@@ -174,3 +176,55 @@ func (bm *broadcastTaskManager) removeResourceKeys(broadcastID uint64) {
 		bm.metrics.GoneResourceKey(key.Domain)
 	}
 }
+
+// ListTasks returns the inspection view of all in-flight broadcast tasks sorted by broadcast id.
+func (bm *broadcastTaskManager) ListTasks() []BroadcastTaskInfo {
+	bm.cond.L.Lock()
+	tasks := make([]*broadcastTask, 0, len(bm.tasks))
+	held := make(map[uint64]map[message.ResourceKey]bool, len(bm.tasks))
+	for id, task := range bm.tasks {
+		tasks = append(tasks, task)
+		held[id] = make(map[message.ResourceKey]bool)
+	}
+	for key, id := range bm.resourceKeys {
+		if _, ok := held[id]; ok {
+			held[id][key] = true
+		}
+	}
+	bm.cond.L.Unlock()
+
+	infos := make([]BroadcastTaskInfo, 0, len(tasks))
+	for _, task := range tasks {
+		infos = append(infos, task.Info(held[task.header.BroadcastID]))
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].BroadcastID < infos[j].BroadcastID
+	})
+	return infos
+}
+
+// RetryAck retries the ack callbacks of the appended but unacked vchannels of the broadcast task.
+func (bm *broadcastTaskManager) RetryAck(ctx context.Context, broadcastID uint64) error {
+	task, ok := bm.getBroadcastTaskByID(broadcastID)
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("broadcast task %d not found", broadcastID)
+	}
+	err := task.RetryAck(ctx)
+	if task.State() == streamingpb.BroadcastTaskState_BROADCAST_TASK_STATE_DONE {
+		bm.removeBroadcastTask(broadcastID)
+	}
+	return err
+}
+
+// Abandon abandons the broadcast task and releases the resource keys held by it.
+func (bm *broadcastTaskManager) Abandon(ctx context.Context, broadcastID uint64, force bool) error {
+	task, ok := bm.getBroadcastTaskByID(broadcastID)
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("broadcast task %d not found", broadcastID)
+	}
+	if err := task.Abandon(ctx, force); err != nil {
+		return err
+	}
+	bm.removeBroadcastTask(broadcastID)
+	return nil
+}
@@ -3,6 +3,7 @@ package broadcaster
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
@@ -24,10 +25,20 @@ func newBroadcastTaskFromProto(proto *streamingpb.BroadcastTask, metrics *broadc
 	bt := &broadcastTask{
 		mu:               sync.Mutex{},
 		header:           bh,
+		messageType:      msg.MessageType(),
 		task:             proto,
 		recoverPersisted: true, // the task is recovered from the recovery info, so it's persisted.
 		metrics:          m,
 		allAcked:         make(chan struct{}),
+		abandoned:        make(chan struct{}),
+		createdAt:        time.Now(),
+		progress:         newVChannelProgress(bh.VChannels),
+	}
+	for idx, vchannel := range bh.VChannels {
+		// all messages have been appended if the task is waiting for ack.
+		if proto.GetState() == streamingpb.BroadcastTaskState_BROADCAST_TASK_STATE_WAIT_ACK || proto.AckedVchannelBitmap[idx] != 0 {
+			bt.progress[vchannel].appended = true
+		}
 	}
 	if isAllDone(proto) {
 		close(bt.allAcked)
@@ -40,9 +51,10 @@ func newBroadcastTaskFromBroadcastMessage(msg message.BroadcastMutableMessage, m
 	m := metrics.NewBroadcastTask(streamingpb.BroadcastTaskState_BROADCAST_TASK_STATE_PENDING)
 	header := msg.BroadcastHeader()
 	bt := &broadcastTask{
-		Binder: log.Binder{},
-		mu:     sync.Mutex{},
-		header: header,
+		Binder:      log.Binder{},
+		mu:          sync.Mutex{},
+		header:      header,
+		messageType: msg.MessageType(),
 		task: &streamingpb.BroadcastTask{
 			Message:             &messagespb.Message{Payload: msg.Payload(), Properties: msg.Properties().ToRawMap()},
 			State:               streamingpb.BroadcastTaskState_BROADCAST_TASK_STATE_PENDING,
@@ -51,6 +63,9 @@ func newBroadcastTaskFromBroadcastMessage(msg message.BroadcastMutableMessage, m
 		recoverPersisted: false,
 		metrics:          m,
 		allAcked:         make(chan struct{}),
+		abandoned:        make(chan struct{}),
+		createdAt:        time.Now(),
+		progress:         newVChannelProgress(header.VChannels),
 	}
 	if isAllDone(bt.task) {
 		close(bt.allAcked)
@@ -63,10 +78,17 @@ type broadcastTask struct {
 	log.Binder
 	mu               sync.Mutex
 	header           *message.BroadcastHeader
+	messageType      message.MessageType
 	task             *streamingpb.BroadcastTask
 	recoverPersisted bool // a flag to indicate that the task has been persisted into the recovery info and can be recovered.
 	metrics          *taskMetricsGuard
 	allAcked         chan struct{}
+	abandoned        chan struct{} // closed when the task is abandoned by operator.
+
+	// the fields below are kept in memory only for inspection.
+	createdAt time.Time                    // the time when the task is created or recovered.
+	appending bool                         // a flag to indicate that the messages of task are being appended.
+	progress  map[string]*vchannelProgress // the append and ack progress of every vchannel.
 }
 
 // Header returns the header of the broadcast task.
@@ -107,7 +129,7 @@ func (b *broadcastTask) InitializeRecovery(ctx context.Context) error {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	if b.recoverPersisted {
+	if b.recoverPersisted || b.isAbandoned() {
 		return nil
 	}
 	if err := b.saveTask(ctx, b.task, b.Logger()); err != nil {
@@ -137,14 +159,22 @@ func (b *broadcastTask) Ack(ctx context.Context, vchannel string) error {
 		b.Logger().Warn("vchannel is already acked, ignore the ack request", zap.String("vchannel", vchannel))
 		return nil
 	}
+	if b.IsAbandoned() {
+		b.Logger().Warn("broadcast task is abandoned, ignore the ack request", zap.String("vchannel", vchannel))
+		return nil
+	}
 	if err := registry.CallMessageAckCallback(ctx, msg); err != nil {
 		b.Logger().Warn("message ack callback failed", log.FieldMessage(msg), zap.Error(err))
+		b.setVChannelError(vchannel, err)
 		return err
 	}
 	b.Logger().Warn("message ack callback success", log.FieldMessage(msg))
 
 	b.mu.Lock()
 	defer b.mu.Unlock()
+	if b.isAbandoned() {
+		return nil
+	}
 	task, ok := b.copyAndSetVChannelAcked(vchannel)
 	if !ok {
 		return nil
@@ -157,6 +187,11 @@ func (b *broadcastTask) Ack(ctx context.Context, vchannel string) error {
 		return err
 	}
 	b.task = task
+	if p, ok := b.progress[vchannel]; ok {
+		p.appended = true
+		p.ackedAt = time.Now()
+		p.lastError = nil
+	}
 	if isAllDone(task) {
 		b.metrics.ObserveAckAll()
 		close(b.allAcked)
@@ -169,6 +204,8 @@ func (b *broadcastTask) BlockUntilAllAck(ctx context.Context) error {
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
+	case <-b.abandoned:
+		return errBroadcastTaskAbandoned
 	case <-b.allAcked:
 		return nil
 	}
@@ -209,6 +246,10 @@ func (b *broadcastTask) BroadcastDone(ctx context.Context) error {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	if b.isAbandoned() {
+		// the task has been removed from the recovery info, should not be saved again.
+		return nil
+	}
 	task := b.copyAndMarkBroadcastDone()
 	if err := b.saveTask(ctx, task, b.Logger()); err != nil {
 		return err
@@ -15,6 +15,18 @@ type Broadcaster interface {
 	// Ack acknowledges the message at the specified vchannel.
 	Ack(ctx context.Context, req types.BroadcastAckRequest) error
 
+	// ListTasks returns the in-flight broadcast tasks for inspection.
+	ListTasks(ctx context.Context) ([]BroadcastTaskInfo, error)
+
+	// RetryTask retries the stuck broadcast task immediately,
+	// the unsent messages are appended again without waiting for the backoff,
+	// and the ack callbacks of the appended but unacked vchannels are called again.
+	RetryTask(ctx context.Context, broadcastID uint64) error
+
+	// AbandonTask gives up the stuck broadcast task and releases its resource keys.
+	// It's rejected if any message of the task has been appended unless force is set.
+	AbandonTask(ctx context.Context, broadcastID uint64, force bool) error
+
 	// Close closes the broadcaster.
 	Close()
 }
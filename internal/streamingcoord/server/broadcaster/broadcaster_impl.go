@@ -37,6 +37,7 @@ func RecoverBroadcaster(
 		backoffs:               typeutil.NewHeap[*pendingBroadcastTask](&pendingBroadcastTaskArray{}),
 		backoffChan:            make(chan *pendingBroadcastTask),
 		pendingChan:            make(chan *pendingBroadcastTask),
+		retryChan:              make(chan uint64),
 		workerChan:             make(chan *pendingBroadcastTask),
 		appendOperator:         appendOperator,
 	}
@@ -53,6 +54,7 @@ type broadcasterImpl struct {
 	backoffs               typeutil.Heap[*pendingBroadcastTask]
 	pendingChan            chan *pendingBroadcastTask
 	backoffChan            chan *pendingBroadcastTask
+	retryChan              chan uint64
 	workerChan             chan *pendingBroadcastTask
 	appendOperator         *syncutil.Future[AppendOperator] // TODO: we can remove those lazy future in 2.6.0, by remove the msgstream broadcaster.
 }
@@ -115,6 +117,48 @@ func (b *broadcasterImpl) Ack(ctx context.Context, req types.BroadcastAckRequest
 	return b.manager.Ack(ctx, req.BroadcastID, req.VChannel)
 }
 
+// ListTasks returns the in-flight broadcast tasks for inspection.
+func (b *broadcasterImpl) ListTasks(ctx context.Context) ([]BroadcastTaskInfo, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("broadcaster is closing")
+	}
+	defer b.lifetime.Done()
+
+	return b.manager.ListTasks(), nil
+}
+
+// RetryTask retries the stuck broadcast task immediately.
+func (b *broadcasterImpl) RetryTask(ctx context.Context, broadcastID uint64) error {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("broadcaster is closing")
+	}
+	defer b.lifetime.Done()
+
+	if err := b.manager.RetryAck(ctx, broadcastID); err != nil {
+		return err
+	}
+	// wake up the task in backoff queue to make the unsent messages appended immediately.
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-b.backgroundTaskNotifier.Context().Done():
+		return status.NewOnShutdownError("broadcaster is closing")
+	case b.retryChan <- broadcastID:
+	}
+	b.Logger().Info("broadcast task is retried by operator", zap.Uint64("broadcastID", broadcastID))
+	return nil
+}
+
+// AbandonTask gives up the stuck broadcast task and releases its resource keys.
+func (b *broadcasterImpl) AbandonTask(ctx context.Context, broadcastID uint64, force bool) error {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("broadcaster is closing")
+	}
+	defer b.lifetime.Done()
+
+	return b.manager.Abandon(ctx, broadcastID, force)
+}
+
 func (b *broadcasterImpl) Close() {
 	b.lifetime.SetState(typeutil.LifetimeStateStopped)
 	b.lifetime.Wait()
@@ -188,6 +232,20 @@ func (b *broadcasterImpl) dispatch() {
 		case task := <-b.backoffChan:
 			// task is backoff, push it into backoff queue to make a delay retry.
 			b.backoffs.Push(task)
+		case broadcastID := <-b.retryChan:
+			// move the retried task out of backoff queue.
+			remains := make([]*pendingBroadcastTask, 0, b.backoffs.Len())
+			for b.backoffs.Len() > 0 {
+				task := b.backoffs.Pop()
+				if task.Header().BroadcastID == broadcastID {
+					b.pendings = append([]*pendingBroadcastTask{task}, b.pendings...)
+					continue
+				}
+				remains = append(remains, task)
+			}
+			for _, task := range remains {
+				b.backoffs.Push(task)
+			}
 		case <-nextBackOff:
 			// backoff is done, move all the backoff done task into pending to retry.
 			newPops := make([]*pendingBroadcastTask, 0)
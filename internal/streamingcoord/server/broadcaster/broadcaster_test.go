@@ -217,3 +217,92 @@ func createNewWaitAckBroadcastTaskFromMessage(
 		AckedVchannelBitmap: bitmap,
 	}
 }
+
+func TestBroadcasterInspection(t *testing.T) {
+	registry.ResetRegistration()
+	paramtable.Init()
+
+	meta := mock_metastore.NewMockStreamingCoordCataLog(t)
+	meta.EXPECT().ListBroadcastTask(mock.Anything).Return([]*streamingpb.BroadcastTask{
+		createNewBroadcastTask(10, []string{"v1", "v2"}, message.NewCollectionNameResourceKey("c1")),
+		createNewWaitAckBroadcastTaskFromMessage(
+			createNewBroadcastMsg([]string{"v1", "v2"}, message.NewCollectionNameResourceKey("c2")).WithBroadcastID(11),
+			streamingpb.BroadcastTaskState_BROADCAST_TASK_STATE_WAIT_ACK,
+			[]byte{0x01, 0x00}),
+	}, nil).Times(1)
+	done := typeutil.NewConcurrentSet[uint64]()
+	meta.EXPECT().SaveBroadcastTask(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, broadcastID uint64, bt *streamingpb.BroadcastTask) error {
+		if bt.State == streamingpb.BroadcastTaskState_BROADCAST_TASK_STATE_DONE {
+			done.Insert(broadcastID)
+		}
+		return nil
+	})
+	rc := idalloc.NewMockRootCoordClient(t)
+	f := syncutil.NewFuture[internaltypes.MixCoordClient]()
+	f.Set(rc)
+	resource.InitForTest(resource.OptStreamingCatalog(meta), resource.OptMixCoordClient(f))
+
+	// the append operation always fails, so the pending task is stuck.
+	appendCount := atomic.NewInt64(0)
+	operator := mock_broadcaster.NewMockAppendOperator(t)
+	operator.EXPECT().AppendMessages(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, msgs ...message.MutableMessage) types.AppendResponses {
+			appendCount.Inc()
+			resps := types.AppendResponses{Responses: make([]types.AppendResponse, len(msgs))}
+			for idx := range msgs {
+				resps.Responses[idx] = types.AppendResponse{Error: errors.New("append failed")}
+			}
+			return resps
+		}).Maybe()
+	fOperator := syncutil.NewFuture[AppendOperator]()
+	fOperator.Set(operator)
+
+	bc, err := RecoverBroadcaster(context.Background(), fOperator)
+	assert.NoError(t, err)
+	defer bc.Close()
+
+	assert.Eventually(t, func() bool {
+		return appendCount.Load() > 0
+	}, 10*time.Second, 10*time.Millisecond)
+
+	tasks, err := bc.ListTasks(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 2)
+	assert.Equal(t, uint64(10), tasks[0].BroadcastID)
+	assert.Equal(t, message.MessageTypeDropCollection.String(), tasks[0].MessageType)
+	assert.Equal(t, 0, tasks[0].AckedVChannels)
+	assert.False(t, tasks[0].VChannels[0].Appended)
+	assert.Eventually(t, func() bool {
+		tasks, _ := bc.ListTasks(context.Background())
+		return tasks[0].VChannels[0].LastError != ""
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, uint64(11), tasks[1].BroadcastID)
+	assert.Equal(t, streamingpb.BroadcastTaskState_BROADCAST_TASK_STATE_WAIT_ACK.String(), tasks[1].State)
+	assert.Equal(t, 1, tasks[1].AckedVChannels)
+	assert.True(t, tasks[1].VChannels[1].Appended)
+	assert.False(t, tasks[1].VChannels[1].Acked)
+	assert.Len(t, tasks[1].ResourceKeys, 1)
+	assert.True(t, tasks[1].ResourceKeys[0].Held)
+
+	// retry the ack of the appended vchannels.
+	assert.NoError(t, bc.RetryTask(context.Background(), 11))
+	assert.True(t, done.Contain(11))
+	assert.Error(t, bc.RetryTask(context.Background(), 11))
+
+	// retry the stuck pending task, the append is triggered immediately.
+	before := appendCount.Load()
+	assert.NoError(t, bc.RetryTask(context.Background(), 10))
+	assert.Eventually(t, func() bool {
+		return appendCount.Load() > before
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// abandon the stuck task.
+	assert.Error(t, bc.AbandonTask(context.Background(), 12, false))
+	assert.Eventually(t, func() bool {
+		return bc.AbandonTask(context.Background(), 10, false) == nil
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.True(t, done.Contain(10))
+	tasks, err = bc.ListTasks(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, tasks)
+}
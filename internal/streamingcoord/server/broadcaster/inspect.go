@@ -0,0 +1,249 @@
+package broadcaster
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+var errBroadcastTaskAbandoned = errors.New("broadcast task is abandoned by operator")
+
+// BroadcastTaskInfo is the inspection view of an in-flight broadcast task.
+type BroadcastTaskInfo struct {
+	BroadcastID    uint64            `json:"broadcast_id"`
+	MessageType    string            `json:"message_type"`
+	State          string            `json:"state"`
+	ResourceKeys   []ResourceKeyInfo `json:"resource_keys"`
+	CreatedAt      time.Time         `json:"created_at"`
+	AgeSeconds     float64           `json:"age_seconds"`
+	Appending      bool              `json:"appending"`
+	TotalVChannels int               `json:"total_vchannels"`
+	AckedVChannels int               `json:"acked_vchannels"`
+	VChannels      []VChannelAckInfo `json:"vchannels"`
+}
+
+// ResourceKeyInfo is the resource key of the broadcast task.
+type ResourceKeyInfo struct {
+	Domain string `json:"domain"`
+	Key    string `json:"key"`
+	Held   bool   `json:"held"` // the resource key lock is still held by the task.
+}
+
+// VChannelAckInfo is the append and ack progress of the broadcast task at a vchannel.
+type VChannelAckInfo struct {
+	VChannel          string     `json:"vchannel"`
+	Appended          bool       `json:"appended"`
+	Acked             bool       `json:"acked"`
+	AppendedAt        *time.Time `json:"appended_at,omitempty"`
+	AckedAt           *time.Time `json:"acked_at,omitempty"`
+	UnackedAgeSeconds float64    `json:"unacked_age_seconds,omitempty"`
+	LastError         string     `json:"last_error,omitempty"`
+}
+
+// vchannelProgress is the in-memory progress of the broadcast task at a vchannel.
+type vchannelProgress struct {
+	appended   bool
+	appendedAt time.Time
+	ackedAt    time.Time
+	lastError  error
+}
+
+func newVChannelProgress(vchannels []string) map[string]*vchannelProgress {
+	progress := make(map[string]*vchannelProgress, len(vchannels))
+	for _, vchannel := range vchannels {
+		progress[vchannel] = &vchannelProgress{}
+	}
+	return progress
+}
+
+// IsAbandoned returns true if the task is abandoned by operator.
+func (b *broadcastTask) IsAbandoned() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.isAbandoned()
+}
+
+// isAbandoned returns true if the task is abandoned, should be called with lock.
+func (b *broadcastTask) isAbandoned() bool {
+	select {
+	case <-b.abandoned:
+		return true
+	default:
+		return false
+	}
+}
+
+// beginAppend marks the messages of task are being appended, return false if the task is abandoned.
+func (b *broadcastTask) beginAppend() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.isAbandoned() {
+		return false
+	}
+	b.appending = true
+	return true
+}
+
+// endAppend records the append result of the vchannels.
+func (b *broadcastTask) endAppend(appended []string, failures map[string]error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.appending = false
+	now := time.Now()
+	for _, vchannel := range appended {
+		if p, ok := b.progress[vchannel]; ok {
+			p.appended = true
+			p.appendedAt = now
+			p.lastError = nil
+		}
+	}
+	for vchannel, err := range failures {
+		if p, ok := b.progress[vchannel]; ok {
+			p.lastError = err
+		}
+	}
+}
+
+// setVChannelError records the last error of the vchannel.
+func (b *broadcastTask) setVChannelError(vchannel string, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if p, ok := b.progress[vchannel]; ok {
+		p.lastError = err
+	}
+}
+
+// Info returns the inspection view of the task.
+func (b *broadcastTask) Info(held map[message.ResourceKey]bool) BroadcastTaskInfo {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	info := BroadcastTaskInfo{
+		BroadcastID:    b.header.BroadcastID,
+		MessageType:    b.messageType.String(),
+		State:          b.task.GetState().String(),
+		ResourceKeys:   make([]ResourceKeyInfo, 0, len(b.header.ResourceKeys)),
+		CreatedAt:      b.createdAt,
+		AgeSeconds:     now.Sub(b.createdAt).Seconds(),
+		Appending:      b.appending,
+		TotalVChannels: len(b.header.VChannels),
+		AckedVChannels: ackedCount(b.task),
+		VChannels:      make([]VChannelAckInfo, 0, len(b.header.VChannels)),
+	}
+	if b.isAbandoned() {
+		info.State = "abandoned"
+	}
+	for key := range b.header.ResourceKeys {
+		info.ResourceKeys = append(info.ResourceKeys, ResourceKeyInfo{
+			Domain: key.Domain.String(),
+			Key:    key.Key,
+			Held:   held[key],
+		})
+	}
+	sort.Slice(info.ResourceKeys, func(i, j int) bool {
+		if info.ResourceKeys[i].Domain != info.ResourceKeys[j].Domain {
+			return info.ResourceKeys[i].Domain < info.ResourceKeys[j].Domain
+		}
+		return info.ResourceKeys[i].Key < info.ResourceKeys[j].Key
+	})
+	for idx, vchannel := range b.header.VChannels {
+		p := b.progress[vchannel]
+		vinfo := VChannelAckInfo{
+			VChannel: vchannel,
+			Appended: p.appended,
+			Acked:    b.task.AckedVchannelBitmap[idx] != 0,
+		}
+		if !p.appendedAt.IsZero() {
+			appendedAt := p.appendedAt
+			vinfo.AppendedAt = &appendedAt
+		}
+		if !p.ackedAt.IsZero() {
+			ackedAt := p.ackedAt
+			vinfo.AckedAt = &ackedAt
+		}
+		if !vinfo.Acked {
+			vinfo.UnackedAgeSeconds = info.AgeSeconds
+			if vinfo.AppendedAt != nil {
+				vinfo.UnackedAgeSeconds = now.Sub(*vinfo.AppendedAt).Seconds()
+			}
+		}
+		if p.lastError != nil {
+			vinfo.LastError = p.lastError.Error()
+		}
+		info.VChannels = append(info.VChannels, vinfo)
+	}
+	return info
+}
+
+// appendedButUnackedVChannels returns the vchannels whose message is appended into wal but not acked yet.
+func (b *broadcastTask) appendedButUnackedVChannels() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	vchannels := make([]string, 0)
+	for idx, vchannel := range b.header.VChannels {
+		if b.task.AckedVchannelBitmap[idx] == 0 && b.progress[vchannel].appended {
+			vchannels = append(vchannels, vchannel)
+		}
+	}
+	return vchannels
+}
+
+// RetryAck calls the ack callback of the vchannels whose message is already appended into wal but not acked.
+// The message is persisted in the wal once it's appended, so it's safe to ack it at streamingcoord side,
+// the repeated ack from streamingnode is ignored.
+func (b *broadcastTask) RetryAck(ctx context.Context) error {
+	var errs []error
+	for _, vchannel := range b.appendedButUnackedVChannels() {
+		if err := b.Ack(ctx, vchannel); err != nil {
+			errs = append(errs, errors.Wrapf(err, "retry ack at vchannel %s", vchannel))
+		}
+	}
+	return merr.Combine(errs...)
+}
+
+// Abandon gives up the broadcast task, the unsent messages will never be appended and the ack callbacks of
+// the unacked vchannels will never be called.
+// If any message of the task has been appended into wal, the abandon is rejected unless force is set,
+// because the messages on those vchannels can not be revoked and the operator should repair the state manually.
+func (b *broadcastTask) Abandon(ctx context.Context, force bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.isAbandoned() {
+		return nil
+	}
+	if b.task.GetState() == streamingpb.BroadcastTaskState_BROADCAST_TASK_STATE_DONE {
+		return merr.WrapErrParameterInvalidMsg("broadcast task %d is already done", b.header.BroadcastID)
+	}
+	if !force {
+		if b.appending {
+			return merr.WrapErrParameterInvalidMsg("broadcast task %d is appending messages, retry later or use force", b.header.BroadcastID)
+		}
+		for vchannel, p := range b.progress {
+			if p.appended {
+				return merr.WrapErrParameterInvalidMsg("message of broadcast task %d has been appended into vchannel %s, use force to abandon it", b.header.BroadcastID, vchannel)
+			}
+		}
+	}
+
+	// remove the task from the recovery info by saving it as done.
+	task := proto.Clone(b.task).(*streamingpb.BroadcastTask)
+	task.State = streamingpb.BroadcastTaskState_BROADCAST_TASK_STATE_DONE
+	if err := b.saveTask(ctx, task, b.Logger().With(zap.Bool("abandon", true), zap.Bool("force", force))); err != nil {
+		return err
+	}
+	b.task = task
+	b.recoverPersisted = true
+	close(b.abandoned)
+	b.Logger().Warn("broadcast task is abandoned by operator", zap.Bool("force", force))
+	return nil
+}
@@ -50,6 +50,11 @@ type pendingBroadcastTask struct {
 // Execute can be repeated called until the task is done.
 // Same semantics as the `Poll` operation in eventloop.
 func (b *pendingBroadcastTask) Execute(ctx context.Context, operator AppendOperator) error {
+	if b.broadcastTask.IsAbandoned() {
+		// the task is abandoned by operator, no more message should be appended.
+		b.Logger().Info("broadcast task is abandoned, skip execution")
+		return nil
+	}
 	if err := b.broadcastTask.InitializeRecovery(ctx); err != nil {
 		b.Logger().Warn("broadcast task initialize recovery failed", zap.Error(err))
 		b.UpdateInstantWithNextBackOff()
@@ -57,17 +62,26 @@ func (b *pendingBroadcastTask) Execute(ctx context.Context, operator AppendOpera
 	}
 
 	if len(b.pendingMessages) > 0 {
+		if !b.broadcastTask.beginAppend() {
+			b.Logger().Info("broadcast task is abandoned, skip execution")
+			return nil
+		}
 		b.Logger().Debug("broadcast task is polling to make sent...", zap.Int("pendingMessages", len(b.pendingMessages)))
 		resps := operator.AppendMessages(ctx, b.pendingMessages...)
 		newPendings := make([]message.MutableMessage, 0)
+		appended := make([]string, 0, len(b.pendingMessages))
+		failures := make(map[string]error)
 		for idx, resp := range resps.Responses {
 			if resp.Error != nil {
 				b.Logger().Warn("broadcast task append message failed", zap.Int("idx", idx), zap.Error(resp.Error))
 				newPendings = append(newPendings, b.pendingMessages[idx])
+				failures[b.pendingMessages[idx].VChannel()] = resp.Error
 				continue
 			}
 			b.appendResult[b.pendingMessages[idx].VChannel()] = resp.AppendResult
+			appended = append(appended, b.pendingMessages[idx].VChannel())
 		}
+		b.broadcastTask.endAppend(appended, failures)
 		b.pendingMessages = newPendings
 		if len(newPendings) == 0 {
 			b.future.Set(&types.BroadcastAppendResult{
@@ -90,7 +104,14 @@ func (b *pendingBroadcastTask) Execute(ctx context.Context, operator AppendOpera
 
 // BlockUntilTaskDone blocks until the task is done.
 func (b *pendingBroadcastTask) BlockUntilTaskDone(ctx context.Context) (*types.BroadcastAppendResult, error) {
-	return b.future.GetWithContext(ctx)
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-b.abandoned:
+		return nil, errBroadcastTaskAbandoned
+	case <-b.future.Done():
+		return b.future.Get(), nil
+	}
 }
 
 // pendingBroadcastTaskArray is a heap of pendingBroadcastTask.
@@ -0,0 +1,225 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster"
+	"github.com/milvus-io/milvus/pkg/v2/util/syncutil"
+)
+
+// this file contains streamingcoord management restful API handler
+var mgrRouteRegisterOnce sync.Once
+
+// registerMgrRoute registers the management restful API of streamingcoord.
+// The balancer routes are skipped if the balancer is nil, e.g. the streaming service is not enabled.
+func registerMgrRoute(b *syncutil.Future[balancer.Balancer], bc *syncutil.Future[broadcaster.Broadcaster]) {
+	mgrRouteRegisterOnce.Do(func() {
+		h := &mgrHandler{balancer: b, broadcaster: bc}
+		management.Register(&management.Handler{
+			Path:        management.RouteListBroadcastTasks,
+			HandlerFunc: h.ListBroadcastTasks,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteRetryBroadcastTask,
+			HandlerFunc: h.RetryBroadcastTask,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteAbandonBroadcastTask,
+			HandlerFunc: h.AbandonBroadcastTask,
+		})
+		if b == nil {
+			return
+		}
+		management.Register(&management.Handler{
+			Path:        management.RouteDrainStreamingNode,
+			HandlerFunc: h.DrainStreamingNode,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteUndrainStreamingNode,
+			HandlerFunc: h.UndrainStreamingNode,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteStreamingNodeDrainStatus,
+			HandlerFunc: h.GetDrainStatus,
+		})
+	})
+}
+
+// mgrHandler is the management restful API handler of streamingcoord.
+type mgrHandler struct {
+	balancer    *syncutil.Future[balancer.Balancer]
+	broadcaster *syncutil.Future[broadcaster.Broadcaster]
+}
+
+// DrainStreamingNode marks the streaming node as unschedulable and evacuates the pchannels on it.
+func (h *mgrHandler) DrainStreamingNode(w http.ResponseWriter, req *http.Request) {
+	b, nodeID, ok := h.parseNodeRequest(w, req, "drain streaming node")
+	if !ok {
+		return
+	}
+	if err := b.DrainStreamingNode(req.Context(), nodeID); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drain streaming node, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// UndrainStreamingNode re-admits the draining streaming node into scheduling.
+func (h *mgrHandler) UndrainStreamingNode(w http.ResponseWriter, req *http.Request) {
+	b, nodeID, ok := h.parseNodeRequest(w, req, "undrain streaming node")
+	if !ok {
+		return
+	}
+	if err := b.UndrainStreamingNode(req.Context(), nodeID); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to undrain streaming node, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// GetDrainStatus returns the progress of all draining streaming nodes.
+func (h *mgrHandler) GetDrainStatus(w http.ResponseWriter, req *http.Request) {
+	b, ok := h.getBalancer(w, "get drain status")
+	if !ok {
+		return
+	}
+	statuses, err := b.GetDrainStatus(req.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get drain status, %s"}`, err.Error())))
+		return
+	}
+	bytes, err := json.Marshal(statuses)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get drain status, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// parseNodeRequest parses the node_id of request and returns the balancer.
+func (h *mgrHandler) parseNodeRequest(w http.ResponseWriter, req *http.Request, op string) (balancer.Balancer, int64, bool) {
+	if err := req.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s, %s"}`, op, err.Error())))
+		return nil, 0, false
+	}
+	nodeID, err := strconv.ParseInt(req.FormValue("node_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s, %s"}`, op, err.Error())))
+		return nil, 0, false
+	}
+	b, ok := h.getBalancer(w, op)
+	return b, nodeID, ok
+}
+
+// getBalancer returns the balancer if it's ready.
+func (h *mgrHandler) getBalancer(w http.ResponseWriter, op string) (balancer.Balancer, bool) {
+	if !h.balancer.Ready() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s, balancer is not ready"}`, op)))
+		return nil, false
+	}
+	return h.balancer.Get(), true
+}
+
+// ListBroadcastTasks returns the in-flight broadcast tasks with the ack state of every vchannel.
+func (h *mgrHandler) ListBroadcastTasks(w http.ResponseWriter, req *http.Request) {
+	bc, ok := h.getBroadcaster(w, "list broadcast tasks")
+	if !ok {
+		return
+	}
+	tasks, err := bc.ListTasks(req.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list broadcast tasks, %s"}`, err.Error())))
+		return
+	}
+	bytes, err := json.Marshal(tasks)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list broadcast tasks, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// RetryBroadcastTask retries the stuck broadcast task immediately.
+func (h *mgrHandler) RetryBroadcastTask(w http.ResponseWriter, req *http.Request) {
+	bc, broadcastID, ok := h.parseBroadcastTaskRequest(w, req, "retry broadcast task")
+	if !ok {
+		return
+	}
+	if err := bc.RetryTask(req.Context(), broadcastID); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to retry broadcast task, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// AbandonBroadcastTask gives up the stuck broadcast task and releases its resource keys.
+func (h *mgrHandler) AbandonBroadcastTask(w http.ResponseWriter, req *http.Request) {
+	bc, broadcastID, ok := h.parseBroadcastTaskRequest(w, req, "abandon broadcast task")
+	if !ok {
+		return
+	}
+	force := false
+	if v := req.FormValue("force"); v != "" {
+		var err error
+		if force, err = strconv.ParseBool(v); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to abandon broadcast task, %s"}`, err.Error())))
+			return
+		}
+	}
+	if err := bc.AbandonTask(req.Context(), broadcastID, force); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to abandon broadcast task, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// parseBroadcastTaskRequest parses the broadcast_id of request and returns the broadcaster.
+func (h *mgrHandler) parseBroadcastTaskRequest(w http.ResponseWriter, req *http.Request, op string) (broadcaster.Broadcaster, uint64, bool) {
+	if err := req.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s, %s"}`, op, err.Error())))
+		return nil, 0, false
+	}
+	broadcastID, err := strconv.ParseUint(req.FormValue("broadcast_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s, %s"}`, op, err.Error())))
+		return nil, 0, false
+	}
+	bc, ok := h.getBroadcaster(w, op)
+	return bc, broadcastID, ok
+}
+
+// getBroadcaster returns the broadcaster if it's ready.
+func (h *mgrHandler) getBroadcaster(w http.ResponseWriter, op string) (broadcaster.Broadcaster, bool) {
+	if !h.broadcaster.Ready() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s, broadcaster is not ready"}`, op)))
+		return nil, false
+	}
+	return h.broadcaster.Get(), true
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/mocks/streamingcoord/server/mock_balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/pkg/v2/util/syncutil"
+)
+
+func TestMgrHandlerDrain(t *testing.T) {
+	f := syncutil.NewFuture[balancer.Balancer]()
+	h := &mgrHandler{balancer: f}
+
+	serve := func(handler http.HandlerFunc, method string, url string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(method, url, nil))
+		return w
+	}
+
+	// balancer is not ready.
+	w := serve(h.DrainStreamingNode, http.MethodPost, "/management/streamingcoord/node/drain?node_id=1")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	w = serve(h.UndrainStreamingNode, http.MethodPost, "/management/streamingcoord/node/undrain?node_id=1")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	w = serve(h.GetDrainStatus, http.MethodGet, "/management/streamingcoord/node/drain/status")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	b := mock_balancer.NewMockBalancer(t)
+	f.Set(b)
+
+	// invalid node id.
+	w = serve(h.DrainStreamingNode, http.MethodPost, "/management/streamingcoord/node/drain")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = serve(h.UndrainStreamingNode, http.MethodPost, "/management/streamingcoord/node/undrain?node_id=abc")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// drain.
+	b.EXPECT().DrainStreamingNode(mock.Anything, int64(1)).Return(nil).Once()
+	w = serve(h.DrainStreamingNode, http.MethodPost, "/management/streamingcoord/node/drain?node_id=1")
+	assert.Equal(t, http.StatusOK, w.Code)
+	b.EXPECT().DrainStreamingNode(mock.Anything, int64(2)).Return(errors.New("node not found")).Once()
+	w = serve(h.DrainStreamingNode, http.MethodPost, "/management/streamingcoord/node/drain?node_id=2")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.True(t, strings.Contains(w.Body.String(), "node not found"))
+
+	// drain status.
+	startedAt := time.Now().Truncate(time.Second)
+	b.EXPECT().GetDrainStatus(mock.Anything).Return([]balancer.DrainStatus{{
+		ServerID:           1,
+		State:              balancer.DrainStateDraining,
+		TotalChannels:      2,
+		RemainingChannels:  1,
+		RemainingPChannels: []string{"pchannel-1"},
+		StartedAt:          startedAt,
+	}}, nil).Once()
+	w = serve(h.GetDrainStatus, http.MethodGet, "/management/streamingcoord/node/drain/status")
+	assert.Equal(t, http.StatusOK, w.Code)
+	var statuses []balancer.DrainStatus
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &statuses))
+	assert.Len(t, statuses, 1)
+	assert.Equal(t, int64(1), statuses[0].ServerID)
+	assert.Equal(t, balancer.DrainStateDraining, statuses[0].State)
+	assert.Equal(t, []string{"pchannel-1"}, statuses[0].RemainingPChannels)
+	assert.True(t, startedAt.Equal(statuses[0].StartedAt))
+	b.EXPECT().GetDrainStatus(mock.Anything).Return(nil, errors.New("balancer closed")).Once()
+	w = serve(h.GetDrainStatus, http.MethodGet, "/management/streamingcoord/node/drain/status")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	// undrain.
+	b.EXPECT().UndrainStreamingNode(mock.Anything, int64(1)).Return(nil).Once()
+	w = serve(h.UndrainStreamingNode, http.MethodPost, "/management/streamingcoord/node/undrain?node_id=1")
+	assert.Equal(t, http.StatusOK, w.Code)
+	b.EXPECT().UndrainStreamingNode(mock.Anything, int64(1)).Return(errors.New("not draining")).Once()
+	w = serve(h.UndrainStreamingNode, http.MethodPost, "/management/streamingcoord/node/undrain?node_id=1")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
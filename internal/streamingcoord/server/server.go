@@ -45,6 +45,11 @@ func (s *Server) Start(ctx context.Context) (err error) {
 		s.logger.Warn("init basic component of streamingcoord failed", zap.Error(err))
 		return err
 	}
+	if streamingutil.IsStreamingServiceEnabled() {
+		registerMgrRoute(s.balancer, s.broadcaster)
+	} else {
+		registerMgrRoute(nil, s.broadcaster)
+	}
 	// Init all grpc service of streamingcoord server.
 	s.logger.Info("streamingcoord initialized")
 	return nil
@@ -152,7 +152,7 @@ func (impl *WALFlusherImpl) buildFlusherComponents(ctx context.Context, l wal.WA
 
 	cpUpdater := util.NewChannelCheckpointUpdaterWithCallback(broker, func(mp *msgpb.MsgPosition) {
 		messageID := adaptor.MustGetMessageIDFromMQWrapperIDBytes(l.WALName(), mp.MsgID)
-		impl.RecoveryStorage.UpdateFlusherCheckpoint(&recovery.WALCheckpoint{
+		impl.RecoveryStorage.UpdateFlusherCheckpoint(mp.GetChannelName(), &recovery.WALCheckpoint{
 			MessageID: messageID,
 			TimeTick:  mp.Timestamp,
 			Magic:     recovery.RecoveryMagicStreamingInitialized,
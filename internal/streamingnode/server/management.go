@@ -0,0 +1,59 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/recovery"
+)
+
+// this file contains streamingnode management restful API handler
+var mgrRouteRegisterOnce sync.Once
+
+// registerMgrRoute registers the management restful API of streamingnode.
+func registerMgrRoute() {
+	mgrRouteRegisterOnce.Do(func() {
+		management.Register(&management.Handler{
+			Path:        management.RouteWALRetention,
+			HandlerFunc: GetWALRetention,
+		})
+	})
+}
+
+// GetWALRetention returns the retention and backlog of all wal and vchannels on current streaming node.
+// The vchannel can be filtered by the `vchannel` parameter.
+func GetWALRetention(w http.ResponseWriter, req *http.Request) {
+	if err := req.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get wal retention, %s"}`, err.Error())))
+		return
+	}
+	retentions := recovery.GetWALRetentions()
+	if vchannel := req.FormValue("vchannel"); vchannel != "" {
+		filtered := make([]recovery.WALRetention, 0, 1)
+		for _, retention := range retentions {
+			vchannels := make([]recovery.VChannelRetention, 0, 1)
+			for _, v := range retention.VChannels {
+				if v.VChannel == vchannel {
+					vchannels = append(vchannels, v)
+				}
+			}
+			if len(vchannels) > 0 {
+				retention.VChannels = vchannels
+				filtered = append(filtered, retention)
+			}
+		}
+		retentions = filtered
+	}
+	bytes, err := json.Marshal(retentions)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get wal retention, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
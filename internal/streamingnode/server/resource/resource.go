@@ -1,10 +1,13 @@
 package resource
 
 import (
+	"context"
 	"reflect"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/flushcommon/syncmgr"
 	"github.com/milvus-io/milvus/internal/flushcommon/writebuffer"
 	"github.com/milvus-io/milvus/internal/metastore"
@@ -15,6 +18,9 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/idalloc"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/syncutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
@@ -72,6 +78,7 @@ func Init(opts ...optResourceInit) {
 
 	newR.logger = log.With(log.FieldModule(typeutil.StreamingNodeRole))
 	newR.segmentStatsManager = stats.NewStatsManager()
+	newR.segmentStatsManager.SetCollectionPropertiesFetcher(newCollectionPropertiesFetcher(newR.mixCoordClient))
 	newR.timeTickInspector = tinspector.NewTimeTickSyncInspector()
 	newR.syncMgr = syncmgr.NewSyncManager(newR.chunkManager)
 	newR.wbMgr = writebuffer.NewManager(newR.syncMgr)
@@ -87,6 +94,27 @@ func Init(opts ...optResourceInit) {
 	r = newR
 }
 
+// newCollectionPropertiesFetcher creates a fetcher to get the collection properties from the coordinator.
+func newCollectionPropertiesFetcher(mix *syncutil.Future[types.MixCoordClient]) stats.CollectionPropertiesFetcher {
+	return func(ctx context.Context, collectionID int64) ([]*commonpb.KeyValuePair, error) {
+		mixCoord, err := mix.GetWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := mixCoord.DescribeCollectionInternal(ctx, &milvuspb.DescribeCollectionRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			CollectionID: collectionID,
+		})
+		if err := merr.CheckRPCCall(resp, err); err != nil {
+			return nil, err
+		}
+		return resp.GetProperties(), nil
+	}
+}
+
 // Release releases the singleton of resources.
 func Release() {
 	r.wbMgr.Stop()
@@ -0,0 +1,66 @@
+package policy
+
+import (
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+)
+
+// CollectionSealConfig is the segment seal config of a collection set by the collection properties.
+// The zero value of a field means using the global config.
+type CollectionSealConfig struct {
+	MaxSegmentSize        uint64        // max binary size of a growing segment in bytes.
+	MaxIdleTime           time.Duration // seal the segment if no data is written in the duration.
+	MaxLifetime           time.Duration // seal the segment if it's created longer than the duration.
+	SealPartitionTogether bool          // seal all growing segments of the partition together.
+}
+
+// IsZero returns true if no config is set by the collection.
+func (c CollectionSealConfig) IsZero() bool {
+	return c == CollectionSealConfig{}
+}
+
+// ParseCollectionSealConfig parses the seal config from the collection properties.
+// The invalid properties are ignored, so a bad property never blocks the writing of collection.
+func ParseCollectionSealConfig(collectionID int64, props []*commonpb.KeyValuePair) CollectionSealConfig {
+	cfg := CollectionSealConfig{}
+	for _, kv := range props {
+		switch kv.GetKey() {
+		case common.CollectionSegmentMaxSizeKey:
+			if size, ok := parsePositiveInt(collectionID, kv); ok {
+				cfg.MaxSegmentSize = uint64(size) * 1024 * 1024
+			}
+		case common.CollectionSegmentMaxIdleTimeKey:
+			if seconds, ok := parsePositiveInt(collectionID, kv); ok {
+				cfg.MaxIdleTime = time.Duration(seconds) * time.Second
+			}
+		case common.CollectionSegmentMaxLifetimeKey:
+			if seconds, ok := parsePositiveInt(collectionID, kv); ok {
+				cfg.MaxLifetime = time.Duration(seconds) * time.Second
+			}
+		case common.CollectionSegmentSealPolicyKey:
+			if kv.GetValue() == common.SegmentSealPolicyPartition {
+				cfg.SealPartitionTogether = true
+			} else {
+				log.Warn("ignore unknown segment seal policy of collection",
+					zap.Int64("collectionID", collectionID), zap.String("policy", kv.GetValue()))
+			}
+		}
+	}
+	return cfg
+}
+
+func parsePositiveInt(collectionID int64, kv *commonpb.KeyValuePair) (int64, bool) {
+	v, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+	if err != nil || v <= 0 {
+		log.Warn("ignore invalid segment seal property of collection",
+			zap.Int64("collectionID", collectionID), zap.String("key", kv.GetKey()), zap.String("value", kv.GetValue()))
+		return 0, false
+	}
+	return v, true
+}
@@ -0,0 +1,38 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+)
+
+func TestParseCollectionSealConfig(t *testing.T) {
+	cfg := ParseCollectionSealConfig(1, nil)
+	assert.True(t, cfg.IsZero())
+
+	cfg = ParseCollectionSealConfig(1, []*commonpb.KeyValuePair{
+		{Key: common.CollectionSegmentMaxSizeKey, Value: "256"},
+		{Key: common.CollectionSegmentMaxIdleTimeKey, Value: "60"},
+		{Key: common.CollectionSegmentMaxLifetimeKey, Value: "3600"},
+		{Key: common.CollectionSegmentSealPolicyKey, Value: common.SegmentSealPolicyPartition},
+		{Key: common.CollectionTTLConfigKey, Value: "10"},
+	})
+	assert.Equal(t, CollectionSealConfig{
+		MaxSegmentSize:        256 * 1024 * 1024,
+		MaxIdleTime:           time.Minute,
+		MaxLifetime:           time.Hour,
+		SealPartitionTogether: true,
+	}, cfg)
+
+	// invalid properties are ignored.
+	cfg = ParseCollectionSealConfig(1, []*commonpb.KeyValuePair{
+		{Key: common.CollectionSegmentMaxSizeKey, Value: "-1"},
+		{Key: common.CollectionSegmentMaxIdleTimeKey, Value: "abc"},
+		{Key: common.CollectionSegmentSealPolicyKey, Value: "unknown"},
+	})
+	assert.True(t, cfg.IsZero())
+}
@@ -17,6 +17,7 @@ var (
 	PolicyNameIdle                   PolicyName = "idle"
 	PolicyNameGrowingSegmentBytesHWM PolicyName = "growing_bytes_hwm"
 	PolicyNameNodeMemory             PolicyName = "node_memory"
+	PolicyNamePartition              PolicyName = "partition"
 )
 
 // PolicyPartitionNotFound returns a SealPolicy for partition not found.
@@ -103,6 +104,17 @@ func PolicyNodeMemory(usedRatio float64) SealPolicy {
 	}
 }
 
+// PolicyPartition returns a SealPolicy to seal all growing segments of the partition together,
+// the trigger is the policy that makes one of the segments be sealed.
+func PolicyPartition(trigger SealPolicy) SealPolicy {
+	return SealPolicy{
+		Policy: PolicyNamePartition,
+		Extra: sealByPartitionExtraInfo{
+			Trigger: trigger,
+		},
+	}
+}
+
 // PolicyRecover returns a SealPolicy for recover.
 type SealPolicy struct {
 	Policy PolicyName
@@ -138,3 +150,8 @@ type sealByIdleTimeExtraInfo struct {
 	IdleTime    time.Duration
 	MinimalSize uint64
 }
+
+// sealByPartitionExtraInfo is the extra info of the seal by partition policy.
+type sealByPartitionExtraInfo struct {
+	Trigger SealPolicy
+}
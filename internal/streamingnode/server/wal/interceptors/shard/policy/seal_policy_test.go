@@ -138,3 +138,17 @@ func TestPolicyNodeMemory(t *testing.T) {
 		t.Errorf("expected used ratio %f, got %f", usedRatio, extra.UsedRatio)
 	}
 }
+
+func TestPolicyPartition(t *testing.T) {
+	policy := PolicyPartition(PolicyCapacity())
+	if policy.Policy != PolicyNamePartition {
+		t.Errorf("expected policy name %s, got %s", PolicyNamePartition, policy.Policy)
+	}
+	extra, ok := policy.Extra.(sealByPartitionExtraInfo)
+	if !ok {
+		t.Errorf("expected extra to be of type sealByPartitionExtraInfo, got %T", policy.Extra)
+	}
+	if extra.Trigger.Policy != PolicyNameCapacity {
+		t.Errorf("expected trigger policy %s, got %s", PolicyNameCapacity, extra.Trigger.Policy)
+	}
+}
@@ -170,18 +170,31 @@ func (m *partitionManager) AsyncFlushSegment(signal utils.SealSegmentSignal) err
 		return ErrSegmentNotFound
 	}
 
-	if !sm.IsFlushed() {
-		sm.Flush(signal.SealPolicy)
-		m.metrics.ObserveSegmentFlushed(
-			string(sm.SealPolicy().Policy),
-			int64(sm.GetFlushedStat().Insert.Rows),
-			int64(sm.GetFlushedStat().Insert.BinarySize),
-		)
-		m.asyncFlushSegment(m.ctx, sm)
+	if signal.SealPolicy.Policy == policy.PolicyNamePartition {
+		// seal all the growing segments of the partition together.
+		for _, sm := range m.segments {
+			m.asyncFlushSegmentIfNotFlushed(sm, signal.SealPolicy)
+		}
+		return nil
 	}
+	m.asyncFlushSegmentIfNotFlushed(sm, signal.SealPolicy)
 	return nil
 }
 
+// asyncFlushSegmentIfNotFlushed flushes the segment with the policy if it's not flushed.
+func (m *partitionManager) asyncFlushSegmentIfNotFlushed(sm *segmentAllocManager, sealPolicy policy.SealPolicy) {
+	if sm.IsFlushed() {
+		return
+	}
+	sm.Flush(sealPolicy)
+	m.metrics.ObserveSegmentFlushed(
+		string(sm.SealPolicy().Policy),
+		int64(sm.GetFlushedStat().Insert.Rows),
+		int64(sm.GetFlushedStat().Insert.BinarySize),
+	)
+	m.asyncFlushSegment(m.ctx, sm)
+}
+
 // MustRemoveFlushedSegment removes the flushed segment from the segment manager.
 func (m *partitionManager) MustRemoveFlushedSegment(segmentID int64) {
 	if !m.segments[segmentID].IsFlushed() {
@@ -185,6 +185,63 @@ func TestPartitionManager(t *testing.T) {
 	<-m.WaitPendingGrowingSegmentReady()
 }
 
+func TestPartitionManagerSealPartitionTogether(t *testing.T) {
+	paramtable.Init()
+	resource.InitForTest(t)
+	channel := types.PChannelInfo{
+		Name: "test_channel",
+		Term: 1,
+	}
+	o := mock_utils.NewMockSealOperator(t)
+	o.EXPECT().Channel().Return(channel)
+	resource.Resource().SegmentStatsManager().RegisterSealOperator(o, nil, nil)
+
+	segments := make(map[int64]*segmentAllocManager)
+	for _, segmentID := range []int64{1003, 1004} {
+		segments[segmentID] = newTestSegmentAllocManager(channel, &messagespb.CreateSegmentMessageHeader{
+			CollectionId:   1,
+			PartitionId:    2,
+			SegmentId:      segmentID,
+			StorageVersion: 2,
+			MaxSegmentSize: 150,
+		}, 120)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := mock_wal.NewMockWAL(t)
+	w.EXPECT().Available().RunAndReturn(func() <-chan struct{} {
+		return make(chan struct{})
+	}).Maybe()
+	f := syncutil.NewFuture[wal.WAL]()
+	f.Set(w)
+	m := newPartitionSegmentManager(ctx, log.With(), f, channel, "v1", 1, 2, segments, &mockedTxnManager{}, 100, metricsutil.NewSegmentAssignMetrics(channel.Name))
+
+	flushed := make(chan int64, 2)
+	w.EXPECT().Append(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, msg message.MutableMessage) (*types.AppendResult, error) {
+			flushMsg := message.MustAsMutableFlushMessageV2(msg)
+			flushed <- flushMsg.Header().SegmentId
+			return &types.AppendResult{
+				MessageID: rmq.NewRmqID(20),
+				TimeTick:  200,
+			}, nil
+		})
+
+	err := m.AsyncFlushSegment(utils.SealSegmentSignal{
+		SegmentBelongs: utils.SegmentBelongs{
+			SegmentID: 1003,
+		},
+		SealPolicy: policy.PolicyPartition(policy.PolicyCapacity()),
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1003, 1004}, []int64{<-flushed, <-flushed})
+	for _, sm := range segments {
+		assert.True(t, sm.IsFlushed())
+		assert.Equal(t, policy.PolicyNamePartition, sm.SealPolicy().Policy)
+	}
+}
+
 type mockedTxnManager struct{}
 
 func (m *mockedTxnManager) RecoverDone() <-chan struct{} {
@@ -112,7 +112,8 @@ func (w *segmentAllocWorker) generateNewGrowingSegmentMessage() error {
 		storageVersion = storage.StorageV2
 	}
 	// Getnerate growing segment limitation.
-	limitation := getSegmentLimitationPolicy().GenerateLimitation()
+	sealConfig := resource.Resource().SegmentStatsManager().GetCollectionSealConfig(w.ctx, w.collectionID)
+	limitation := getSegmentLimitationPolicy().GenerateLimitation(sealConfig)
 	// Create a new segment by sending a create segment message into wal directly.
 	w.msg = message.NewCreateSegmentMessageBuilderV2().
 		WithVChannel(w.vchannel).
@@ -3,6 +3,7 @@ package shards
 import (
 	"math/rand"
 
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/shard/policy"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
@@ -22,7 +23,8 @@ type segmentLimitation struct {
 // SegmentLimitationPolicy is the interface to generate the limitation of the segment.
 type SegmentLimitationPolicy interface {
 	// GenerateLimitation generates the limitation of the segment.
-	GenerateLimitation() segmentLimitation
+	// The max segment size of the collection seal config overrides the global one if set.
+	GenerateLimitation(cfg policy.CollectionSealConfig) segmentLimitation
 }
 
 // jitterSegmentLimitationPolicyExtraInfo is the extra info of the jitter segment limitation policy.
@@ -38,7 +40,7 @@ type jitterSegmentLimitationPolicyExtraInfo struct {
 type jitterSegmentLimitationPolicy struct{}
 
 // GenerateLimitation generates the limitation of the segment.
-func (p jitterSegmentLimitationPolicy) GenerateLimitation() segmentLimitation {
+func (p jitterSegmentLimitationPolicy) GenerateLimitation(cfg policy.CollectionSealConfig) segmentLimitation {
 	// TODO: It's weird to set such a parameter into datacoord configuration.
 	// Refactor it in the future
 	jitter := paramtable.Get().DataCoordCfg.SegmentSealProportionJitter.GetAsFloat()
@@ -47,6 +49,9 @@ func (p jitterSegmentLimitationPolicy) GenerateLimitation() segmentLimitation {
 		jitterRatio = 1
 	}
 	maxSegmentSize := uint64(paramtable.Get().DataCoordCfg.SegmentMaxSize.GetAsInt64() * 1024 * 1024)
+	if cfg.MaxSegmentSize > 0 {
+		maxSegmentSize = cfg.MaxSegmentSize
+	}
 	proportion := paramtable.Get().DataCoordCfg.SegmentSealProportion.GetAsFloat()
 	segmentSize := uint64(jitterRatio * float64(maxSegmentSize) * proportion)
 	return segmentLimitation{
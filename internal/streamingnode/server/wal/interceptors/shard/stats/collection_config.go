@@ -0,0 +1,107 @@
+package stats
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/shard/policy"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+)
+
+var collectionPropertiesFetchTimeout = 5 * time.Second
+
+// CollectionPropertiesFetcher fetches the properties of the collection from the coordinator.
+type CollectionPropertiesFetcher func(ctx context.Context, collectionID int64) ([]*commonpb.KeyValuePair, error)
+
+// newCollectionSealConfigs creates a new collection seal config cache.
+func newCollectionSealConfigs() *collectionSealConfigs {
+	return &collectionSealConfigs{
+		configs: make(map[int64]policy.CollectionSealConfig),
+	}
+}
+
+// collectionSealConfigs caches the seal configs of the collections which have growing segments on current node.
+// The cache is refreshed by the seal worker, so the altered collection properties take effect in a timer interval.
+type collectionSealConfigs struct {
+	mu      sync.Mutex
+	fetcher CollectionPropertiesFetcher
+	configs map[int64]policy.CollectionSealConfig
+}
+
+// setFetcher sets the fetcher of collection properties.
+func (c *collectionSealConfigs) setFetcher(fetcher CollectionPropertiesFetcher) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fetcher = fetcher
+}
+
+// getCached returns the cached config of the collection, never blocks.
+func (c *collectionSealConfigs) getCached(collectionID int64) policy.CollectionSealConfig {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.configs[collectionID]
+}
+
+// get returns the config of the collection, fetches it from the coordinator if not cached.
+func (c *collectionSealConfigs) get(ctx context.Context, collectionID int64) policy.CollectionSealConfig {
+	c.mu.Lock()
+	cfg, ok := c.configs[collectionID]
+	c.mu.Unlock()
+	if ok {
+		return cfg
+	}
+	cfg, ok = c.fetch(ctx, collectionID)
+	if !ok {
+		return cfg
+	}
+	c.mu.Lock()
+	c.configs[collectionID] = cfg
+	c.mu.Unlock()
+	return cfg
+}
+
+// refresh fetches the configs of the given collections and drops the others from the cache.
+// The cached config is kept if the fetch fails.
+func (c *collectionSealConfigs) refresh(collectionIDs []int64) {
+	configs := make(map[int64]policy.CollectionSealConfig, len(collectionIDs))
+	c.mu.Lock()
+	for _, collectionID := range collectionIDs {
+		if cfg, ok := c.configs[collectionID]; ok {
+			configs[collectionID] = cfg
+		}
+	}
+	c.mu.Unlock()
+
+	for _, collectionID := range collectionIDs {
+		ctx, cancel := context.WithTimeout(context.Background(), collectionPropertiesFetchTimeout)
+		cfg, ok := c.fetch(ctx, collectionID)
+		cancel()
+		if ok {
+			configs[collectionID] = cfg
+		}
+	}
+
+	c.mu.Lock()
+	c.configs = configs
+	c.mu.Unlock()
+}
+
+// fetch fetches the properties of the collection and parses the seal config from it.
+func (c *collectionSealConfigs) fetch(ctx context.Context, collectionID int64) (policy.CollectionSealConfig, bool) {
+	c.mu.Lock()
+	fetcher := c.fetcher
+	c.mu.Unlock()
+	if fetcher == nil {
+		return policy.CollectionSealConfig{}, false
+	}
+	props, err := fetcher(ctx, collectionID)
+	if err != nil {
+		log.Warn("failed to fetch collection properties for seal config", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return policy.CollectionSealConfig{}, false
+	}
+	return policy.ParseCollectionSealConfig(collectionID, props), true
+}
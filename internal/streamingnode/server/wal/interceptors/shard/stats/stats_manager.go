@@ -1,11 +1,13 @@
 package stats
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/shard/policy"
@@ -43,6 +45,8 @@ type StatsManager struct {
 	pchannelIndex map[string]map[int64]struct{} // map[PChannel]SegmentID
 	sealOperators map[string]SealOperator
 	metricHelper  *metricsHelper
+
+	collectionConfigs *collectionSealConfigs
 }
 
 // sealSegmentIDWithPolicy is the struct that contains the segment ID and the seal policy.
@@ -68,12 +72,26 @@ func NewStatsManager() *StatsManager {
 		pchannelIndex: make(map[string]map[int64]struct{}),
 		sealOperators: make(map[string]SealOperator),
 		metricHelper:  newMetricsHelper(),
+
+		collectionConfigs: newCollectionSealConfigs(),
 	}
 	m.worker = newSealWorker(m)
 	go m.worker.loop()
 	return m
 }
 
+// SetCollectionPropertiesFetcher sets the fetcher of collection properties,
+// the seal configs of collection properties are ignored if the fetcher is not set.
+func (m *StatsManager) SetCollectionPropertiesFetcher(fetcher CollectionPropertiesFetcher) {
+	m.collectionConfigs.setFetcher(fetcher)
+}
+
+// GetCollectionSealConfig returns the seal config of the collection set by the collection properties.
+// It may fetch the collection properties from the coordinator, so it should not be called in the wal append path.
+func (m *StatsManager) GetCollectionSealConfig(ctx context.Context, collectionID int64) policy.CollectionSealConfig {
+	return m.collectionConfigs.get(ctx, collectionID)
+}
+
 // RegisterSealOperator registers a seal operator and current growing segments related to the seal operator.
 // It will perform an atomic operation to register the seal operator and segments into the manager.
 func (m *StatsManager) RegisterSealOperator(sealOperator SealOperator, belongs []SegmentBelongs, stats []*SegmentStats) {
@@ -334,18 +352,38 @@ func (m *StatsManager) selectSegmentsWithTimePolicy() map[int64]policy.SealPolic
 	now := time.Now()
 	sealSegmentIDs := make(map[int64]policy.SealPolicy, 0)
 	for segmentID, stat := range m.segmentStats {
-		if now.Sub(stat.CreateTime) > m.cfg.maxLifetime {
-			sealSegmentIDs[segmentID] = policy.PolicyLifetime(m.cfg.maxLifetime)
+		maxLifetime, maxIdleTime := m.cfg.maxLifetime, m.cfg.maxIdleTime
+		collectionCfg := m.collectionConfigs.getCached(m.segmentIndex[segmentID].CollectionID)
+		if collectionCfg.MaxLifetime > 0 {
+			maxLifetime = collectionCfg.MaxLifetime
+		}
+		if collectionCfg.MaxIdleTime > 0 {
+			maxIdleTime = collectionCfg.MaxIdleTime
+		}
+		if now.Sub(stat.CreateTime) > maxLifetime {
+			sealSegmentIDs[segmentID] = policy.PolicyLifetime(maxLifetime)
 			continue
 		}
-		if stat.Insert.BinarySize > uint64(m.cfg.minSizeFromIdleTime) && now.Sub(stat.LastModifiedTime) > m.cfg.maxIdleTime {
-			sealSegmentIDs[segmentID] = policy.PolicyIdle(m.cfg.maxIdleTime, uint64(m.cfg.minSizeFromIdleTime))
+		if stat.Insert.BinarySize > uint64(m.cfg.minSizeFromIdleTime) && now.Sub(stat.LastModifiedTime) > maxIdleTime {
+			sealSegmentIDs[segmentID] = policy.PolicyIdle(maxIdleTime, uint64(m.cfg.minSizeFromIdleTime))
 			continue
 		}
 	}
 	return sealSegmentIDs
 }
 
+// refreshCollectionSealConfigs refreshes the seal configs of the collections which have growing segments.
+func (m *StatsManager) refreshCollectionSealConfigs() {
+	m.mu.Lock()
+	collectionIDs := make(map[int64]struct{})
+	for _, belongs := range m.segmentIndex {
+		collectionIDs[belongs.CollectionID] = struct{}{}
+	}
+	m.mu.Unlock()
+
+	m.collectionConfigs.refresh(lo.Keys(collectionIDs))
+}
+
 // selectSegmentsUntilLessThanLWM selects segments until the total size is less than the threshold.
 func (m *StatsManager) selectSegmentsUntilLessThanLWM() []int64 {
 	m.mu.Lock()
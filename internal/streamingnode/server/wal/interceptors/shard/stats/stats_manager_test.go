@@ -1,18 +1,23 @@
 package stats
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/atomic"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/mocks/streamingnode/server/wal/interceptors/shard/mock_utils"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/shard/policy"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/shard/utils"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -156,6 +161,16 @@ func TestConcurrentStasManager(t *testing.T) {
 	params.Save(params.DataCoordCfg.SegmentMaxIdleTime.Key, "0.1")
 	params.Save(params.DataCoordCfg.SegmentMinSizeFromIdleToSealed.Key, "1024")
 	defaultSealWorkerTimerInterval = 10 * time.Millisecond
+	defer func() {
+		params.Reset(params.DataCoordCfg.SegmentMaxBinlogFileNumber.Key)
+		params.Reset(params.StreamingCfg.FlushMemoryThreshold.Key)
+		params.Reset(params.StreamingCfg.FlushGrowingSegmentBytesHwmThreshold.Key)
+		params.Reset(params.StreamingCfg.FlushGrowingSegmentBytesLwmThreshold.Key)
+		params.Reset(params.DataCoordCfg.SegmentMaxLifetime.Key)
+		params.Reset(params.DataCoordCfg.SegmentMaxIdleTime.Key)
+		params.Reset(params.DataCoordCfg.SegmentMinSizeFromIdleToSealed.Key)
+		defaultSealWorkerTimerInterval = 1 * time.Minute
+	}()
 
 	m := NewStatsManager()
 
@@ -253,3 +268,56 @@ func createSegmentStats(row uint64, binarySize uint64, maxBinarSize uint64) *Seg
 		BinLogCounter:    0,
 	}
 }
+
+func TestCollectionSealConfig(t *testing.T) {
+	paramtable.Init()
+	m := NewStatsManager()
+	// no fetcher, use the global config.
+	assert.True(t, m.GetCollectionSealConfig(context.Background(), 1).IsZero())
+
+	fetched := atomic.NewInt32(0)
+	m.SetCollectionPropertiesFetcher(func(ctx context.Context, collectionID int64) ([]*commonpb.KeyValuePair, error) {
+		fetched.Inc()
+		if collectionID != 1 {
+			return nil, errors.New("mock")
+		}
+		return []*commonpb.KeyValuePair{
+			{Key: common.CollectionSegmentMaxLifetimeKey, Value: "1"},
+			{Key: common.CollectionSegmentSealPolicyKey, Value: common.SegmentSealPolicyPartition},
+		}, nil
+	})
+	cfg := m.GetCollectionSealConfig(context.Background(), 1)
+	assert.Equal(t, time.Second, cfg.MaxLifetime)
+	assert.True(t, cfg.SealPartitionTogether)
+	// cached.
+	m.GetCollectionSealConfig(context.Background(), 1)
+	assert.EqualValues(t, 1, fetched.Load())
+	assert.True(t, m.GetCollectionSealConfig(context.Background(), 2).IsZero())
+
+	sealOperator := mock_utils.NewMockSealOperator(t)
+	sealOperator.EXPECT().Channel().Return(types.PChannelInfo{Name: "pchannel"})
+	m.RegisterSealOperator(sealOperator, nil, nil)
+	stats := createSegmentStats(100, 100, 300)
+	stats.CreateTime = time.Now().Add(-2 * time.Second)
+	m.RegisterNewGrowingSegment(SegmentBelongs{PChannel: "pchannel", VChannel: "vchannel", CollectionID: 1, PartitionID: 2, SegmentID: 3}, stats)
+	stats = createSegmentStats(100, 100, 300)
+	stats.CreateTime = time.Now().Add(-2 * time.Second)
+	m.RegisterNewGrowingSegment(SegmentBelongs{PChannel: "pchannel", VChannel: "vchannel2", CollectionID: 2, PartitionID: 4, SegmentID: 5}, stats)
+
+	// only the segment of collection 1 exceeds its own lifetime.
+	m.refreshCollectionSealConfigs()
+	policies := m.selectSegmentsWithTimePolicy()
+	assert.Len(t, policies, 1)
+	assert.Equal(t, policy.PolicyNameLifetime, policies[3].Policy)
+
+	// the partition policy is applied to the seal signal.
+	sealOperator.EXPECT().AsyncFlushSegment(mock.Anything).Run(func(signal utils.SealSegmentSignal) {
+		assert.Equal(t, policy.PolicyNamePartition, signal.SealPolicy.Policy)
+	}).Once()
+	m.worker.asyncMustSealSegment(3, policy.PolicyCapacity())
+
+	// the config of collection without growing segments is dropped.
+	m.UnregisterSealedSegment(3)
+	m.refreshCollectionSealConfigs()
+	assert.True(t, m.collectionConfigs.getCached(1).IsZero())
+}
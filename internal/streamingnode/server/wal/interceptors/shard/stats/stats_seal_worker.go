@@ -24,6 +24,7 @@ func newSealWorker(statsManager *StatsManager) *sealWorker {
 		sealNotifier:            make(chan sealSegmentIDWithPolicy, 100),
 		growingBytesNotifier:    syncutil.NewCooldownNotifier[uint64](growingBytesNotifyCooldown, 100),
 		timePolicyCheckInterval: defaultSealWorkerTimerInterval,
+		configRefreshNotifier:   make(chan struct{}, 1),
 	}
 	return w
 }
@@ -35,6 +36,7 @@ type sealWorker struct {
 	sealNotifier            chan sealSegmentIDWithPolicy
 	growingBytesNotifier    *syncutil.CooldownNotifier[uint64]
 	timePolicyCheckInterval time.Duration
+	configRefreshNotifier   chan struct{}
 }
 
 // NotifySealSegment is used to notify the seal worker to seal the segment.
@@ -73,6 +75,7 @@ func (m *sealWorker) loop() {
 		hardware.UnregisterSystemMetricsListener(listener)
 	}()
 	hardware.RegisterSystemMetricsListener(listener)
+	go m.configRefreshLoop()
 
 	for {
 		select {
@@ -81,6 +84,7 @@ func (m *sealWorker) loop() {
 			m.asyncMustSealSegment(targetSegment.segmentID, targetSegment.sealPolicy)
 		case <-timer.C:
 			m.statsManager.updateConfig()
+			m.notifyToRefreshCollectionSealConfigs()
 			m.notifyToSealSegmentWithTimePolicy()
 		case policy := <-memoryNotifier:
 			m.statsManager.updateConfig()
@@ -92,6 +96,24 @@ func (m *sealWorker) loop() {
 	}
 }
 
+// notifyToRefreshCollectionSealConfigs notifies the refresh loop to refresh the collection seal configs.
+// The refresh fetches the collection properties by rpc, so it's done in another goroutine to avoid blocking the seal loop,
+// the time policy uses the configs refreshed at the last round.
+func (m *sealWorker) notifyToRefreshCollectionSealConfigs() {
+	select {
+	case m.configRefreshNotifier <- struct{}{}:
+	default:
+		// the refresh in progress is not finished, skip the repeated notify.
+	}
+}
+
+// configRefreshLoop refreshes the collection seal configs when notified.
+func (m *sealWorker) configRefreshLoop() {
+	for range m.configRefreshNotifier {
+		m.statsManager.refreshCollectionSealConfigs()
+	}
+}
+
 // notifyToSealSegmentWithTimePolicy notifies to seal segments with time policy.
 func (m *sealWorker) notifyToSealSegmentWithTimePolicy() {
 	sealSegmentIDs := m.statsManager.selectSegmentsWithTimePolicy()
@@ -115,17 +137,21 @@ func (m *sealWorker) notifyToSealSegmentUntilLessThanLWM(sealPolicy policy.SealP
 }
 
 // asyncMustSealSegment seals the segment asynchronously.
-func (m *sealWorker) asyncMustSealSegment(segmentID int64, policy policy.SealPolicy) {
+func (m *sealWorker) asyncMustSealSegment(segmentID int64, sealPolicy policy.SealPolicy) {
 	belongs, stats, operator, ok := m.statsManager.getSealOperator(segmentID)
 	if !ok {
 		// The segment seal operation is performed asynchronously,
 		// so the segment may be unregistered, should be ignored.
 		return
 	}
+	// The shard manager seals all growing segments of the partition if the collection asks for it.
+	if m.statsManager.collectionConfigs.getCached(belongs.CollectionID).SealPartitionTogether {
+		sealPolicy = policy.PolicyPartition(sealPolicy)
+	}
 	// Notify the seal operator to do the seal.
 	operator.AsyncFlushSegment(utils.SealSegmentSignal{
 		SegmentBelongs: belongs,
 		Stats:          *stats,
-		SealPolicy:     policy,
+		SealPolicy:     sealPolicy,
 	})
 }
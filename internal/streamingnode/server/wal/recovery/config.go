@@ -50,9 +50,11 @@ func newTruncatorConfig() *truncatorConfig {
 	params := paramtable.Get()
 	samplerInterval := params.StreamingCfg.WALTruncateSampleInterval.GetAsDurationByParse()
 	retentionInterval := params.StreamingCfg.WALTruncateRetentionInterval.GetAsDurationByParse()
+	minRetentionSize := params.StreamingCfg.WALTruncateMinRetentionSize.GetAsSize()
 	cfg := &truncatorConfig{
 		sampleInterval:    samplerInterval,
 		retentionInterval: retentionInterval,
+		minRetentionSize:  minRetentionSize,
 	}
 	if err := cfg.validate(); err != nil {
 		panic(err)
@@ -64,6 +66,7 @@ func newTruncatorConfig() *truncatorConfig {
 type truncatorConfig struct {
 	sampleInterval    time.Duration // the interval to sample the checkpoint
 	retentionInterval time.Duration // the retention interval to sample the checkpoint
+	minRetentionSize  int64         // the minimum retained size of wal after truncate, 0 means disabled
 }
 
 // validate validates the truncator config.
@@ -74,5 +77,8 @@ func (cfg *truncatorConfig) validate() error {
 	if cfg.retentionInterval <= 0 {
 		return errors.New("retention interval must be greater than 0")
 	}
+	if cfg.minRetentionSize < 0 {
+		return errors.New("min retention size must not be negative")
+	}
 	return nil
 }
@@ -57,4 +57,8 @@ func TestTruncatorConfig(t *testing.T) {
 
 	assert.Equal(t, 30*time.Minute, cfg.sampleInterval)
 	assert.Equal(t, 72*time.Hour, cfg.retentionInterval)
+	assert.Equal(t, int64(0), cfg.minRetentionSize)
+
+	cfg.minRetentionSize = -1
+	assert.Error(t, cfg.validate())
 }
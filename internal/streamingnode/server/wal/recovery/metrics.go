@@ -25,6 +25,9 @@ func newRecoveryStorageMetrics(channelInfo types.PChannelInfo) *recoveryMetrics
 		inMemTimeTick:          metrics.WALRecoveryInMemTimeTick.With(constLabels),
 		persistedTimeTick:      metrics.WALRecoveryPersistedTimeTick.With(constLabels),
 		truncateTimeTick:       metrics.WALTruncateTimeTick.With(constLabels),
+		backlogBytes:           metrics.WALRetentionBacklogBytes.With(constLabels),
+		vchannelBacklogBytes:   metrics.WALVChannelRetentionBacklogBytes.MustCurryWith(constLabels),
+		vchannelCheckpointLag:  metrics.WALVChannelCheckpointLagSeconds.MustCurryWith(constLabels),
 	}
 }
 
@@ -36,6 +39,9 @@ type recoveryMetrics struct {
 	inMemTimeTick          prometheus.Gauge
 	persistedTimeTick      prometheus.Gauge
 	truncateTimeTick       prometheus.Gauge
+	backlogBytes           prometheus.Gauge
+	vchannelBacklogBytes   *prometheus.GaugeVec
+	vchannelCheckpointLag  *prometheus.GaugeVec
 }
 
 // ObserveStateChange sets the state of the recovery storage metrics.
@@ -56,6 +62,18 @@ func (m *recoveryMetrics) ObServeTruncateMetrics(tickTime uint64) {
 	m.truncateTimeTick.Set(tsoutil.PhysicalTimeSeconds(tickTime))
 }
 
+// ObserveRetention sets the retention metrics of wal and all vchannels on it.
+func (m *recoveryMetrics) ObserveRetention(retention WALRetention) {
+	m.backlogBytes.Set(float64(retention.BacklogBytes))
+	// the vchannel may be dropped, so reset all vchannel metrics.
+	metrics.WALVChannelRetentionBacklogBytes.DeletePartialMatch(m.constLabels)
+	metrics.WALVChannelCheckpointLagSeconds.DeletePartialMatch(m.constLabels)
+	for _, vchannel := range retention.VChannels {
+		m.vchannelBacklogBytes.WithLabelValues(vchannel.VChannel).Set(float64(vchannel.BacklogBytes))
+		m.vchannelCheckpointLag.WithLabelValues(vchannel.VChannel).Set(vchannel.CheckpointLag)
+	}
+}
+
 func (m *recoveryMetrics) ObserveInconsitentEvent() {
 	m.inconsistentEventTotal.Inc()
 }
@@ -75,4 +93,7 @@ func (m *recoveryMetrics) Close() {
 	metrics.WALRecoveryInMemTimeTick.DeletePartialMatch(m.constLabels)
 	metrics.WALRecoveryPersistedTimeTick.DeletePartialMatch(m.constLabels)
 	metrics.WALTruncateTimeTick.DeletePartialMatch(m.constLabels)
+	metrics.WALRetentionBacklogBytes.DeletePartialMatch(m.constLabels)
+	metrics.WALVChannelRetentionBacklogBytes.DeletePartialMatch(m.constLabels)
+	metrics.WALVChannelCheckpointLagSeconds.DeletePartialMatch(m.constLabels)
 }
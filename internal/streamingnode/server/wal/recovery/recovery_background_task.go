@@ -57,6 +57,7 @@ func (rs *recoveryStorageImpl) backgroundTask() {
 		if err := rs.persistDirtySnapshot(rs.backgroundTaskNotifier.Context(), zap.DebugLevel); err != nil {
 			return
 		}
+		rs.metrics.ObserveRetention(rs.Retention())
 	}
 }
 
@@ -153,11 +154,12 @@ func (rs *recoveryStorageImpl) sampleTruncateCheckpoint(checkpoint *WALCheckpoin
 	if flusherCP == nil {
 		return
 	}
+	mark := rs.getRetentionMark()
 	// use the smaller one to truncate the wal.
 	if flusherCP.MessageID.LTE(checkpoint.MessageID) {
-		rs.truncator.SampleCheckpoint(flusherCP)
+		rs.truncator.SampleCheckpoint(flusherCP, mark)
 	} else {
-		rs.truncator.SampleCheckpoint(checkpoint)
+		rs.truncator.SampleCheckpoint(checkpoint, mark)
 	}
 }
 
@@ -73,7 +73,7 @@ type RecoveryStorage interface {
 
 	// UpdateFlusherCheckpoint updates the checkpoint of flusher.
 	// TODO: should be removed in future, after merge the flusher logic into recovery storage.
-	UpdateFlusherCheckpoint(checkpoint *WALCheckpoint)
+	UpdateFlusherCheckpoint(vchannel string, checkpoint *WALCheckpoint)
 
 	// Close closes the recovery storage.
 	Close()
@@ -55,6 +55,7 @@ func RecoverRecoveryStorage(
 		rs.metrics,
 	)
 	rs.truncator.SetLogger(rs.Logger())
+	walRetentions.Insert(rs.channel.String(), rs)
 	go rs.backgroundTask()
 	return rs, snapshot, nil
 }
@@ -63,14 +64,16 @@ func RecoverRecoveryStorage(
 func newRecoveryStorage(channel types.PChannelInfo) *recoveryStorageImpl {
 	cfg := newConfig()
 	return &recoveryStorageImpl{
-		backgroundTaskNotifier: syncutil.NewAsyncTaskNotifier[struct{}](),
-		cfg:                    cfg,
-		mu:                     sync.Mutex{},
-		channel:                channel,
-		dirtyCounter:           0,
-		persistNotifier:        make(chan struct{}, 1),
-		gracefulClosed:         false,
-		metrics:                newRecoveryStorageMetrics(channel),
+		backgroundTaskNotifier:     syncutil.NewAsyncTaskNotifier[struct{}](),
+		cfg:                        cfg,
+		mu:                         sync.Mutex{},
+		channel:                    channel,
+		dirtyCounter:               0,
+		persistNotifier:            make(chan struct{}, 1),
+		gracefulClosed:             false,
+		metrics:                    newRecoveryStorageMetrics(channel),
+		retentionMark:              newRetentionMark(),
+		vchannelFlusherCheckpoints: make(map[string]uint64),
 	}
 }
 
@@ -93,6 +96,10 @@ type recoveryStorageImpl struct {
 	truncator              *samplingTruncator
 	metrics                *recoveryMetrics
 	pendingPersistSnapshot *RecoverySnapshot
+	// retentionMark is the accumulated bytes of observed messages, used to estimate the wal backlog.
+	retentionMark retentionMark
+	// vchannelFlusherCheckpoints is the flush checkpoint time tick of each vchannel.
+	vchannelFlusherCheckpoints map[string]uint64
 }
 
 // Metrics gets the metrics of the wal.
@@ -107,9 +114,12 @@ func (r *recoveryStorageImpl) Metrics() RecoveryMetrics {
 
 // UpdateFlusherCheckpoint updates the checkpoint of flusher.
 // TODO: should be removed in future, after merge the flusher logic into recovery storage.
-func (r *recoveryStorageImpl) UpdateFlusherCheckpoint(checkpoint *WALCheckpoint) {
+func (r *recoveryStorageImpl) UpdateFlusherCheckpoint(vchannel string, checkpoint *WALCheckpoint) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	if vchannel != "" && r.vchannelFlusherCheckpoints[vchannel] < checkpoint.TimeTick {
+		r.vchannelFlusherCheckpoints[vchannel] = checkpoint.TimeTick
+	}
 	if r.flusherCheckpoint == nil || r.flusherCheckpoint.MessageID.LTE(checkpoint.MessageID) {
 		r.flusherCheckpoint = checkpoint
 		r.Logger().Info("update checkpoint of flusher", zap.String("messageID", checkpoint.MessageID.String()), zap.Uint64("timeTick", checkpoint.TimeTick))
@@ -140,6 +150,7 @@ func (r *recoveryStorageImpl) ObserveMessage(ctx context.Context, msg message.Im
 func (r *recoveryStorageImpl) Close() {
 	r.backgroundTaskNotifier.Cancel()
 	r.backgroundTaskNotifier.BlockUntilFinish()
+	walRetentions.Remove(r.channel.String())
 	// Stop the truncator.
 	r.truncator.Close()
 	r.metrics.Close()
@@ -204,6 +215,7 @@ func (r *recoveryStorageImpl) observeMessage(msg message.ImmutableMessage) {
 		return
 	}
 	r.handleMessage(msg)
+	r.retentionMark.Observe(msg)
 
 	r.checkpoint.TimeTick = msg.TimeTick()
 	r.checkpoint.MessageID = msg.LastConfirmedMessageID()
@@ -436,6 +448,14 @@ func (r *recoveryStorageImpl) detectInconsistency(msg message.ImmutableMessage,
 	r.metrics.ObserveInconsitentEvent()
 }
 
+// getRetentionMark returns a snapshot of the retention mark concurrent-safe
+// NOTE: shall not be called with r.mu.Lock()!
+func (r *recoveryStorageImpl) getRetentionMark() retentionMark {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.retentionMark.Clone()
+}
+
 // getFlusherCheckpoint returns flusher checkpoint concurrent-safe
 // NOTE: shall not be called with r.mu.Lock()!
 func (r *recoveryStorageImpl) getFlusherCheckpoint() *WALCheckpoint {
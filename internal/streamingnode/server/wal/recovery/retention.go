@@ -0,0 +1,143 @@
+package recovery
+
+import (
+	"sort"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// walRetentions is the recovery storages of all working wal on current streaming node.
+var walRetentions = typeutil.NewConcurrentMap[string, *recoveryStorageImpl]()
+
+// GetWALRetentions returns the retention view of all working wal on current streaming node.
+func GetWALRetentions() []WALRetention {
+	retentions := make([]WALRetention, 0, walRetentions.Len())
+	walRetentions.Range(func(_ string, rs *recoveryStorageImpl) bool {
+		retentions = append(retentions, rs.Retention())
+		return true
+	})
+	sort.Slice(retentions, func(i, j int) bool {
+		return retentions[i].Channel < retentions[j].Channel
+	})
+	return retentions
+}
+
+// WALRetention is the retention view of a wal.
+type WALRetention struct {
+	Channel                 string              `json:"channel"`
+	Term                    int64               `json:"term"`
+	BacklogBytes            uint64              `json:"backlog_bytes"`
+	OldestRetainedMessageID string              `json:"oldest_retained_message_id"`
+	OldestRetainedTimeTick  uint64              `json:"oldest_retained_time_tick"`
+	OldestRetainedTime      time.Time           `json:"oldest_retained_time"`
+	LatestTimeTick          uint64              `json:"latest_time_tick"`
+	VChannels               []VChannelRetention `json:"vchannels"`
+}
+
+// VChannelRetention is the retention view of a vchannel.
+type VChannelRetention struct {
+	VChannel           string  `json:"vchannel"`
+	BacklogBytes       uint64  `json:"backlog_bytes"`
+	CheckpointTimeTick uint64  `json:"checkpoint_time_tick"`
+	CheckpointLag      float64 `json:"checkpoint_lag_seconds"`
+}
+
+// newRetentionMark creates a new empty retention mark.
+func newRetentionMark() retentionMark {
+	return retentionMark{
+		vchannelBytes: make(map[string]uint64),
+	}
+}
+
+// retentionMark is the accumulated bytes of wal observed by recovery storage at some point.
+// The bytes is accumulated from the recovery checkpoint, so it's an estimated value.
+type retentionMark struct {
+	totalBytes    uint64
+	vchannelBytes map[string]uint64
+}
+
+// Observe accumulates the size of incoming message.
+func (m *retentionMark) Observe(msg message.ImmutableMessage) {
+	size := uint64(msg.EstimateSize())
+	m.totalBytes += size
+	if msg.VChannel() != "" {
+		m.vchannelBytes[msg.VChannel()] += size
+	}
+}
+
+// Clone returns a copy of the retention mark.
+func (m retentionMark) Clone() retentionMark {
+	vchannelBytes := make(map[string]uint64, len(m.vchannelBytes))
+	for vchannel, bytes := range m.vchannelBytes {
+		vchannelBytes[vchannel] = bytes
+	}
+	return retentionMark{
+		totalBytes:    m.totalBytes,
+		vchannelBytes: vchannelBytes,
+	}
+}
+
+// BytesAfter returns the bytes accumulated after the given mark.
+func (m retentionMark) BytesAfter(base retentionMark) uint64 {
+	if m.totalBytes < base.totalBytes {
+		return 0
+	}
+	return m.totalBytes - base.totalBytes
+}
+
+// VChannelBytesAfter returns the bytes of vchannel accumulated after the given mark.
+func (m retentionMark) VChannelBytesAfter(vchannel string, base retentionMark) uint64 {
+	if m.vchannelBytes[vchannel] < base.vchannelBytes[vchannel] {
+		return 0
+	}
+	return m.vchannelBytes[vchannel] - base.vchannelBytes[vchannel]
+}
+
+// Retention returns the retention view of the wal.
+func (r *recoveryStorageImpl) Retention() WALRetention {
+	truncated, truncatedMark := r.truncator.LastTruncated()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	retention := WALRetention{
+		Channel:                r.channel.Name,
+		Term:                   r.channel.Term,
+		BacklogBytes:           r.retentionMark.BytesAfter(truncatedMark),
+		OldestRetainedTimeTick: truncated.TimeTick,
+		OldestRetainedTime:     tsoutil.PhysicalTime(truncated.TimeTick),
+		LatestTimeTick:         r.checkpoint.TimeTick,
+		VChannels:              make([]VChannelRetention, 0, len(r.vchannels)),
+	}
+	if truncated.MessageID != nil {
+		retention.OldestRetainedMessageID = truncated.MessageID.String()
+	}
+	latest := tsoutil.PhysicalTime(r.checkpoint.TimeTick)
+	for vchannel, info := range r.vchannels {
+		if !info.IsActive() {
+			continue
+		}
+		checkpoint, ok := r.vchannelFlusherCheckpoints[vchannel]
+		if !ok {
+			// the flusher doesn't report the checkpoint yet, use the checkpoint of recovery info.
+			checkpoint = info.meta.CheckpointTimeTick
+		}
+		lag := latest.Sub(tsoutil.PhysicalTime(checkpoint))
+		if lag < 0 {
+			lag = 0
+		}
+		retention.VChannels = append(retention.VChannels, VChannelRetention{
+			VChannel:           vchannel,
+			BacklogBytes:       r.retentionMark.VChannelBytesAfter(vchannel, truncatedMark),
+			CheckpointTimeTick: checkpoint,
+			CheckpointLag:      lag.Seconds(),
+		})
+	}
+	sort.Slice(retention.VChannels, func(i, j int) bool {
+		return retention.VChannels[i].VChannel < retention.VChannels[j].VChannel
+	})
+	return retention
+}
@@ -23,8 +23,11 @@ func newSamplingTruncator(
 		cfg:                     newTruncatorConfig(),
 		truncator:               truncator,
 		mu:                      sync.Mutex{},
-		checkpointSamples:       []*WALCheckpoint{checkpoint},
+		checkpointSamples:       []*checkpointSample{{checkpoint: checkpoint, mark: newRetentionMark()}},
 		lastTruncatedCheckpoint: nil,
+		recoveryCheckpoint:      checkpoint,
+		lastTruncatedMark:       newRetentionMark(),
+		latestMark:              newRetentionMark(),
 		lastSampled:             time.Now(),
 		metrics:                 recoveryMetrics,
 	}
@@ -40,22 +43,44 @@ type samplingTruncator struct {
 	truncator walimpls.WALImpls
 
 	mu                      sync.Mutex
-	checkpointSamples       []*WALCheckpoint // the samples of checkpoints
-	lastTruncatedCheckpoint *WALCheckpoint   // the last truncated checkpoint
-	lastSampled             time.Time        // the last time the checkpoint is sampled
+	checkpointSamples       []*checkpointSample // the samples of checkpoints
+	lastTruncatedCheckpoint *WALCheckpoint      // the last truncated checkpoint
+	recoveryCheckpoint      *WALCheckpoint      // the checkpoint when the wal is recovered, used as the oldest retained one before any truncate.
+	lastTruncatedMark       retentionMark       // the retention mark of the last truncated checkpoint
+	latestMark              retentionMark       // the latest retention mark seen by truncator
+	lastSampled             time.Time           // the last time the checkpoint is sampled
 	metrics                 *recoveryMetrics
 }
 
+// checkpointSample is a sampled checkpoint with the retention mark when sampling.
+type checkpointSample struct {
+	checkpoint *WALCheckpoint
+	mark       retentionMark
+}
+
+// LastTruncated returns the oldest retained checkpoint of wal and its retention mark.
+func (t *samplingTruncator) LastTruncated() (*WALCheckpoint, retentionMark) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.lastTruncatedCheckpoint == nil {
+		return t.recoveryCheckpoint, t.lastTruncatedMark
+	}
+	return t.lastTruncatedCheckpoint, t.lastTruncatedMark
+}
+
 // SampleCheckpoint samples the incoming checkpoint and adds it to the checkpoint samples.
-func (t *samplingTruncator) SampleCheckpoint(checkpoint *WALCheckpoint) {
+// The mark is the retention mark of the recovery storage when sampling,
+// it's always newer than the checkpoint, so the size-based retention is conservative.
+func (t *samplingTruncator) SampleCheckpoint(checkpoint *WALCheckpoint, mark retentionMark) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	t.latestMark = mark
 	if time.Since(t.lastSampled) < t.cfg.sampleInterval {
 		return
 	}
 
-	if len(t.checkpointSamples) == 0 || t.checkpointSamples[len(t.checkpointSamples)-1].MessageID.LT(checkpoint.MessageID) {
-		t.checkpointSamples = append(t.checkpointSamples, checkpoint)
+	if len(t.checkpointSamples) == 0 || t.checkpointSamples[len(t.checkpointSamples)-1].checkpoint.MessageID.LT(checkpoint.MessageID) {
+		t.checkpointSamples = append(t.checkpointSamples, &checkpointSample{checkpoint: checkpoint, mark: mark})
 	}
 	t.lastSampled = time.Now()
 }
@@ -80,38 +105,51 @@ func (t *samplingTruncator) background() {
 }
 
 // consumeCheckpointSamples consumes the checkpoint samples and returns the truncate checkpoint.
-func (t *samplingTruncator) consumeCheckpointSamples() *WALCheckpoint {
+func (t *samplingTruncator) consumeCheckpointSamples() *checkpointSample {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
 	targetCheckpointIdx := -1
 	for i := 0; i < len(t.checkpointSamples); i++ {
-		if time.Since(tsoutil.PhysicalTime(t.checkpointSamples[i].TimeTick)) < t.cfg.retentionInterval {
+		if time.Since(tsoutil.PhysicalTime(t.checkpointSamples[i].checkpoint.TimeTick)) < t.cfg.retentionInterval {
+			break
+		}
+		// keep at least minRetentionSize of wal after the truncate point.
+		if t.cfg.minRetentionSize > 0 && t.latestMark.BytesAfter(t.checkpointSamples[i].mark) < uint64(t.cfg.minRetentionSize) {
 			break
 		}
 		targetCheckpointIdx = i
 	}
 	if targetCheckpointIdx >= 0 {
-		checkpoint := t.checkpointSamples[targetCheckpointIdx]
+		sample := t.checkpointSamples[targetCheckpointIdx]
 		t.checkpointSamples = t.checkpointSamples[targetCheckpointIdx+1:]
-		return checkpoint
+		return sample
 	}
 	return nil
 }
 
+// setTruncated sets the last truncated checkpoint.
+func (t *samplingTruncator) setTruncated(sample *checkpointSample) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lastTruncatedCheckpoint = sample.checkpoint
+	t.lastTruncatedMark = sample.mark
+}
+
 // applyTruncate applies the truncate operation.
 func (t *samplingTruncator) applyTruncate() {
-	truncateCheckpoint := t.consumeCheckpointSamples()
-	if truncateCheckpoint == nil {
+	sample := t.consumeCheckpointSamples()
+	if sample == nil {
 		t.Logger().Debug("no checkpoint sample can be used to truncate wal")
 		return
 	}
+	truncateCheckpoint := sample.checkpoint
 	logger := t.Logger().With(zap.String("messageID", truncateCheckpoint.MessageID.String()), zap.Uint64("timeTick", truncateCheckpoint.TimeTick))
 	if t.lastTruncatedCheckpoint != nil {
 		logger = logger.With(zap.String("lastMessageID", t.lastTruncatedCheckpoint.MessageID.String()), zap.Uint64("lastTimeTick", t.lastTruncatedCheckpoint.TimeTick))
 		if truncateCheckpoint.MessageID.EQ(t.lastTruncatedCheckpoint.MessageID) {
 			logger.Debug("checkpoint sample is the same, ignore the operation", zap.String("messageID", truncateCheckpoint.MessageID.String()))
-			t.lastTruncatedCheckpoint = truncateCheckpoint
+			t.setTruncated(sample)
 			t.metrics.ObServeTruncateMetrics(truncateCheckpoint.TimeTick)
 			return
 		} else if truncateCheckpoint.MessageID.LT(t.lastTruncatedCheckpoint.MessageID) {
@@ -126,7 +164,7 @@ func (t *samplingTruncator) applyTruncate() {
 		return
 	}
 	logger.Info("truncate wal")
-	t.lastTruncatedCheckpoint = truncateCheckpoint
+	t.setTruncated(sample)
 	t.metrics.ObServeTruncateMetrics(truncateCheckpoint.TimeTick)
 }
 
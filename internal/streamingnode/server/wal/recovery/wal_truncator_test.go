@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/milvus-io/milvus/pkg/v2/mocks/streaming/mock_walimpls"
@@ -33,8 +34,55 @@ func TestTruncator(t *testing.T) {
 				MessageID: rmq.NewRmqID(int64(i)),
 				TimeTick:  tsoutil.ComposeTSByTime(time.Now(), 0),
 				Magic:     RecoveryMagicStreamingInitialized,
-			})
+			}, newRetentionMark())
 		}
 	}
 	truncator.Close()
 }
+
+func TestTruncatorMinRetentionSize(t *testing.T) {
+	w := mock_walimpls.NewMockWALImpls(t)
+	w.EXPECT().Truncate(mock.Anything, mock.Anything).Return(nil).Maybe()
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALTruncateSampleInterval.Key, "1h")
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALTruncateRetentionInterval.Key, "1ms")
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALTruncateMinRetentionSize.Key, "100")
+	defer func() {
+		paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALTruncateSampleInterval.Key)
+		paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALTruncateRetentionInterval.Key)
+		paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALTruncateMinRetentionSize.Key)
+	}()
+
+	initial := &WALCheckpoint{
+		MessageID: rmq.NewRmqID(1),
+		TimeTick:  1,
+		Magic:     RecoveryMagicStreamingInitialized,
+	}
+	truncator := newSamplingTruncator(initial, w, newRecoveryStorageMetrics(types.PChannelInfo{Name: "test", Term: 1}))
+	defer truncator.Close()
+
+	cp, mark := truncator.LastTruncated()
+	assert.Equal(t, initial, cp)
+	assert.Zero(t, mark.totalBytes)
+
+	// only 50 bytes retained after the initial checkpoint, can not be truncated.
+	truncator.SampleCheckpoint(initial, retentionMark{totalBytes: 50, vchannelBytes: map[string]uint64{"v1": 50}})
+	time.Sleep(2 * time.Millisecond)
+	assert.Nil(t, truncator.consumeCheckpointSamples())
+
+	// 150 bytes retained after the initial checkpoint, can be truncated.
+	truncator.SampleCheckpoint(initial, retentionMark{totalBytes: 150, vchannelBytes: map[string]uint64{"v1": 150}})
+	sample := truncator.consumeCheckpointSamples()
+	assert.NotNil(t, sample)
+	assert.Equal(t, initial, sample.checkpoint)
+
+	truncator.setTruncated(&checkpointSample{
+		checkpoint: initial,
+		mark:       retentionMark{totalBytes: 50, vchannelBytes: map[string]uint64{"v1": 50}},
+	})
+	_, mark = truncator.LastTruncated()
+	latest := retentionMark{totalBytes: 150, vchannelBytes: map[string]uint64{"v1": 120, "v2": 30}}
+	assert.Equal(t, uint64(100), latest.BytesAfter(mark))
+	assert.Equal(t, uint64(70), latest.VChannelBytesAfter("v1", mark))
+	assert.Equal(t, uint64(30), latest.VChannelBytesAfter("v2", mark))
+	assert.Zero(t, mark.BytesAfter(latest))
+}
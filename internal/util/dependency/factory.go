@@ -21,6 +21,7 @@ const (
 	mqTypeKafka      = "kafka"
 	mqTypePulsar     = "pulsar"
 	mqTypeWoodpecker = "woodpecker"
+	mqTypeNats       = "nats"
 )
 
 type mqEnable struct {
@@ -93,7 +94,8 @@ func (f *DefaultFactory) initMQ(standalone bool, params *paramtable.ComponentPar
 		f.msgStreamFactory = msgstream.NewPmsFactory(&params.ServiceParam)
 	case mqTypeKafka:
 		f.msgStreamFactory = msgstream.NewKmsFactory(&params.ServiceParam)
-	case mqTypeWoodpecker:
+	case mqTypeWoodpecker, mqTypeNats:
+		// woodpecker and nats are only accessed by the streaming node as wal, the msgstream is a no-op
 		f.msgStreamFactory = msgstream.NewWpmsFactory(&params.ServiceParam)
 	}
 	if f.msgStreamFactory == nil {
@@ -131,7 +133,7 @@ func mustSelectMQType(standalone bool, mqType string, enable mqEnable) string {
 
 // Validate mq type.
 func validateMQType(standalone bool, mqType string) error {
-	if mqType != mqTypeRocksmq && mqType != mqTypeKafka && mqType != mqTypePulsar && mqType != mqTypeWoodpecker {
+	if mqType != mqTypeRocksmq && mqType != mqTypeKafka && mqType != mqTypePulsar && mqType != mqTypeWoodpecker && mqType != mqTypeNats {
 		return errors.Newf("mq type %s is invalid", mqType)
 	}
 	if !standalone && mqType == mqTypeRocksmq {
@@ -172,8 +174,8 @@ func HealthCheck(mqType string) *common.MQClusterStatus {
 		msgstream.PulsarHealthCheck(clusterStatus)
 	case mqTypeKafka:
 		msgstream.KafkaHealthCheck(clusterStatus)
-	case mqTypeWoodpecker:
-		// TODO: implement health checker for woodpecker
+	case mqTypeWoodpecker, mqTypeNats:
+		// TODO: implement health checker for woodpecker and nats
 		clusterStatus.Health = true
 	}
 	return clusterStatus
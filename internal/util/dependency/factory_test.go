@@ -1,6 +1,7 @@
 package dependency
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,6 +15,8 @@ func TestValidateMQType(t *testing.T) {
 	assert.Error(t, validateMQType(false, mqTypeRocksmq))
 	assert.NoError(t, validateMQType(true, mqTypeWoodpecker))
 	assert.NoError(t, validateMQType(false, mqTypeWoodpecker))
+	assert.NoError(t, validateMQType(true, mqTypeNats))
+	assert.NoError(t, validateMQType(false, mqTypeNats))
 }
 
 func TestSelectMQType(t *testing.T) {
@@ -37,6 +40,24 @@ func TestSelectMQType(t *testing.T) {
 	assert.Equal(t, mustSelectMQType(false, mqTypePulsar, mqEnable{true, true, true, true}), mqTypePulsar)
 	assert.Equal(t, mustSelectMQType(false, mqTypeKafka, mqEnable{true, true, true, true}), mqTypeKafka)
 	assert.Equal(t, mustSelectMQType(false, mqTypeWoodpecker, mqEnable{true, true, true, true}), mqTypeWoodpecker)
+	assert.Equal(t, mustSelectMQType(true, mqTypeNats, mqEnable{true, true, true, true}), mqTypeNats)
+	assert.Equal(t, mustSelectMQType(false, mqTypeNats, mqEnable{false, false, false, false}), mqTypeNats)
+}
+
+func TestInitNatsFactory(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.MQCfg.Type.Key, mqTypeNats)
+	defer params.Reset(params.MQCfg.Type.Key)
+
+	for _, standalone := range []bool{true, false} {
+		f := NewFactory(standalone)
+		assert.NotPanics(t, func() { f.Init(params) })
+		stream, err := f.NewMsgStream(context.Background())
+		assert.NoError(t, err)
+		assert.NotNil(t, stream)
+		stream.Close()
+	}
 }
 
 func TestHealthCheck(t *testing.T) {
@@ -54,6 +75,7 @@ func TestHealthCheck(t *testing.T) {
 		{mqTypePulsar, false},
 		{mqTypeKafka, false},
 		{mqTypeWoodpecker, true},
+		{mqTypeNats, true},
 		{"invalidType", false},
 	}
 
@@ -19,6 +19,7 @@
 package function
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -108,7 +109,7 @@ func (provider *AliEmbeddingProvider) FieldDim() int64 {
 	return provider.fieldDim
 }
 
-func (provider *AliEmbeddingProvider) CallEmbedding(texts []string, mode TextEmbeddingMode) (any, error) {
+func (provider *AliEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, mode TextEmbeddingMode) (any, error) {
 	numRows := len(texts)
 	var textType string
 	if mode == SearchMode {
@@ -122,7 +123,7 @@ func (provider *AliEmbeddingProvider) CallEmbedding(texts []string, mode TextEmb
 		if end > numRows {
 			end = numRows
 		}
-		resp, err := provider.client.Embedding(provider.modelName, texts[i:end], int(provider.embedDimParam), textType, provider.outputType, provider.timeoutSec)
+		resp, err := provider.client.Embedding(ctx, provider.modelName, texts[i:end], int(provider.embedDimParam), textType, provider.outputType, provider.timeoutSec)
 		if err != nil {
 			return nil, err
 		}
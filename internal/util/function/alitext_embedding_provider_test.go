@@ -19,6 +19,7 @@
 package function
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -91,7 +92,7 @@ func (s *AliTextEmbeddingProviderSuite) TestEmbedding() {
 		s.NoError(err)
 		{
 			data := []string{"sentence"}
-			r, err2 := provder.CallEmbedding(data, InsertMode)
+			r, err2 := provder.CallEmbedding(context.Background(), data, InsertMode)
 			ret := r.([][]float32)
 			s.NoError(err2)
 			s.Equal(1, len(ret))
@@ -100,7 +101,7 @@ func (s *AliTextEmbeddingProviderSuite) TestEmbedding() {
 		}
 		{
 			data := []string{"sentence 1", "sentence 2", "sentence 3"}
-			ret, _ := provder.CallEmbedding(data, SearchMode)
+			ret, _ := provder.CallEmbedding(context.Background(), data, SearchMode)
 			s.Equal([][]float32{{0.0, 1.0, 2.0, 3.0}, {1.0, 2.0, 3.0, 4.0}, {2.0, 3.0, 4.0, 5.0}}, ret)
 		}
 	}
@@ -133,7 +134,7 @@ func (s *AliTextEmbeddingProviderSuite) TestEmbeddingDimNotMatch() {
 
 		// embedding dim not match
 		data := []string{"sentence", "sentence"}
-		_, err2 := provder.CallEmbedding(data, InsertMode)
+		_, err2 := provder.CallEmbedding(context.Background(), data, InsertMode)
 		s.Error(err2)
 	}
 }
@@ -161,7 +162,7 @@ func (s *AliTextEmbeddingProviderSuite) TestEmbeddingNumberNotMatch() {
 
 		// embedding dim not match
 		data := []string{"sentence", "sentence2"}
-		_, err2 := provder.CallEmbedding(data, InsertMode)
+		_, err2 := provder.CallEmbedding(context.Background(), data, InsertMode)
 		s.Error(err2)
 	}
 }
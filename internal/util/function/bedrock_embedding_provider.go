@@ -29,11 +29,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime"
+	"github.com/aws/smithy-go/middleware"
 	"github.com/cockroachdb/errors"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	milvusCredentials "github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function/models/utils"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
@@ -179,7 +181,21 @@ func (provider *BedrockEmbeddingProvider) FieldDim() int64 {
 	return provider.fieldDim
 }
 
-func (provider *BedrockEmbeddingProvider) CallEmbedding(texts []string, _ TextEmbeddingMode) (any, error) {
+// withRequestLimiter waits for the request limiter of the context before every attempt of the request,
+// the middleware is added to the end of the finalize step, so it runs after the retry middleware of the sdk.
+func withRequestLimiter(o *bedrockruntime.Options) {
+	o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+		return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("RequestLimiter",
+			func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+				if err := utils.WaitRequestLimiter(ctx); err != nil {
+					return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+				}
+				return next.HandleFinalize(ctx, in)
+			}), middleware.After)
+	})
+}
+
+func (provider *BedrockEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, _ TextEmbeddingMode) (any, error) {
 	numRows := len(texts)
 	data := make([][]float32, 0, numRows)
 	for i := 0; i < numRows; i += 1 {
@@ -196,11 +212,11 @@ func (provider *BedrockEmbeddingProvider) CallEmbedding(texts []string, _ TextEm
 			return nil, err
 		}
 
-		output, err := provider.client.InvokeModel(context.Background(), &bedrockruntime.InvokeModelInput{
+		output, err := provider.client.InvokeModel(ctx, &bedrockruntime.InvokeModelInput{
 			Body:        payloadBytes,
 			ModelId:     aws.String(provider.modelName),
 			ContentType: aws.String("application/json"),
-		})
+		}, withRequestLimiter)
 		if err != nil {
 			return nil, err
 		}
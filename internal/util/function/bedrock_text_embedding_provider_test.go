@@ -19,15 +19,20 @@
 package function
 
 import (
+	"context"
 	"os"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime"
+	"github.com/aws/smithy-go/middleware"
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function/models/utils"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
 func TestBedrockTextEmbeddingProvider(t *testing.T) {
@@ -84,7 +89,7 @@ func (s *BedrockTextEmbeddingProviderSuite) TestEmbedding() {
 		s.NoError(err)
 		{
 			data := []string{"sentence"}
-			r, err2 := provder.CallEmbedding(data, InsertMode)
+			r, err2 := provder.CallEmbedding(context.Background(), data, InsertMode)
 			ret := r.([][]float32)
 			s.NoError(err2)
 			s.Equal(1, len(ret))
@@ -93,7 +98,7 @@ func (s *BedrockTextEmbeddingProviderSuite) TestEmbedding() {
 		}
 		{
 			data := []string{"sentence 1", "sentence 2", "sentence 3"}
-			ret, _ := provder.CallEmbedding(data, SearchMode)
+			ret, _ := provder.CallEmbedding(context.Background(), data, SearchMode)
 			s.Equal([][]float32{{0.0, 1.0, 2.0, 3.0}, {0.0, 1.0, 2.0, 3.0}, {0.0, 1.0, 2.0, 3.0}}, ret)
 		}
 	}
@@ -106,7 +111,7 @@ func (s *BedrockTextEmbeddingProviderSuite) TestEmbeddingDimNotMatch() {
 
 		// embedding dim not match
 		data := []string{"sentence", "sentence"}
-		_, err2 := provder.CallEmbedding(data, InsertMode)
+		_, err2 := provder.CallEmbedding(context.Background(), data, InsertMode)
 		s.Error(err2)
 	}
 }
@@ -205,3 +210,34 @@ func (s *BedrockTextEmbeddingProviderSuite) TestNewBedrockEmbeddingProvider() {
 	_, err = NewBedrockEmbeddingProvider(fieldSchema, functionSchema, nil, map[string]string{}, credentials.NewCredentials(map[string]string{"mock.access_key_id": "mock", "mock.secret_access_key": "mock"}))
 	s.Error(err)
 }
+
+func (s *BedrockTextEmbeddingProviderSuite) TestRequestLimiter() {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().FunctionCfg.RateLimitMaxWait.Key, "0")
+	defer paramtable.Get().Reset(paramtable.Get().FunctionCfg.RateLimitMaxWait.Key)
+
+	o := &bedrockruntime.Options{}
+	withRequestLimiter(o)
+	stack := middleware.NewStack("InvokeModel", func() interface{} { return nil })
+	s.NoError(o.APIOptions[0](stack))
+	m, ok := stack.Finalize.Get("RequestLimiter")
+	s.True(ok)
+
+	attempts := 0
+	next := middleware.FinalizeHandlerFunc(func(ctx context.Context, in middleware.FinalizeInput) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		attempts++
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, nil
+	})
+	// every attempt takes a token
+	ctx := utils.WithRequestLimiter(context.Background(), newProviderGuard(bedrockProvider, "limiter", 1))
+	_, _, err := m.HandleFinalize(ctx, middleware.FinalizeInput{}, next)
+	s.NoError(err)
+	_, _, err = m.HandleFinalize(ctx, middleware.FinalizeInput{}, next)
+	s.True(errors.Is(err, errRateLimitExceeded))
+	s.Equal(1, attempts)
+
+	// no limiter
+	_, _, err = m.HandleFinalize(context.Background(), middleware.FinalizeInput{}, next)
+	s.NoError(err)
+	s.Equal(2, attempts)
+}
@@ -19,6 +19,7 @@
 package function
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -43,7 +44,7 @@ type CohereEmbeddingProvider struct {
 
 func createCohereEmbeddingClient(apiKey string, url string) (*cohere.CohereEmbedding, error) {
 	if apiKey == "" {
-		return nil, fmt.Errorf("Missing credentials config or configure the %s environment variable in the Milvus service.", cohereAIAKEnvStr)
+		return nil, fmt.Errorf("Missing credentials config or configure the %s environment variable in the Milvus service.", CohereAIAKEnvStr)
 	}
 
 	if url == "" {
@@ -59,7 +60,7 @@ func NewCohereEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchem
 	if err != nil {
 		return nil, err
 	}
-	apiKey, url, err := parseAKAndURL(credentials, functionSchema.Params, params, cohereAIAKEnvStr)
+	apiKey, url, err := parseAKAndURL(credentials, functionSchema.Params, params, CohereAIAKEnvStr)
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +129,7 @@ func (provider *CohereEmbeddingProvider) getInputType(mode TextEmbeddingMode) st
 	return "search_query" // Used for embeddings of search queries run against a vector DB to find relevant documents.
 }
 
-func (provider *CohereEmbeddingProvider) CallEmbedding(texts []string, mode TextEmbeddingMode) (any, error) {
+func (provider *CohereEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, mode TextEmbeddingMode) (any, error) {
 	numRows := len(texts)
 	inputType := provider.getInputType(mode)
 	embRet := newEmbdResult(numRows, provider.embdType)
@@ -138,7 +139,7 @@ func (provider *CohereEmbeddingProvider) CallEmbedding(texts []string, mode Text
 			end = numRows
 		}
 
-		resp, err := provider.client.Embedding(provider.modelName, texts[i:end], inputType, provider.outputType, provider.truncate, provider.timeoutSec)
+		resp, err := provider.client.Embedding(ctx, provider.modelName, texts[i:end], inputType, provider.outputType, provider.truncate, provider.timeoutSec)
 		if err != nil {
 			return nil, err
 		}
@@ -19,6 +19,7 @@
 package function
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -101,7 +102,7 @@ func (s *CohereTextEmbeddingProviderSuite) TestEmbedding() {
 		s.Equal("float", provider.(*CohereEmbeddingProvider).outputType)
 		{
 			data := []string{"sentence"}
-			r, err2 := provider.CallEmbedding(data, InsertMode)
+			r, err2 := provider.CallEmbedding(context.Background(), data, InsertMode)
 			ret := r.([][]float32)
 			s.NoError(err2)
 			s.Equal(1, len(ret))
@@ -110,7 +111,7 @@ func (s *CohereTextEmbeddingProviderSuite) TestEmbedding() {
 		}
 		{
 			data := []string{"sentence 1", "sentence 2", "sentence 3"}
-			ret, _ := provider.CallEmbedding(data, SearchMode)
+			ret, _ := provider.CallEmbedding(context.Background(), data, SearchMode)
 			s.Equal([][]float32{{0.0, 1.0, 2.0, 3.0}, {1.0, 2.0, 3.0, 4.0}, {2.0, 3.0, 4.0, 5.0}}, ret)
 		}
 	}
@@ -136,7 +137,7 @@ func (s *CohereTextEmbeddingProviderSuite) TestEmbeddingInt8() {
 		s.Equal("int8", provider.(*CohereEmbeddingProvider).outputType)
 		{
 			data := []string{"sentence"}
-			r, err2 := provider.CallEmbedding(data, InsertMode)
+			r, err2 := provider.CallEmbedding(context.Background(), data, InsertMode)
 			s.NoError(err2)
 			ret := r.([][]int8)
 			s.Equal(1, len(ret))
@@ -145,7 +146,7 @@ func (s *CohereTextEmbeddingProviderSuite) TestEmbeddingInt8() {
 		}
 		{
 			data := []string{"sentence 1", "sentence 2", "sentence 3"}
-			ret, _ := provider.CallEmbedding(data, SearchMode)
+			ret, _ := provider.CallEmbedding(context.Background(), data, SearchMode)
 			s.Equal([][]int8{{0, 1, 2, 3}, {1, 2, 3, 4}, {2, 3, 4, 5}}, ret)
 		}
 	}
@@ -171,7 +172,7 @@ func (s *CohereTextEmbeddingProviderSuite) TestEmbeddingDimNotMatch() {
 
 			// embedding dim not match
 			data := []string{"sentence", "sentence"}
-			_, err2 := provider.CallEmbedding(data, InsertMode)
+			_, err2 := provider.CallEmbedding(context.Background(), data, InsertMode)
 			s.Error(err2)
 		}
 	}
@@ -199,7 +200,7 @@ func (s *CohereTextEmbeddingProviderSuite) TestEmbeddingDimNotMatch() {
 
 		// embedding dim not match
 		data := []string{"sentence", "sentence"}
-		_, err2 := provider.CallEmbedding(data, InsertMode)
+		_, err2 := provider.CallEmbedding(context.Background(), data, InsertMode)
 		s.Error(err2)
 	}
 }
@@ -224,7 +225,7 @@ func (s *CohereTextEmbeddingProviderSuite) TestEmbeddingNumberNotMatch() {
 
 			// embedding dim not match
 			data := []string{"sentence", "sentence2"}
-			_, err2 := provider.CallEmbedding(data, InsertMode)
+			_, err2 := provider.CallEmbedding(context.Background(), data, InsertMode)
 			s.Error(err2)
 		}
 	}
@@ -251,7 +252,7 @@ func (s *CohereTextEmbeddingProviderSuite) TestEmbeddingNumberNotMatch() {
 
 		// embedding dim not match
 		data := []string{"sentence", "sentence2"}
-		_, err2 := provider.CallEmbedding(data, InsertMode)
+		_, err2 := provider.CallEmbedding(context.Background(), data, InsertMode)
 		s.Error(err2)
 	}
 }
@@ -335,6 +336,6 @@ func (s *CohereTextEmbeddingProviderSuite) TestRuntimeDimNotMatch() {
 	provider, err := createCohereProvider(ts.URL, s.schema.Fields[2], cohereProvider)
 	s.NoError(err)
 	data := []string{"sentence"}
-	_, err2 := provider.CallEmbedding(data, InsertMode)
+	_, err2 := provider.CallEmbedding(context.Background(), data, InsertMode)
 	s.Error(err2)
 }
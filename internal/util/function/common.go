@@ -94,13 +94,13 @@ const (
 // voyageAI
 const (
 	truncationParamKey string = "truncation"
-	voyageAIAKEnvStr   string = "MILVUSAI_VOYAGEAI_API_KEY"
+	VoyageAIAKEnvStr   string = "MILVUSAI_VOYAGEAI_API_KEY"
 )
 
 // cohere
 
 const (
-	cohereAIAKEnvStr string = "MILVUSAI_COHERE_API_KEY"
+	CohereAIAKEnvStr string = "MILVUSAI_COHERE_API_KEY"
 )
 
 // siliconflow
@@ -122,6 +122,33 @@ const (
 	EnableVllmEnvStr string = "MILVUSAI_ENABLE_VLLM"
 )
 
+// openai compatible and ollama
+
+const (
+	keepAliveParamKey string = "keep_alive"
+
+	openAICompatibleAKEnvStr string = "MILVUSAI_OPENAI_COMPATIBLE_API_KEY"
+	ollamaAKEnvStr           string = "MILVUSAI_OLLAMA_API_KEY"
+)
+
+// clip
+
+const (
+	clipAKEnvStr string = "MILVUSAI_CLIP_API_KEY"
+)
+
+// jina
+
+const (
+	JinaAIAKEnvStr string = "MILVUSAI_JINAAI_API_KEY"
+)
+
+// ParseAKAndURL resolves the api key and the url of the model services outside this package, such as the rerank models,
+// which share the credential config of the embedding providers.
+func ParseAKAndURL(credentials *credentials.Credentials, params []*commonpb.KeyValuePair, confParams map[string]string, apiKeyEnv string) (string, string, error) {
+	return parseAKAndURL(credentials, params, confParams, apiKeyEnv)
+}
+
 func parseAKAndURL(credentials *credentials.Credentials, params []*commonpb.KeyValuePair, confParams map[string]string, apiKeyEnv string) (string, string, error) {
 	// function param > yaml > env
 	var err error
@@ -158,6 +185,22 @@ func parseAKAndURL(credentials *credentials.Credentials, params []*commonpb.KeyV
 	return apiKey, url, nil
 }
 
+// addPrompt prepends the ingestion or search prompt to the texts according to the mode.
+func addPrompt(texts []string, ingestionPrompt string, searchPrompt string, mode TextEmbeddingMode) []string {
+	prompt := ingestionPrompt
+	if mode == SearchMode {
+		prompt = searchPrompt
+	}
+	if prompt == "" {
+		return texts
+	}
+	newTexts := make([]string, 0, len(texts))
+	for _, text := range texts {
+		newTexts = append(newTexts, prompt+text)
+	}
+	return newTexts
+}
+
 func parseAndCheckFieldDim(dimStr string, fieldDim int64, fieldName string) (int64, error) {
 	dim, err := strconv.ParseInt(dimStr, 10, 64)
 	if err != nil {
@@ -0,0 +1,434 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/flock"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const enableCacheParamKey string = "enable_cache"
+
+const (
+	spillDirPattern = "embedding-cache-"
+	// spillLockFile is held by the process owning the spill directory until it exits
+	spillLockFile = ".lock"
+	// the spill directory without lock file younger than spillDirGracePeriod may be being created
+	spillDirGracePeriod = time.Minute
+)
+
+// the type tags of the spilled embeddings
+const (
+	spilledFloatEmbedding  byte = 1
+	spilledInt8Embedding   byte = 2
+	spilledSparseEmbedding byte = 3
+)
+
+var (
+	globalEmbeddingCache     *embeddingCache
+	globalEmbeddingCacheOnce sync.Once
+)
+
+// getEmbeddingCache returns the embedding cache shared by all the text embedding functions of the node.
+func getEmbeddingCache() *embeddingCache {
+	globalEmbeddingCacheOnce.Do(func() {
+		cfg := &paramtable.Get().FunctionCfg
+		c, err := newEmbeddingCache(cfg.EmbeddingCacheCapacity.GetAsInt(), cfg.EmbeddingCacheSpillDir.GetValue(), cfg.EmbeddingCacheSpillCapacity.GetAsInt())
+		if err != nil {
+			log.Warn("failed to create embedding cache with disk spill, fallback to memory only", zap.Error(err))
+			c, _ = newEmbeddingCache(cfg.EmbeddingCacheCapacity.GetAsInt(), "", 0)
+		}
+		globalEmbeddingCache = c
+	})
+	return globalEmbeddingCache
+}
+
+// embeddingCache is a bounded LRU of the embeddings of single texts, the value is []float32, []int8
+// or a sparse float row. Entries evicted from memory are written to the spill dir if it is configured.
+type embeddingCache struct {
+	memory *lru.Cache[string, any]
+
+	spillDir  string
+	spillLock *flock.Flock
+	disk      *lru.Cache[string, struct{}]
+}
+
+func newEmbeddingCache(capacity int, spillDir string, spillCapacity int) (*embeddingCache, error) {
+	if capacity <= 0 {
+		return nil, fmt.Errorf("Embedding cache capacity must be positive, got [%d]", capacity)
+	}
+	c := &embeddingCache{}
+	if spillDir != "" && spillCapacity > 0 {
+		if err := os.MkdirAll(spillDir, os.ModePerm); err != nil {
+			return nil, err
+		}
+		removeStaleSpillDirs(spillDir)
+		// every process spills to its own directory, so the stale files of the other processes are never read
+		dir, err := os.MkdirTemp(spillDir, spillDirPattern)
+		if err != nil {
+			return nil, err
+		}
+		lock := flock.New(filepath.Join(dir, spillLockFile))
+		locked, err := lock.TryLock()
+		if err != nil || !locked {
+			os.RemoveAll(dir)
+			if err == nil {
+				err = fmt.Errorf("embedding spill dir %s is locked by another process", dir)
+			}
+			return nil, err
+		}
+		disk, err := lru.NewWithEvict(spillCapacity, func(key string, _ struct{}) {
+			os.Remove(filepath.Join(dir, key))
+		})
+		if err != nil {
+			lock.Close()
+			os.RemoveAll(dir)
+			return nil, err
+		}
+		c.spillDir = dir
+		c.spillLock = lock
+		c.disk = disk
+	}
+	memory, err := lru.NewWithEvict(capacity, c.spill)
+	if err != nil {
+		return nil, err
+	}
+	c.memory = memory
+	return c, nil
+}
+
+// removeStaleSpillDirs removes the spill directories left by the exited processes,
+// the directory of a running process can't be removed since its lock file is held.
+func removeStaleSpillDirs(spillDir string) {
+	dirs, err := filepath.Glob(filepath.Join(spillDir, spillDirPattern+"*"))
+	if err != nil {
+		return
+	}
+	for _, dir := range dirs {
+		if _, err := os.Stat(filepath.Join(dir, spillLockFile)); err != nil {
+			info, err := os.Stat(dir)
+			if err != nil || time.Since(info.ModTime()) < spillDirGracePeriod {
+				continue
+			}
+		}
+		lock := flock.New(filepath.Join(dir, spillLockFile))
+		if locked, err := lock.TryLock(); err != nil || !locked {
+			continue
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			log.Warn("failed to remove stale embedding spill dir", zap.String("dir", dir), zap.Error(err))
+		}
+		lock.Close()
+	}
+}
+
+func (c *embeddingCache) spill(key string, value any) {
+	if c.disk == nil {
+		return
+	}
+	data, err := encodeEmbedding(value)
+	if err != nil {
+		log.Warn("failed to encode embedding", zap.Error(err))
+		return
+	}
+	if err := os.WriteFile(filepath.Join(c.spillDir, key), data, 0o600); err != nil {
+		log.Warn("failed to spill embedding to disk", zap.String("dir", c.spillDir), zap.Error(err))
+		return
+	}
+	c.disk.Add(key, struct{}{})
+}
+
+func (c *embeddingCache) Get(key string) (any, bool) {
+	if v, ok := c.memory.Get(key); ok {
+		return v, true
+	}
+	if c.disk == nil || !c.disk.Contains(key) {
+		return nil, false
+	}
+	data, err := os.ReadFile(filepath.Join(c.spillDir, key))
+	c.disk.Remove(key)
+	if err != nil {
+		return nil, false
+	}
+	v, err := decodeEmbedding(data)
+	if err != nil {
+		return nil, false
+	}
+	c.memory.Add(key, v)
+	return v, true
+}
+
+func (c *embeddingCache) Add(key string, value any) {
+	c.memory.Add(key, value)
+}
+
+func encodeEmbedding(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case []float32:
+		data := make([]byte, 1+4*len(v))
+		data[0] = spilledFloatEmbedding
+		for i, f := range v {
+			binary.LittleEndian.PutUint32(data[1+4*i:], math.Float32bits(f))
+		}
+		return data, nil
+	case []int8:
+		data := make([]byte, 1+len(v))
+		data[0] = spilledInt8Embedding
+		for i, b := range v {
+			data[1+i] = byte(b)
+		}
+		return data, nil
+	case []byte:
+		return append([]byte{spilledSparseEmbedding}, v...), nil
+	default:
+		return nil, fmt.Errorf("Unsupport embedding type: %s", reflect.TypeOf(value).String())
+	}
+}
+
+func decodeEmbedding(data []byte) (any, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty spilled embedding")
+	}
+	body := data[1:]
+	switch data[0] {
+	case spilledFloatEmbedding:
+		if len(body)%4 != 0 {
+			return nil, errors.New("corrupted spilled float embedding")
+		}
+		v := make([]float32, len(body)/4)
+		for i := range v {
+			v[i] = math.Float32frombits(binary.LittleEndian.Uint32(body[4*i:]))
+		}
+		return v, nil
+	case spilledInt8Embedding:
+		v := make([]int8, len(body))
+		for i, b := range body {
+			v[i] = int8(b)
+		}
+		return v, nil
+	case spilledSparseEmbedding:
+		return append([]byte{}, body...), nil
+	default:
+		return nil, fmt.Errorf("unknown spilled embedding type: %d", data[0])
+	}
+}
+
+// embeddingCacheNamespace identifies the embeddings produced by the same model with the same settings,
+// all the function params except enable_cache are part of it since prompts, truncation and so on change the result.
+func embeddingCacheNamespace(provider string, fieldSchema *schemapb.FieldSchema, params []*commonpb.KeyValuePair) string {
+	var model string
+	kvs := make([]string, 0, len(params))
+	for _, param := range params {
+		key := strings.ToLower(param.Key)
+		switch key {
+		case enableCacheParamKey:
+			continue
+		case modelNameParamKey:
+			model = param.Value
+		}
+		kvs = append(kvs, key+"="+param.Value)
+	}
+	sort.Strings(kvs)
+	digest := sha256.Sum256([]byte(strings.Join(kvs, "\x00")))
+	var dim int64
+	if typeutil.IsDenseFloatVectorType(fieldSchema.GetDataType()) || fieldSchema.GetDataType() == schemapb.DataType_Int8Vector {
+		dim, _ = typeutil.GetDim(fieldSchema)
+	}
+	return fmt.Sprintf("%s/%s/%s/%d/%s", provider, model, fieldSchema.GetDataType().String(), dim, hex.EncodeToString(digest[:8]))
+}
+
+func parseEnableCache(params []*commonpb.KeyValuePair) (bool, error) {
+	for _, param := range params {
+		if strings.ToLower(param.Key) == enableCacheParamKey {
+			enable, err := strconv.ParseBool(param.Value)
+			if err != nil {
+				return false, fmt.Errorf("[%s param's value: %s] is invalid, only supports: [true/false]", enableCacheParamKey, param.Value)
+			}
+			return enable, nil
+		}
+	}
+	return paramtable.Get().FunctionCfg.EmbeddingCacheEnabled.GetAsBool(), nil
+}
+
+// cachedEmbeddingProvider consults the embedding cache before calling the provider,
+// only the texts missing in the cache are sent to the model service.
+type cachedEmbeddingProvider struct {
+	textEmbeddingProvider
+
+	cache     *embeddingCache
+	namespace string
+
+	collectionName string
+	providerName   string
+	functionName   string
+}
+
+func newCachedEmbeddingProvider(provider textEmbeddingProvider, cache *embeddingCache, namespace string, base *FunctionBase) *cachedEmbeddingProvider {
+	return &cachedEmbeddingProvider{
+		textEmbeddingProvider: provider,
+		cache:                 cache,
+		namespace:             namespace,
+		collectionName:        base.collectionName,
+		providerName:          base.provider,
+		functionName:          base.functionName,
+	}
+}
+
+func (provider *cachedEmbeddingProvider) key(text string, mode TextEmbeddingMode) string {
+	h := sha256.New()
+	h.Write([]byte(provider.namespace))
+	h.Write([]byte{0, byte(mode), 0})
+	h.Write([]byte(text))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (provider *cachedEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, mode TextEmbeddingMode) (any, error) {
+	keys := make([]string, len(texts))
+	rows := make([]any, len(texts))
+	pending := make(map[string][]int)
+	missTexts := make([]string, 0)
+	for i, text := range texts {
+		keys[i] = provider.key(text, mode)
+		if v, ok := provider.cache.Get(keys[i]); ok {
+			rows[i] = v
+			continue
+		}
+		if _, ok := pending[keys[i]]; !ok {
+			missTexts = append(missTexts, text)
+		}
+		pending[keys[i]] = append(pending[keys[i]], i)
+	}
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionEmbeddingCacheCounter.WithLabelValues(nodeID, provider.collectionName, provider.providerName, provider.functionName, metrics.CacheHitLabel).Add(float64(len(texts) - len(missTexts)))
+	metrics.ProxyFunctionEmbeddingCacheCounter.WithLabelValues(nodeID, provider.collectionName, provider.providerName, provider.functionName, metrics.CacheMissLabel).Add(float64(len(missTexts)))
+
+	if len(missTexts) > 0 {
+		embds, err := provider.textEmbeddingProvider.CallEmbedding(ctx, missTexts, mode)
+		if err != nil {
+			return nil, err
+		}
+		missRows, err := splitEmbeddings(embds)
+		if err != nil {
+			return nil, err
+		}
+		if len(missRows) != len(missTexts) {
+			return nil, fmt.Errorf("Get embedding failed. The number of texts and embeddings does not match text:[%d], embedding:[%d]", len(missTexts), len(missRows))
+		}
+		for i, text := range missTexts {
+			key := provider.key(text, mode)
+			provider.cache.Add(key, missRows[i])
+			for _, idx := range pending[key] {
+				rows[idx] = missRows[i]
+			}
+		}
+	}
+	return mergeEmbeddings(rows)
+}
+
+func splitEmbeddings(embds any) ([]any, error) {
+	switch embds := embds.(type) {
+	case [][]float32:
+		rows := make([]any, len(embds))
+		for i, emb := range embds {
+			rows[i] = emb
+		}
+		return rows, nil
+	case [][]int8:
+		rows := make([]any, len(embds))
+		for i, emb := range embds {
+			rows[i] = emb
+		}
+		return rows, nil
+	case *schemapb.SparseFloatArray:
+		rows := make([]any, len(embds.GetContents()))
+		for i, emb := range embds.GetContents() {
+			rows[i] = emb
+		}
+		return rows, nil
+	default:
+		return nil, fmt.Errorf("Unsupport embedding type: %s", reflect.TypeOf(embds).String())
+	}
+}
+
+func mergeEmbeddings(rows []any) (any, error) {
+	if len(rows) == 0 {
+		return [][]float32{}, nil
+	}
+	switch rows[0].(type) {
+	case []float32:
+		embds := make([][]float32, 0, len(rows))
+		for _, row := range rows {
+			emb, ok := row.([]float32)
+			if !ok {
+				return nil, errors.New("Inconsistent embedding types in the embedding cache")
+			}
+			embds = append(embds, emb)
+		}
+		return embds, nil
+	case []int8:
+		embds := make([][]int8, 0, len(rows))
+		for _, row := range rows {
+			emb, ok := row.([]int8)
+			if !ok {
+				return nil, errors.New("Inconsistent embedding types in the embedding cache")
+			}
+			embds = append(embds, emb)
+		}
+		return embds, nil
+	case []byte:
+		embds := &schemapb.SparseFloatArray{Contents: make([][]byte, 0, len(rows))}
+		for _, row := range rows {
+			emb, ok := row.([]byte)
+			if !ok {
+				return nil, errors.New("Inconsistent embedding types in the embedding cache")
+			}
+			if dim := typeutil.SparseFloatRowDim(emb); dim > embds.Dim {
+				embds.Dim = dim
+			}
+			embds.Contents = append(embds.Contents, emb)
+		}
+		return embds, nil
+	default:
+		return nil, fmt.Errorf("Unsupport embedding type: %s", reflect.TypeOf(rows[0]).String())
+	}
+}
@@ -0,0 +1,303 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function/models/tei"
+	"github.com/milvus-io/milvus/internal/util/function/models/utils"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func TestEmbeddingCache(t *testing.T) {
+	suite.Run(t, new(EmbeddingCacheSuite))
+}
+
+type EmbeddingCacheSuite struct {
+	suite.Suite
+}
+
+func (s *EmbeddingCacheSuite) SetupTest() {
+	paramtable.Init()
+}
+
+// countingEmbeddingProvider sends requestsPerCall http requests for every call, at least one.
+type countingEmbeddingProvider struct {
+	calls           [][]string
+	requestsPerCall int
+}
+
+func (p *countingEmbeddingProvider) MaxBatch() int {
+	return 100
+}
+
+func (p *countingEmbeddingProvider) FieldDim() int64 {
+	return 2
+}
+
+func (p *countingEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, mode TextEmbeddingMode) (any, error) {
+	for i := 0; i < max(p.requestsPerCall, 1); i++ {
+		if err := utils.WaitRequestLimiter(ctx); err != nil {
+			return nil, err
+		}
+	}
+	p.calls = append(p.calls, texts)
+	embds := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		embds = append(embds, []float32{float32(len(text)), float32(mode)})
+	}
+	return embds, nil
+}
+
+func (s *EmbeddingCacheSuite) TestCallEmbedding() {
+	c, err := newEmbeddingCache(10, "", 0)
+	s.NoError(err)
+	inner := &countingEmbeddingProvider{}
+	provider := newCachedEmbeddingProvider(inner, c, "ns", &FunctionBase{})
+
+	r, err := provider.CallEmbedding(context.Background(), []string{"a", "bb", "a"}, InsertMode)
+	s.NoError(err)
+	s.Equal([][]float32{{1, 0}, {2, 0}, {1, 0}}, r)
+	// the duplicated text is only sent once
+	s.Equal([][]string{{"a", "bb"}}, inner.calls)
+
+	r, err = provider.CallEmbedding(context.Background(), []string{"bb", "ccc"}, InsertMode)
+	s.NoError(err)
+	s.Equal([][]float32{{2, 0}, {3, 0}}, r)
+	s.Equal([]string{"ccc"}, inner.calls[1])
+
+	// the search embeddings are cached separately since the prompts may differ
+	r, err = provider.CallEmbedding(context.Background(), []string{"a"}, SearchMode)
+	s.NoError(err)
+	s.Equal([][]float32{{1, 1}}, r)
+	s.Equal(3, len(inner.calls))
+
+	// all hit
+	_, err = provider.CallEmbedding(context.Background(), []string{"a", "bb", "ccc"}, InsertMode)
+	s.NoError(err)
+	s.Equal(3, len(inner.calls))
+
+	// functions with different namespaces don't share the embeddings
+	other := newCachedEmbeddingProvider(inner, c, "other", &FunctionBase{})
+	_, err = other.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+	s.NoError(err)
+	s.Equal(4, len(inner.calls))
+}
+
+func (s *EmbeddingCacheSuite) TestSpill() {
+	c, err := newEmbeddingCache(1, s.T().TempDir(), 10)
+	s.NoError(err)
+	c.Add("k1", []float32{0.1, 0.2})
+	c.Add("k2", []int8{1, -1})
+	s.Equal(1, c.memory.Len())
+	s.Equal(1, c.disk.Len())
+
+	v, ok := c.Get("k1")
+	s.True(ok)
+	s.Equal([]float32{0.1, 0.2}, v)
+	// k2 is spilled after k1 is loaded back into memory
+	v, ok = c.Get("k2")
+	s.True(ok)
+	s.Equal([]int8{1, -1}, v)
+
+	_, ok = c.Get("k3")
+	s.False(ok)
+
+	// memory only
+	c, err = newEmbeddingCache(1, "", 0)
+	s.NoError(err)
+	c.Add("k1", []float32{0.1})
+	c.Add("k2", []float32{0.2})
+	_, ok = c.Get("k1")
+	s.False(ok)
+
+	_, err = newEmbeddingCache(0, "", 0)
+	s.Error(err)
+}
+
+func (s *EmbeddingCacheSuite) TestRemoveStaleSpillDirs() {
+	spillDir := s.T().TempDir()
+	running, err := newEmbeddingCache(1, spillDir, 10)
+	s.NoError(err)
+	running.Add("k1", []float32{0.1})
+	running.Add("k2", []float32{0.2})
+
+	// the dir of an exited process
+	exited := filepath.Join(spillDir, spillDirPattern+"exited")
+	s.NoError(os.MkdirAll(exited, os.ModePerm))
+	s.NoError(os.WriteFile(filepath.Join(exited, spillLockFile), nil, 0o600))
+	// the dir without lock file is removed after the grace period
+	creating := filepath.Join(spillDir, spillDirPattern+"creating")
+	s.NoError(os.MkdirAll(creating, os.ModePerm))
+	legacy := filepath.Join(spillDir, spillDirPattern+"legacy")
+	s.NoError(os.MkdirAll(legacy, os.ModePerm))
+	past := time.Now().Add(-2 * spillDirGracePeriod)
+	s.NoError(os.Chtimes(legacy, past, past))
+
+	c, err := newEmbeddingCache(1, spillDir, 10)
+	s.NoError(err)
+	s.NotEqual(running.spillDir, c.spillDir)
+	s.NoDirExists(exited)
+	s.NoDirExists(legacy)
+	s.DirExists(creating)
+	// the dir of the running process is kept
+	v, ok := running.Get("k1")
+	s.True(ok)
+	s.Equal([]float32{0.1}, v)
+
+	// the dir is removed once the process exits
+	s.NoError(running.spillLock.Close())
+	removeStaleSpillDirs(spillDir)
+	s.NoDirExists(running.spillDir)
+	s.DirExists(c.spillDir)
+}
+
+func (s *EmbeddingCacheSuite) TestEncodeEmbedding() {
+	sparse := typeutil.CreateAndSortSparseFloatRow(map[uint32]float32{3: 0.5, 7: 1.5})
+	for _, v := range []any{[]float32{0.1, -0.2}, []int8{1, -128, 127}, sparse} {
+		data, err := encodeEmbedding(v)
+		s.NoError(err)
+		decoded, err := decodeEmbedding(data)
+		s.NoError(err)
+		s.Equal(v, decoded)
+	}
+	_, err := encodeEmbedding([]float64{0.1})
+	s.Error(err)
+	_, err = decodeEmbedding([]byte{})
+	s.Error(err)
+	_, err = decodeEmbedding([]byte{spilledFloatEmbedding, 1})
+	s.Error(err)
+	_, err = decodeEmbedding([]byte{100})
+	s.Error(err)
+}
+
+func (s *EmbeddingCacheSuite) TestMergeSparseEmbeddings() {
+	rows := []any{
+		typeutil.CreateAndSortSparseFloatRow(map[uint32]float32{3: 0.5}),
+		typeutil.CreateAndSortSparseFloatRow(map[uint32]float32{9: 0.5}),
+	}
+	r, err := mergeEmbeddings(rows)
+	s.NoError(err)
+	s.Equal(int64(10), r.(*schemapb.SparseFloatArray).Dim)
+
+	_, err = mergeEmbeddings([]any{[]float32{0.1}, []int8{1}})
+	s.Error(err)
+}
+
+func (s *EmbeddingCacheSuite) TestNamespace() {
+	field := &schemapb.FieldSchema{
+		FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}},
+	}
+	ns1 := embeddingCacheNamespace(openAIProvider, field, []*commonpb.KeyValuePair{
+		{Key: modelNameParamKey, Value: "text-embedding-3-small"},
+		{Key: enableCacheParamKey, Value: "true"},
+	})
+	ns2 := embeddingCacheNamespace(openAIProvider, field, []*commonpb.KeyValuePair{
+		{Key: modelNameParamKey, Value: "text-embedding-3-small"},
+	})
+	s.Equal(ns1, ns2)
+	ns3 := embeddingCacheNamespace(openAIProvider, field, []*commonpb.KeyValuePair{
+		{Key: modelNameParamKey, Value: "text-embedding-3-small"},
+		{Key: userParamKey, Value: "u"},
+	})
+	s.NotEqual(ns1, ns3)
+}
+
+func (s *EmbeddingCacheSuite) TestParseEnableCache() {
+	enable, err := parseEnableCache(nil)
+	s.NoError(err)
+	s.False(enable)
+
+	paramtable.Get().Save(paramtable.Get().FunctionCfg.EmbeddingCacheEnabled.Key, "true")
+	defer paramtable.Get().Reset(paramtable.Get().FunctionCfg.EmbeddingCacheEnabled.Key)
+	enable, err = parseEnableCache(nil)
+	s.NoError(err)
+	s.True(enable)
+
+	enable, err = parseEnableCache([]*commonpb.KeyValuePair{{Key: enableCacheParamKey, Value: "false"}})
+	s.NoError(err)
+	s.False(enable)
+
+	_, err = parseEnableCache([]*commonpb.KeyValuePair{{Key: enableCacheParamKey, Value: "invalid"}})
+	s.Error(err)
+}
+
+func (s *EmbeddingCacheSuite) TestTextEmbeddingFunction() {
+	numRequests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		numRequests++
+		var req tei.EmbeddingRequest
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &req)
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(mockEmbedding[float32](req.Inputs, 4))
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	schema := &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}},
+			},
+		},
+	}
+	runner, err := NewTextEmbeddingFunction(schema, &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             schemapb.FunctionType_TextEmbedding,
+		InputFieldNames:  []string{"text"},
+		OutputFieldNames: []string{"vector"},
+		InputFieldIds:    []int64{101},
+		OutputFieldIds:   []int64{102},
+		Params: []*commonpb.KeyValuePair{
+			{Key: Provider, Value: teiProvider},
+			{Key: EndpointParamKey, Value: ts.URL},
+			{Key: enableCacheParamKey, Value: "true"},
+		},
+	})
+	s.NoError(err)
+	_, ok := runner.embProvider.(*cachedEmbeddingProvider)
+	s.True(ok)
+
+	_, err = runner.ProcessInsert(context.Background(), createData([]string{"cached sentence"}))
+	s.NoError(err)
+	ret, err := runner.ProcessInsert(context.Background(), createData([]string{"cached sentence"}))
+	s.NoError(err)
+	s.Equal(1, numRequests)
+	s.Equal(4, len(ret[0].GetVectors().GetFloatVector().GetData()))
+}
@@ -38,7 +38,7 @@ func NewFunctionRunner(coll *schemapb.CollectionSchema, schema *schemapb.Functio
 	switch schema.GetType() {
 	case schemapb.FunctionType_BM25:
 		return NewBM25FunctionRunner(coll, schema)
-	case schemapb.FunctionType_TextEmbedding:
+	case schemapb.FunctionType_TextEmbedding, schemapb.FunctionType_MinHash, schemapb.FunctionType_MultimodalEmbedding, schemapb.FunctionType_TextChunking:
 		return nil, nil
 	default:
 		return nil, fmt.Errorf("unknown functionRunner type %s", schema.GetType().String())
@@ -53,8 +53,21 @@ type Runner interface {
 	ProcessBulkInsert(inputs []storage.FieldData) (map[storage.FieldID]storage.FieldData, error)
 }
 
+// IDAllocator allocates the parent ids of the TextChunking function.
+type IDAllocator interface {
+	Alloc(count uint32) (int64, int64, error)
+}
+
 type FunctionExecutor struct {
 	runners map[int64]Runner
+
+	// chunker expands the documents into chunks before the other functions run
+	chunker     *TextChunkingFunction
+	idAllocator IDAllocator
+	// the max size of the insert msg expanded by the chunker, -1 means no limit
+	maxInsertSize int
+	// the parent ids of the documents chunked by the last ProcessInsert
+	parentIDs []int64
 }
 
 func createFunction(coll *schemapb.CollectionSchema, schema *schemapb.FunctionSchema) (Runner, error) {
@@ -67,6 +80,18 @@ func createFunction(coll *schemapb.CollectionSchema, schema *schemapb.FunctionSc
 			return nil, err
 		}
 		return f, nil
+	case schemapb.FunctionType_MinHash:
+		f, err := NewMinHashFunction(coll, schema)
+		if err != nil {
+			return nil, err
+		}
+		return f, nil
+	case schemapb.FunctionType_MultimodalEmbedding:
+		f, err := NewMultimodalEmbeddingFunction(coll, schema)
+		if err != nil {
+			return nil, err
+		}
+		return f, nil
 	default:
 		return nil, fmt.Errorf("unknown functionRunner type %s", schema.GetType().String())
 	}
@@ -75,6 +100,12 @@ func createFunction(coll *schemapb.CollectionSchema, schema *schemapb.FunctionSc
 // Since bm25 and embedding are implemented in different ways, the bm25 function is not verified here.
 func ValidateFunctions(schema *schemapb.CollectionSchema) error {
 	for _, fSchema := range schema.Functions {
+		if fSchema.GetType() == schemapb.FunctionType_TextChunking {
+			if _, err := NewTextChunkingFunction(schema, fSchema); err != nil {
+				return err
+			}
+			continue
+		}
 		f, err := createFunction(schema, fSchema)
 		if err != nil {
 			return err
@@ -93,9 +124,18 @@ func ValidateFunctions(schema *schemapb.CollectionSchema) error {
 
 func NewFunctionExecutor(schema *schemapb.CollectionSchema) (*FunctionExecutor, error) {
 	executor := &FunctionExecutor{
-		runners: make(map[int64]Runner),
+		runners:       make(map[int64]Runner),
+		maxInsertSize: -1,
 	}
 	for _, fSchema := range schema.Functions {
+		if fSchema.GetType() == schemapb.FunctionType_TextChunking {
+			chunker, err := NewTextChunkingFunction(schema, fSchema)
+			if err != nil {
+				return nil, err
+			}
+			executor.chunker = chunker
+			continue
+		}
 		runner, err := createFunction(schema, fSchema)
 		if err != nil {
 			return nil, err
@@ -107,6 +147,64 @@ func NewFunctionExecutor(schema *schemapb.CollectionSchema) (*FunctionExecutor,
 	return executor, nil
 }
 
+// SetIDAllocator sets the allocator of the parent ids, it's required if the collection has a TextChunking function.
+func (executor *FunctionExecutor) SetIDAllocator(idAllocator IDAllocator) {
+	executor.idAllocator = idAllocator
+}
+
+// SetMaxInsertSize sets the max size of the insert msg expanded by the TextChunking function, the msg
+// exceeding it is rejected before the chunks are embedded. -1 means no limit.
+func (executor *FunctionExecutor) SetMaxInsertSize(maxInsertSize int) {
+	executor.maxInsertSize = maxInsertSize
+}
+
+// GetParentIDs returns the parent ids of the documents chunked by the last ProcessInsert in the order
+// of the documents, nil if the collection has no TextChunking function.
+func (executor *FunctionExecutor) GetParentIDs() []int64 {
+	return executor.parentIDs
+}
+
+// processChunking replaces every document of the msg with the rows of its chunks, the chunks of the same
+// document share a parent id.
+func (executor *FunctionExecutor) processChunking(ctx context.Context, msg *msgstream.InsertMsg) error {
+	if executor.idAllocator == nil {
+		return errors.New("The id allocator of TextChunking function is not set")
+	}
+	tr := timerecord.NewTimeRecorder("function ProcessChunking")
+	numDocs := msg.NRows()
+	docs, err := executor.chunker.GetDocuments(msg.GetFieldsData())
+	if err != nil {
+		return err
+	}
+	// every document takes a parent id, so the ids are allocated only if the documents match the rows of msg
+	if uint64(len(docs)) != numDocs {
+		return merr.WrapErrParameterInvalidMsg("the number of documents %d in the input field of TextChunking function does not match the num rows %d", len(docs), numDocs)
+	}
+	parentIDBegin, _, err := executor.idAllocator.Alloc(uint32(numDocs))
+	if err != nil {
+		return err
+	}
+	fieldsData, numRows, err := executor.chunker.Expand(msg.GetFieldsData(), parentIDBegin)
+	if err != nil {
+		return err
+	}
+	msg.FieldsData = fieldsData
+	msg.NumRows = uint64(numRows)
+	if executor.maxInsertSize != -1 && msg.Size() > executor.maxInsertSize {
+		return merr.WrapErrAsInputError(merr.WrapErrParameterTooLarge(
+			fmt.Sprintf("insert request size exceeds maxInsertSize after %d documents are chunked into %d rows", numDocs, numRows)))
+	}
+	executor.parentIDs = make([]int64, 0, numDocs)
+	for i := uint64(0); i < numDocs; i++ {
+		executor.parentIDs = append(executor.parentIDs, parentIDBegin+int64(i))
+	}
+
+	runner := executor.chunker
+	metrics.ProxyFunctionlatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), runner.GetCollectionName(), runner.GetFunctionTypeName(), runner.GetFunctionProvider(), runner.GetFunctionName()).Observe(float64(tr.RecordSpan().Milliseconds()))
+	tr.CtxElapse(ctx, "function ProcessChunking done")
+	return nil
+}
+
 func (executor *FunctionExecutor) processSingleFunction(ctx context.Context, runner Runner, msg *msgstream.InsertMsg) ([]*schemapb.FieldData, error) {
 	inputs := make([]*schemapb.FieldData, 0, len(runner.GetSchema().GetInputFieldNames()))
 	for _, name := range runner.GetSchema().GetInputFieldNames() {
@@ -132,6 +230,11 @@ func (executor *FunctionExecutor) processSingleFunction(ctx context.Context, run
 }
 
 func (executor *FunctionExecutor) ProcessInsert(ctx context.Context, msg *msgstream.InsertMsg) error {
+	if executor.chunker != nil {
+		if err := executor.processChunking(ctx, msg); err != nil {
+			return err
+		}
+	}
 	numRows := msg.NumRows
 	for _, runner := range executor.runners {
 		if numRows > uint64(runner.MaxBatch()) {
@@ -269,11 +372,14 @@ func (executor *FunctionExecutor) processSingleBulkInsert(runner Runner, data *s
 }
 
 func (executor *FunctionExecutor) ProcessBulkInsert(data *storage.InsertData) error {
+	if executor.chunker != nil {
+		return fmt.Errorf("TextChunking function [%s] does not support bulk insert", executor.chunker.GetFunctionName())
+	}
 	// Since concurrency has already been used in the outer layer, only a serial logic access model is used here.
 	for _, runner := range executor.runners {
 		output, err := executor.processSingleBulkInsert(runner, data)
 		if err != nil {
-			return nil
+			return err
 		}
 		for k, v := range output {
 			data.Data[k] = v
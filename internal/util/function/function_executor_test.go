@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/function/models/openai"
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
@@ -210,6 +211,20 @@ func (s *FunctionExecutorSuite) TestErrorSchema() {
 	s.Error(err)
 }
 
+func (s *FunctionExecutorSuite) TestProcessBulkInsertError() {
+	ts := CreateOpenAIEmbeddingServer()
+	defer ts.Close()
+	schema := s.creataSchema(ts.URL)
+	exec, err := NewFunctionExecutor(schema)
+	s.NoError(err)
+
+	// the input field of the functions is missing
+	data := &storage.InsertData{Data: map[storage.FieldID]storage.FieldData{}}
+	err = exec.ProcessBulkInsert(data)
+	s.Error(err)
+	s.Empty(data.Data)
+}
+
 func (s *FunctionExecutorSuite) TestInternalPrcessSearch() {
 	ts := CreateOpenAIEmbeddingServer()
 	defer ts.Close()
@@ -45,3 +45,13 @@ func HasNonBM25Functions(functions []*schemapb.FunctionSchema, outputIDs []int64
 	}
 	return false
 }
+
+// GetTextChunkingFunction returns the TextChunking function of the collection, nil if there is none.
+func GetTextChunkingFunction(functions []*schemapb.FunctionSchema) *schemapb.FunctionSchema {
+	for _, fSchema := range functions {
+		if fSchema.GetType() == schemapb.FunctionType_TextChunking {
+			return fSchema
+		}
+	}
+	return nil
+}
@@ -0,0 +1,336 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/ctokenizer"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const (
+	minHashShingleSizeKey = "shingle_size"
+	minHashNumHashesKey   = "num_hashes"
+	minHashSeedKey        = "seed"
+
+	defaultMinHashShingleSize = 3
+	defaultMinHashSeed        = 1234
+
+	// every hash value of the signature occupies 32 bits of the binary vector.
+	minHashElementBits = 32
+	// the mersenne prime 2^61-1, used as the modulus of the universal hash permutations.
+	minHashPrime    uint64 = (1 << 61) - 1
+	minHashProvider        = "milvus"
+)
+
+func MinHashOutputsCheck(fields []*schemapb.FieldSchema) error {
+	if len(fields) != 1 || fields[0].GetDataType() != schemapb.DataType_BinaryVector {
+		return errors.New("MinHash function output field must be a BinaryVector field")
+	}
+	dim, err := typeutil.GetDim(fields[0])
+	if err != nil {
+		return err
+	}
+	if dim <= 0 || dim%minHashElementBits != 0 {
+		return fmt.Errorf("The dim of MinHash function output field must be a positive multiple of %d, got %d", minHashElementBits, dim)
+	}
+	return nil
+}
+
+// MinHash function
+// Input: string
+// Output: binary vector, the MinHash signature of the shingles of analyzed tokens, num_hashes * 32 bits
+type MinHashFunction struct {
+	FunctionBase
+
+	analyzerParams string
+	shingleSize    int
+	numHashes      int
+	// the coefficients of the hash permutations: (a * x + b) mod p
+	permA []uint64
+	permB []uint64
+}
+
+func NewMinHashFunction(coll *schemapb.CollectionSchema, functionSchema *schemapb.FunctionSchema) (*MinHashFunction, error) {
+	if len(functionSchema.GetInputFieldNames()) != 1 || len(functionSchema.GetOutputFieldNames()) != 1 {
+		return nil, fmt.Errorf("MinHash function should only have one input and one output field, but now is %d and %d",
+			len(functionSchema.GetInputFieldNames()), len(functionSchema.GetOutputFieldNames()))
+	}
+
+	base := FunctionBase{
+		schema:           functionSchema,
+		collectionName:   coll.GetName(),
+		functionTypeName: functionSchema.GetType().String(),
+		functionName:     functionSchema.GetName(),
+		provider:         minHashProvider,
+	}
+	var inputField *schemapb.FieldSchema
+	for _, field := range coll.GetFields() {
+		if field.GetName() == functionSchema.GetOutputFieldNames()[0] {
+			base.outputFields = append(base.outputFields, field)
+		}
+		if field.GetName() == functionSchema.GetInputFieldNames()[0] {
+			inputField = field
+		}
+	}
+	if inputField == nil || len(base.outputFields) != 1 {
+		return nil, fmt.Errorf("The collection [%s]'s information is wrong, function [%s]'s inputs or outputs does not match the schema",
+			coll.GetName(), functionSchema.GetName())
+	}
+	if !isValidInputDataType(inputField.GetDataType()) {
+		return nil, fmt.Errorf("MinHash function only supports varchar or text field as input field, but got %s", inputField.GetDataType().String())
+	}
+	if err := MinHashOutputsCheck(base.outputFields); err != nil {
+		return nil, err
+	}
+	dim, _ := typeutil.GetDim(base.outputFields[0])
+
+	runner := &MinHashFunction{
+		FunctionBase:   base,
+		analyzerParams: getAnalyzerParams(inputField),
+		shingleSize:    defaultMinHashShingleSize,
+		numHashes:      int(dim / minHashElementBits),
+	}
+	seed := int64(defaultMinHashSeed)
+	for _, param := range functionSchema.GetParams() {
+		switch strings.ToLower(param.GetKey()) {
+		case minHashShingleSizeKey:
+			shingleSize, err := strconv.Atoi(param.GetValue())
+			if err != nil || shingleSize <= 0 {
+				return nil, fmt.Errorf("MinHash function param [%s] must be a positive integer, got [%s]", minHashShingleSizeKey, param.GetValue())
+			}
+			runner.shingleSize = shingleSize
+		case minHashNumHashesKey:
+			numHashes, err := strconv.Atoi(param.GetValue())
+			if err != nil || numHashes != runner.numHashes {
+				return nil, fmt.Errorf("MinHash function param [%s] must be equal to dim/%d of the output field, which is %d, got [%s]",
+					minHashNumHashesKey, minHashElementBits, runner.numHashes, param.GetValue())
+			}
+		case minHashSeedKey:
+			v, err := strconv.ParseInt(param.GetValue(), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("MinHash function param [%s] must be an integer, got [%s]", minHashSeedKey, param.GetValue())
+			}
+			seed = v
+		case analyzerParams:
+			runner.analyzerParams = param.GetValue()
+		default:
+			return nil, fmt.Errorf("Unsupported MinHash function param [%s]", param.GetKey())
+		}
+	}
+
+	// the permutations are generated from the seed, so the signatures of the same text are always the same.
+	r := rand.New(rand.NewSource(seed))
+	runner.permA = make([]uint64, runner.numHashes)
+	runner.permB = make([]uint64, runner.numHashes)
+	for i := 0; i < runner.numHashes; i++ {
+		runner.permA[i] = uint64(r.Int63n(int64(minHashPrime-1))) + 1
+		runner.permB[i] = uint64(r.Int63n(int64(minHashPrime)))
+	}
+	return runner, nil
+}
+
+func (runner *MinHashFunction) Check() error {
+	return ctokenizer.ValidateTokenizer(runner.analyzerParams)
+}
+
+func (runner *MinHashFunction) MaxBatch() int {
+	return math.MaxInt32
+}
+
+func (runner *MinHashFunction) GetCollectionName() string {
+	return runner.collectionName
+}
+
+func (runner *MinHashFunction) GetFunctionProvider() string {
+	return runner.provider
+}
+
+func (runner *MinHashFunction) GetFunctionTypeName() string {
+	return runner.functionTypeName
+}
+
+func (runner *MinHashFunction) GetFunctionName() string {
+	return runner.functionName
+}
+
+// shingles returns the hashes of the shingles, a shingle is shingleSize consecutive tokens.
+// If there are fewer tokens than shingleSize, all the tokens form a single shingle.
+func (runner *MinHashFunction) shingles(tokens []string) []uint64 {
+	if len(tokens) == 0 {
+		return nil
+	}
+	size := runner.shingleSize
+	if len(tokens) < size {
+		size = len(tokens)
+	}
+	hashes := make([]uint64, 0, len(tokens)-size+1)
+	h := fnv.New64a()
+	for i := 0; i+size <= len(tokens); i++ {
+		h.Reset()
+		for j := i; j < i+size; j++ {
+			if j > i {
+				h.Write([]byte{0})
+			}
+			h.Write([]byte(tokens[j]))
+		}
+		hashes = append(hashes, h.Sum64()%minHashPrime)
+	}
+	return hashes
+}
+
+// signature computes the MinHash signature of the shingles, each hash value is encoded as little endian uint32.
+// The signature of the text without any token is filled with the max value.
+func (runner *MinHashFunction) signature(shingles []uint64, dst []byte) {
+	for i := 0; i < runner.numHashes; i++ {
+		minValue := uint64(math.MaxUint32)
+		for _, x := range shingles {
+			hi, lo := bits.Mul64(runner.permA[i], x)
+			v := bits.Rem64(hi, lo, minHashPrime) + runner.permB[i]
+			if v >= minHashPrime {
+				v -= minHashPrime
+			}
+			v &= math.MaxUint32
+			if v < minValue {
+				minValue = v
+			}
+		}
+		binary.LittleEndian.PutUint32(dst[i*4:], uint32(minValue))
+	}
+}
+
+// computeSignatures returns the flattened signatures of the texts.
+func (runner *MinHashFunction) computeSignatures(texts []string) ([]byte, error) {
+	tokenizer, err := ctokenizer.NewTokenizer(runner.analyzerParams)
+	if err != nil {
+		return nil, err
+	}
+	defer tokenizer.Destroy()
+
+	rowBytes := runner.numHashes * minHashElementBits / 8
+	data := make([]byte, len(texts)*rowBytes)
+	tokens := make([]string, 0)
+	for i, text := range texts {
+		tokens = tokens[:0]
+		tokenStream := tokenizer.NewTokenStream(text)
+		for tokenStream.Advance() {
+			tokens = append(tokens, tokenStream.Token())
+		}
+		tokenStream.Destroy()
+		runner.signature(runner.shingles(tokens), data[i*rowBytes:(i+1)*rowBytes])
+	}
+	return data, nil
+}
+
+func (runner *MinHashFunction) dim() int64 {
+	return int64(runner.numHashes * minHashElementBits)
+}
+
+func (runner *MinHashFunction) ProcessInsert(ctx context.Context, inputs []*schemapb.FieldData) ([]*schemapb.FieldData, error) {
+	if len(inputs) != 1 {
+		return nil, fmt.Errorf("MinHash function only receives one input field, but got [%d]", len(inputs))
+	}
+
+	if !isValidInputDataType(inputs[0].Type) {
+		return nil, fmt.Errorf("MinHash function only supports varchar or text field as input field, but got %s", schemapb.DataType_name[int32(inputs[0].Type)])
+	}
+
+	texts := inputs[0].GetScalars().GetStringData().GetData()
+	if texts == nil {
+		return nil, errors.New("Input texts is empty")
+	}
+
+	data, err := runner.computeSignatures(texts)
+	if err != nil {
+		return nil, err
+	}
+	outputField := runner.GetOutputFields()[0]
+	return []*schemapb.FieldData{{
+		FieldId:   outputField.GetFieldID(),
+		FieldName: outputField.GetName(),
+		Type:      outputField.GetDataType(),
+		IsDynamic: outputField.GetIsDynamic(),
+		Field: &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Data: &schemapb.VectorField_BinaryVector{
+					BinaryVector: data,
+				},
+				Dim: runner.dim(),
+			},
+		},
+	}}, nil
+}
+
+func (runner *MinHashFunction) ProcessSearch(ctx context.Context, placeholderGroup *commonpb.PlaceholderGroup) (*commonpb.PlaceholderGroup, error) {
+	texts := funcutil.GetVarCharFromPlaceholder(placeholderGroup.Placeholders[0]) // Already checked externally
+	if hasEmptyString(texts) {
+		return nil, errors.New("There is an empty string in the queries, MinHash function does not support empty text")
+	}
+	data, err := runner.computeSignatures(texts)
+	if err != nil {
+		return nil, err
+	}
+	rowBytes := len(data) / len(texts)
+	vectors := make([][]byte, 0, len(texts))
+	for i := range texts {
+		vectors = append(vectors, data[i*rowBytes:(i+1)*rowBytes])
+	}
+	return funcutil.BinaryVectorsToPlaceholderGroup(vectors), nil
+}
+
+func (runner *MinHashFunction) ProcessBulkInsert(inputs []storage.FieldData) (map[storage.FieldID]storage.FieldData, error) {
+	if len(inputs) != 1 {
+		return nil, fmt.Errorf("MinHash function only receives one input, bug got [%d]", len(inputs))
+	}
+
+	if !isValidInputDataType(inputs[0].GetDataType()) {
+		return nil, fmt.Errorf("MinHash function only supports varchar or text field as input field, but got %s", schemapb.DataType_name[int32(inputs[0].GetDataType())])
+	}
+
+	texts, ok := inputs[0].GetDataRows().([]string)
+	if !ok {
+		return nil, errors.New("Input texts is empty")
+	}
+
+	data, err := runner.computeSignatures(texts)
+	if err != nil {
+		return nil, err
+	}
+	return map[storage.FieldID]storage.FieldData{
+		runner.outputFields[0].FieldID: &storage.BinaryVectorFieldData{
+			Data: data,
+			Dim:  int(runner.dim()),
+		},
+	}, nil
+}
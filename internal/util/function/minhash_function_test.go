@@ -0,0 +1,197 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+)
+
+func TestMinHashFunction(t *testing.T) {
+	suite.Run(t, new(MinHashFunctionSuite))
+}
+
+type MinHashFunctionSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *MinHashFunctionSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "signature", DataType: schemapb.DataType_BinaryVector, IsFunctionOutput: true,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "512"}},
+			},
+		},
+		Functions: []*schemapb.FunctionSchema{
+			{
+				Name:             "minhash",
+				Type:             schemapb.FunctionType_MinHash,
+				InputFieldIds:    []int64{101},
+				InputFieldNames:  []string{"text"},
+				OutputFieldIds:   []int64{102},
+				OutputFieldNames: []string{"signature"},
+				Params: []*commonpb.KeyValuePair{
+					{Key: "shingle_size", Value: "2"},
+					{Key: "num_hashes", Value: "16"},
+					{Key: "seed", Value: "42"},
+				},
+			},
+		},
+	}
+}
+
+func (s *MinHashFunctionSuite) TestFunctionType() {
+	s.Equal("MinHash", schemapb.FunctionType_MinHash.String())
+	s.Equal(int32(schemapb.FunctionType_MinHash), schemapb.FunctionType_value["MinHash"])
+
+	s.True(HasNonBM25Functions(s.schema.Functions, []int64{102}))
+	runner, err := NewFunctionRunner(s.schema, s.schema.Functions[0])
+	s.NoError(err)
+	s.Nil(runner)
+}
+
+func (s *MinHashFunctionSuite) TestNewMinHashFunction() {
+	{
+		f, err := NewMinHashFunction(s.schema, s.schema.Functions[0])
+		s.NoError(err)
+		s.NoError(f.Check())
+		s.Equal(2, f.shingleSize)
+		s.Equal(16, f.numHashes)
+		s.Equal("MinHash", f.GetFunctionTypeName())
+		s.Equal("minhash", f.GetFunctionName())
+		s.Equal("test", f.GetCollectionName())
+	}
+	for _, params := range [][]*commonpb.KeyValuePair{
+		{{Key: "shingle_size", Value: "0"}},
+		{{Key: "num_hashes", Value: "8"}},
+		{{Key: "seed", Value: "x"}},
+		{{Key: "unknown", Value: "1"}},
+	} {
+		fSchema := *s.schema.Functions[0]
+		fSchema.Params = params
+		_, err := NewMinHashFunction(s.schema, &fSchema)
+		s.Error(err)
+	}
+	{
+		fSchema := *s.schema.Functions[0]
+		fSchema.Params = []*commonpb.KeyValuePair{{Key: "analyzer_params", Value: `{"tokenizer": "unknown"}`}}
+		f, err := NewMinHashFunction(s.schema, &fSchema)
+		s.NoError(err)
+		s.Error(f.Check())
+	}
+	{
+		schema := &schemapb.CollectionSchema{
+			Name: "test",
+			Fields: []*schemapb.FieldSchema{
+				s.schema.Fields[0], s.schema.Fields[1],
+				{
+					FieldID: 102, Name: "signature", DataType: schemapb.DataType_BinaryVector,
+					TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}},
+				},
+			},
+		}
+		_, err := NewMinHashFunction(schema, s.schema.Functions[0])
+		s.Error(err)
+	}
+}
+
+func jaccardOfSignatures(a, b []byte) float64 {
+	same := 0
+	for i := 0; i < len(a); i += 4 {
+		if binary.LittleEndian.Uint32(a[i:]) == binary.LittleEndian.Uint32(b[i:]) {
+			same++
+		}
+	}
+	return float64(same) / float64(len(a)/4)
+}
+
+func (s *MinHashFunctionSuite) TestProcess() {
+	texts := []string{
+		"the quick brown fox jumps over the lazy dog",
+		"the quick brown fox jumps over the lazy cat",
+		"a completely different sentence about vector databases",
+	}
+	exec, err := NewFunctionExecutor(s.schema)
+	s.NoError(err)
+
+	msg := &msgstream.InsertMsg{
+		InsertRequest: &msgpb.InsertRequest{
+			NumRows: uint64(len(texts)),
+			FieldsData: []*schemapb.FieldData{{
+				Type:      schemapb.DataType_VarChar,
+				FieldName: "text",
+				FieldId:   101,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: texts}},
+					},
+				},
+			}},
+		},
+	}
+	s.NoError(exec.ProcessInsert(context.Background(), msg))
+	s.Len(msg.FieldsData, 2)
+	output := msg.FieldsData[1]
+	s.Equal(int64(102), output.GetFieldId())
+	s.Equal(int64(512), output.GetVectors().GetDim())
+	vectors := output.GetVectors().GetBinaryVector()
+	s.Len(vectors, 3*64)
+	s.Greater(jaccardOfSignatures(vectors[0:64], vectors[64:128]), jaccardOfSignatures(vectors[0:64], vectors[128:192]))
+
+	// bulk insert generates the same signatures.
+	data, err := storage.NewInsertDataWithFunctionOutputField(s.schema)
+	s.NoError(err)
+	for _, text := range texts {
+		s.NoError(data.Data[101].AppendRow(text))
+	}
+	s.NoError(exec.ProcessBulkInsert(data))
+	s.Equal(vectors, data.Data[102].(*storage.BinaryVectorFieldData).Data)
+
+	// query text is converted to the signature at search time.
+	placeholderGroupBytes, err := funcutil.FieldDataToPlaceholderGroupBytes(msg.FieldsData[0])
+	s.NoError(err)
+	req := &internalpb.SearchRequest{
+		Nq:               3,
+		FieldId:          102,
+		PlaceholderGroup: placeholderGroupBytes,
+	}
+	s.NoError(exec.ProcessSearch(context.Background(), req))
+	pb := &commonpb.PlaceholderGroup{}
+	s.NoError(proto.Unmarshal(req.PlaceholderGroup, pb))
+	s.Equal(commonpb.PlaceholderType_BinaryVector, pb.Placeholders[0].Type)
+	s.Len(pb.Placeholders[0].Values, 3)
+	s.Equal(vectors[0:64], pb.Placeholders[0].Values[0])
+}
@@ -33,7 +33,9 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/util/function/models/ali"
+	"github.com/milvus-io/milvus/internal/util/function/models/clip"
 	"github.com/milvus-io/milvus/internal/util/function/models/cohere"
+	"github.com/milvus-io/milvus/internal/util/function/models/ollama"
 	"github.com/milvus-io/milvus/internal/util/function/models/openai"
 	"github.com/milvus-io/milvus/internal/util/function/models/siliconflow"
 	"github.com/milvus-io/milvus/internal/util/function/models/tei"
@@ -238,6 +240,94 @@ func CreateTEIEmbeddingServer(dim int) *httptest.Server {
 	return ts
 }
 
+func CreateTEISparseEmbeddingServer() *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req tei.EmbeddingRequest
+		body, _ := io.ReadAll(r.Body)
+		defer r.Body.Close()
+		json.Unmarshal(body, &req)
+		embs := make([][]tei.SparseValue, 0, len(req.Inputs))
+		for i := range req.Inputs {
+			embs = append(embs, []tei.SparseValue{{Index: uint32(i), Value: 0.5}, {Index: 100, Value: 1.0}})
+		}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(embs)
+		w.Write(data)
+	}))
+	return ts
+}
+
+func CreateOllamaEmbeddingServer(dim int) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ollama.EmbeddingRequest
+		body, _ := io.ReadAll(r.Body)
+		defer r.Body.Close()
+		json.Unmarshal(body, &req)
+		res := ollama.EmbeddingResponse{
+			Model:      req.Model,
+			Embeddings: mockEmbedding[float32](req.Input, dim),
+		}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(res)
+		w.Write(data)
+	}))
+	return ts
+}
+
+// mockMultimodalEmbedding embeds all the texts to 1 and all the images to 3
+func mockMultimodalEmbedding(isImage bool, dim int) []float32 {
+	value := float32(1)
+	if isImage {
+		value = 3
+	}
+	emb := make([]float32, dim)
+	for i := range emb {
+		emb[i] = value
+	}
+	return emb
+}
+
+func CreateCLIPEmbeddingServer(dim int) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req clip.EmbeddingRequest
+		body, _ := io.ReadAll(r.Body)
+		defer r.Body.Close()
+		json.Unmarshal(body, &req)
+		var res clip.EmbeddingResponse
+		for _, doc := range req.Data {
+			res.Data = append(res.Data, clip.EmbeddingData{Embedding: mockMultimodalEmbedding(doc.Text == "", dim)})
+		}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(res)
+		w.Write(data)
+	}))
+	return ts
+}
+
+func CreateVertexAIMultimodalEmbeddingServer(dim int) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req vertexai.MultimodalEmbeddingRequest
+		body, _ := io.ReadAll(r.Body)
+		defer r.Body.Close()
+		json.Unmarshal(body, &req)
+		var res vertexai.MultimodalEmbeddingResponse
+		for _, instance := range req.Instances {
+			var prediction vertexai.MultimodalPrediction
+			if instance.Text != "" {
+				prediction.TextEmbedding = mockMultimodalEmbedding(false, dim)
+			}
+			if instance.Image != nil {
+				prediction.ImageEmbedding = mockMultimodalEmbedding(true, dim)
+			}
+			res.Predictions = append(res.Predictions, prediction)
+		}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(res)
+		w.Write(data)
+	}))
+	return ts
+}
+
 type MockBedrockClient struct {
 	dim int
 }
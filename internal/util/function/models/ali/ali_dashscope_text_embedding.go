@@ -118,7 +118,7 @@ func (c *AliDashScopeEmbedding) Check() error {
 	return nil
 }
 
-func (c *AliDashScopeEmbedding) Embedding(modelName string, texts []string, dim int, textType string, outputType string, timeoutSec int64) (*EmbeddingResponse, error) {
+func (c *AliDashScopeEmbedding) Embedding(ctx context.Context, modelName string, texts []string, dim int, textType string, outputType string, timeoutSec int64) (*EmbeddingResponse, error) {
 	var r EmbeddingRequest
 	r.Model = modelName
 	r.Input = Input{texts}
@@ -134,7 +134,7 @@ func (c *AliDashScopeEmbedding) Embedding(modelName string, texts []string, dim
 		timeoutSec = utils.DefaultTimeout
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSec)*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
 	defer cancel()
 	headers := map[string]string{
 		"Content-Type":  "application/json",
@@ -0,0 +1,105 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/function/models/utils"
+)
+
+// Document is the input of the CLIP server, only one of the fields is set.
+type Document struct {
+	Text string `json:"text,omitempty"`
+	// the image url fetched by the server
+	URI string `json:"uri,omitempty"`
+	// the base64 encoded image
+	Blob string `json:"blob,omitempty"`
+}
+
+type EmbeddingRequest struct {
+	Data         []Document `json:"data"`
+	ExecEndpoint string     `json:"execEndpoint"`
+}
+
+type EmbeddingData struct {
+	Embedding []float32 `json:"embedding"`
+}
+
+type EmbeddingResponse struct {
+	Data []EmbeddingData `json:"data"`
+}
+
+// CLIPEmbedding calls the HTTP gateway of a CLIP server, such as clip-as-service,
+// the texts and the images are embedded into the same vector space.
+type CLIPEmbedding struct {
+	apiKey string
+	url    string
+}
+
+func NewCLIPEmbeddingClient(apiKey string, endpoint string) (*CLIPEmbedding, error) {
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+		return nil, fmt.Errorf("endpoint: [%s] is not a valid http/https link", endpoint)
+	}
+	return &CLIPEmbedding{
+		apiKey: apiKey,
+		url:    base.String(),
+	}, nil
+}
+
+func (c *CLIPEmbedding) Embedding(ctx context.Context, docs []Document, timeoutSec int64) ([][]float32, error) {
+	r := EmbeddingRequest{Data: docs, ExecEndpoint: "/"}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if timeoutSec <= 0 {
+		timeoutSec = utils.DefaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
+	defer cancel()
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	if c.apiKey != "" {
+		headers["Authorization"] = fmt.Sprintf("Bearer %s", c.apiKey)
+	}
+	body, err := utils.RetrySend(ctx, data, http.MethodPost, c.url, headers, 3)
+	if err != nil {
+		return nil, err
+	}
+	var res EmbeddingResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	embds := make([][]float32, 0, len(res.Data))
+	for _, item := range res.Data {
+		embds = append(embds, item.Embedding)
+	}
+	return embds, nil
+}
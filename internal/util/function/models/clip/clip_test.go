@@ -0,0 +1,82 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clip
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmbeddingClientCheck(t *testing.T) {
+	{
+		_, err := NewCLIPEmbeddingClient("", "http://mymock.com/post")
+		assert.NoError(t, err)
+	}
+
+	{
+		c, err := NewCLIPEmbeddingClient("mock_key", "mock")
+		assert.Nil(t, c)
+		assert.Error(t, err)
+	}
+
+	{
+		_, err := NewCLIPEmbeddingClient("", "http://")
+		assert.Error(t, err)
+	}
+}
+
+func TestEmbeddingOK(t *testing.T) {
+	var req EmbeddingRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &req)
+		assert.Equal(t, "Bearer mock_key", r.Header.Get("Authorization"))
+		var res EmbeddingResponse
+		for i := range req.Data {
+			res.Data = append(res.Data, EmbeddingData{Embedding: []float32{float32(i), 0.1}})
+		}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(res)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	c, err := NewCLIPEmbeddingClient("mock_key", ts.URL)
+	assert.NoError(t, err)
+	ret, err := c.Embedding(context.Background(), []Document{{Text: "sentence"}, {URI: "http://mymock.com/a.jpg"}, {Blob: "aGVsbG8="}}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]float32{{0, 0.1}, {1, 0.1}, {2, 0.1}}, ret)
+	assert.Equal(t, "/", req.ExecEndpoint)
+	assert.Equal(t, Document{URI: "http://mymock.com/a.jpg"}, req.Data[1])
+}
+
+func TestEmbeddingFailed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	c, _ := NewCLIPEmbeddingClient("mock_key", ts.URL)
+	_, err := c.Embedding(context.Background(), []Document{{Text: "sentence"}}, 0)
+	assert.Error(t, err)
+}
@@ -80,7 +80,7 @@ func (c *CohereEmbedding) Check() error {
 	return nil
 }
 
-func (c *CohereEmbedding) Embedding(modelName string, texts []string, inputType string, outputType string, truncate string, timeoutSec int64) (*EmbeddingResponse, error) {
+func (c *CohereEmbedding) Embedding(ctx context.Context, modelName string, texts []string, inputType string, outputType string, truncate string, timeoutSec int64) (*EmbeddingResponse, error) {
 	var r EmbeddingRequest
 	r.Model = modelName
 	r.Texts = texts
@@ -99,7 +99,7 @@ func (c *CohereEmbedding) Embedding(modelName string, texts []string, inputType
 		timeoutSec = utils.DefaultTimeout
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSec)*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
 	defer cancel()
 	headers := map[string]string{
 		"accept":        "application/json",
@@ -17,6 +17,7 @@
 package cohere
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -94,7 +95,7 @@ func TestEmbeddingOK(t *testing.T) {
 		c := NewCohereEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		ret, err := c.Embedding("voyage-3", []string{"sentence"}, "search_document", "float", "END", 0)
+		ret, err := c.Embedding(context.Background(), "voyage-3", []string{"sentence"}, "search_document", "float", "END", 0)
 		assert.True(t, err == nil)
 		assert.Equal(t, ret.Embeddings.Float[0], []float32{0.0, 0.1})
 		assert.Equal(t, ret.Embeddings.Float[1], []float32{1.0, 1.1})
@@ -113,7 +114,7 @@ func TestEmbeddingFailed(t *testing.T) {
 		c := NewCohereEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		_, err = c.Embedding("voyage-3", []string{"sentence"}, "search_document", "float", "END", 0)
+		_, err = c.Embedding(context.Background(), "voyage-3", []string{"sentence"}, "search_document", "float", "END", 0)
 		assert.True(t, err != nil)
 	}
 }
@@ -0,0 +1,119 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ollama
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/function/models/utils"
+)
+
+type EmbeddingRequest struct {
+	// Name of the model to generate embeddings from.
+	Model string `json:"model"`
+
+	// Texts to generate embeddings for.
+	Input []string `json:"input"`
+
+	// Truncates the end of each input to fit within context length, returns error if false and context length is exceeded.
+	Truncate *bool `json:"truncate,omitempty"`
+
+	// Controls how long the model will stay loaded into memory following the request.
+	KeepAlive string `json:"keep_alive,omitempty"`
+
+	// The number of dimensions the resulting output embeddings should have, only supported by some models.
+	Dimensions int `json:"dimensions,omitempty"`
+}
+
+type EmbeddingResponse struct {
+	Model           string      `json:"model"`
+	Embeddings      [][]float32 `json:"embeddings"`
+	TotalDuration   int64       `json:"total_duration,omitempty"`
+	LoadDuration    int64       `json:"load_duration,omitempty"`
+	PromptEvalCount int         `json:"prompt_eval_count,omitempty"`
+}
+
+type OllamaEmbedding struct {
+	apiKey string
+	url    string
+}
+
+// NewOllamaEmbeddingClient creates the client by the base url of ollama server, e.g. http://localhost:11434.
+// The api key is optional, it's only required when ollama is deployed behind an authenticating proxy.
+func NewOllamaEmbeddingClient(apiKey string, endpoint string) (*OllamaEmbedding, error) {
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if base.Scheme != "http" && base.Scheme != "https" {
+		return nil, fmt.Errorf("endpoint: [%s] is not a valid http/https link", endpoint)
+	}
+	if base.Host == "" {
+		return nil, fmt.Errorf("endpoint: [%s] is not a valid http/https link", endpoint)
+	}
+	if !strings.HasSuffix(base.Path, "/api/embed") {
+		base.Path = strings.TrimSuffix(base.Path, "/") + "/api/embed"
+	}
+
+	return &OllamaEmbedding{
+		apiKey: apiKey,
+		url:    base.String(),
+	}, nil
+}
+
+func (c *OllamaEmbedding) Embedding(ctx context.Context, modelName string, texts []string, dim int, truncate *bool, keepAlive string, timeoutSec int64) (*EmbeddingResponse, error) {
+	r := EmbeddingRequest{
+		Model:      modelName,
+		Input:      texts,
+		Truncate:   truncate,
+		KeepAlive:  keepAlive,
+		Dimensions: dim,
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if timeoutSec <= 0 {
+		timeoutSec = utils.DefaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
+	defer cancel()
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	if c.apiKey != "" {
+		headers["Authorization"] = fmt.Sprintf("Bearer %s", c.apiKey)
+	}
+	body, err := utils.RetrySend(ctx, data, http.MethodPost, c.url, headers, 3)
+	if err != nil {
+		return nil, err
+	}
+	var res EmbeddingResponse
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, err
+}
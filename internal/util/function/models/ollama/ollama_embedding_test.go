@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ollama
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmbeddingClientCheck(t *testing.T) {
+	{
+		c, err := NewOllamaEmbeddingClient("", "http://localhost:11434")
+		assert.NoError(t, err)
+		assert.Equal(t, "http://localhost:11434/api/embed", c.url)
+	}
+
+	{
+		c, err := NewOllamaEmbeddingClient("", "http://localhost:11434/api/embed")
+		assert.NoError(t, err)
+		assert.Equal(t, "http://localhost:11434/api/embed", c.url)
+	}
+
+	{
+		_, err := NewOllamaEmbeddingClient("", "mock")
+		assert.Error(t, err)
+	}
+
+	{
+		_, err := NewOllamaEmbeddingClient("", "http://")
+		assert.Error(t, err)
+	}
+}
+
+func TestEmbeddingOK(t *testing.T) {
+	var req EmbeddingRequest
+	var authorization string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/embed" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		authorization = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &req)
+		res := EmbeddingResponse{Model: req.Model}
+		for i := range req.Input {
+			res.Embeddings = append(res.Embeddings, []float32{float32(i), 0.1})
+		}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(res)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	{
+		c, _ := NewOllamaEmbeddingClient("", ts.URL)
+		ret, err := c.Embedding(context.Background(), "nomic-embed-text", []string{"a", "b"}, 0, nil, "", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, [][]float32{{0, 0.1}, {1, 0.1}}, ret.Embeddings)
+		assert.Equal(t, "nomic-embed-text", req.Model)
+		assert.Nil(t, req.Truncate)
+		assert.Empty(t, authorization)
+	}
+
+	{
+		truncate := false
+		c, _ := NewOllamaEmbeddingClient("mock_key", ts.URL)
+		_, err := c.Embedding(context.Background(), "nomic-embed-text", []string{"a"}, 0, &truncate, "5m", 0)
+		assert.NoError(t, err)
+		assert.False(t, *req.Truncate)
+		assert.Equal(t, "5m", req.KeepAlive)
+		assert.Equal(t, "Bearer mock_key", authorization)
+	}
+}
+
+func TestEmbeddingFailed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": "model not found"}`))
+	}))
+	defer ts.Close()
+
+	c, _ := NewOllamaEmbeddingClient("", ts.URL)
+	_, err := c.Embedding(context.Background(), "nomic-embed-text", []string{"a"}, 0, nil, "", 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openai
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// OpenAICompatibleEmbeddingClient calls the self-hosted services which implement the OpenAI embeddings api,
+// such as vLLM, LocalAI and LM Studio. The api key is optional for these services.
+type OpenAICompatibleEmbeddingClient struct {
+	openAIBase
+}
+
+// NewOpenAICompatibleEmbeddingClient creates the client by the base url of the service, e.g. http://localhost:8000/v1,
+// the `/embeddings` path is appended if the url doesn't end with it.
+func NewOpenAICompatibleEmbeddingClient(apiKey string, endpoint string) (*OpenAICompatibleEmbeddingClient, error) {
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if base.Scheme != "http" && base.Scheme != "https" {
+		return nil, fmt.Errorf("endpoint: [%s] is not a valid http/https link", endpoint)
+	}
+	if base.Host == "" {
+		return nil, fmt.Errorf("endpoint: [%s] is not a valid http/https link", endpoint)
+	}
+	if !strings.HasSuffix(base.Path, "/embeddings") {
+		base.Path = strings.TrimSuffix(base.Path, "/") + "/embeddings"
+	}
+
+	return &OpenAICompatibleEmbeddingClient{
+		openAIBase{
+			apiKey: apiKey,
+			url:    base.String(),
+		},
+	}, nil
+}
+
+func (c *OpenAICompatibleEmbeddingClient) Check() error {
+	if c.url == "" {
+		return errors.New("url is empty")
+	}
+	return nil
+}
+
+func (c *OpenAICompatibleEmbeddingClient) Embedding(ctx context.Context, modelName string, texts []string, dim int, user string, timeoutSec int64) (*EmbeddingResponse, error) {
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	if c.apiKey != "" {
+		headers["Authorization"] = fmt.Sprintf("Bearer %s", c.apiKey)
+	}
+	return c.embedding(ctx, c.url, headers, modelName, texts, dim, user, timeoutSec)
+}
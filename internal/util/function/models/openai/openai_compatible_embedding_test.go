@@ -0,0 +1,105 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenAICompatibleClientURL(t *testing.T) {
+	{
+		c, err := NewOpenAICompatibleEmbeddingClient("", "http://localhost:8000/v1")
+		assert.NoError(t, err)
+		assert.NoError(t, c.Check())
+		assert.Equal(t, "http://localhost:8000/v1/embeddings", c.url)
+	}
+
+	{
+		c, err := NewOpenAICompatibleEmbeddingClient("", "http://localhost:8000/v1/")
+		assert.NoError(t, err)
+		assert.Equal(t, "http://localhost:8000/v1/embeddings", c.url)
+	}
+
+	{
+		c, err := NewOpenAICompatibleEmbeddingClient("", "http://localhost:8000/v1/embeddings")
+		assert.NoError(t, err)
+		assert.Equal(t, "http://localhost:8000/v1/embeddings", c.url)
+	}
+
+	{
+		_, err := NewOpenAICompatibleEmbeddingClient("", "localhost:8000")
+		assert.Error(t, err)
+	}
+
+	{
+		_, err := NewOpenAICompatibleEmbeddingClient("", "http://")
+		assert.Error(t, err)
+	}
+}
+
+func TestOpenAICompatibleEmbedding(t *testing.T) {
+	var authorization string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/embeddings" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		authorization = r.Header.Get("Authorization")
+		var req EmbeddingRequest
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &req)
+		var res EmbeddingResponse
+		res.Model = req.Model
+		for i := len(req.Input) - 1; i >= 0; i-- {
+			res.Data = append(res.Data, EmbeddingData{
+				Object:    "embedding",
+				Embedding: []float32{float32(i), 0.1},
+				Index:     i,
+			})
+		}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(res)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	{
+		c, err := NewOpenAICompatibleEmbeddingClient("", ts.URL+"/v1")
+		assert.NoError(t, err)
+		ret, err := c.Embedding(context.Background(), "bge-m3", []string{"a", "b"}, 0, "", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, "bge-m3", ret.Model)
+		assert.Equal(t, []float32{0, 0.1}, ret.Data[0].Embedding)
+		assert.Equal(t, []float32{1, 0.1}, ret.Data[1].Embedding)
+		assert.Empty(t, authorization)
+	}
+
+	{
+		c, err := NewOpenAICompatibleEmbeddingClient("mock_key", ts.URL+"/v1")
+		assert.NoError(t, err)
+		_, err = c.Embedding(context.Background(), "bge-m3", []string{"a"}, 0, "", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer mock_key", authorization)
+	}
+}
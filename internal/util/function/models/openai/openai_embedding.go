@@ -103,7 +103,7 @@ type EmbedddingError struct {
 
 type OpenAIEmbeddingInterface interface {
 	Check() error
-	Embedding(modelName string, texts []string, dim int, user string, timeoutSec int64) (*EmbeddingResponse, error)
+	Embedding(ctx context.Context, modelName string, texts []string, dim int, user string, timeoutSec int64) (*EmbeddingResponse, error)
 }
 
 type openAIBase struct {
@@ -136,7 +136,7 @@ func (c *openAIBase) genReq(modelName string, texts []string, dim int, user stri
 	return &r
 }
 
-func (c *openAIBase) embedding(url string, headers map[string]string, modelName string, texts []string, dim int, user string, timeoutSec int64) (*EmbeddingResponse, error) {
+func (c *openAIBase) embedding(ctx context.Context, url string, headers map[string]string, modelName string, texts []string, dim int, user string, timeoutSec int64) (*EmbeddingResponse, error) {
 	r := c.genReq(modelName, texts, dim, user)
 	data, err := json.Marshal(r)
 	if err != nil {
@@ -147,7 +147,7 @@ func (c *openAIBase) embedding(url string, headers map[string]string, modelName
 		timeoutSec = utils.DefaultTimeout
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSec)*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
 	defer cancel()
 	body, err := utils.RetrySend(ctx, data, http.MethodPost, url, headers, 3)
 	if err != nil {
@@ -175,12 +175,12 @@ func NewOpenAIEmbeddingClient(apiKey string, url string) *OpenAIEmbeddingClient
 	}
 }
 
-func (c *OpenAIEmbeddingClient) Embedding(modelName string, texts []string, dim int, user string, timeoutSec int64) (*EmbeddingResponse, error) {
+func (c *OpenAIEmbeddingClient) Embedding(ctx context.Context, modelName string, texts []string, dim int, user string, timeoutSec int64) (*EmbeddingResponse, error) {
 	headers := map[string]string{
 		"Content-Type":  "application/json",
 		"Authorization": fmt.Sprintf("Bearer %s", c.apiKey),
 	}
-	return c.embedding(c.url, headers, modelName, texts, dim, user, timeoutSec)
+	return c.embedding(ctx, c.url, headers, modelName, texts, dim, user, timeoutSec)
 }
 
 type AzureOpenAIEmbeddingClient struct {
@@ -198,7 +198,7 @@ func NewAzureOpenAIEmbeddingClient(apiKey string, url string) *AzureOpenAIEmbedd
 	}
 }
 
-func (c *AzureOpenAIEmbeddingClient) Embedding(modelName string, texts []string, dim int, user string, timeoutSec int64) (*EmbeddingResponse, error) {
+func (c *AzureOpenAIEmbeddingClient) Embedding(ctx context.Context, modelName string, texts []string, dim int, user string, timeoutSec int64) (*EmbeddingResponse, error) {
 	base, err := url.Parse(c.url)
 	if err != nil {
 		return nil, err
@@ -214,5 +214,5 @@ func (c *AzureOpenAIEmbeddingClient) Embedding(modelName string, texts []string,
 		"Content-Type": "application/json",
 		"api-key":      c.apiKey,
 	}
-	return c.embedding(url, headers, modelName, texts, dim, user, timeoutSec)
+	return c.embedding(ctx, url, headers, modelName, texts, dim, user, timeoutSec)
 }
@@ -17,6 +17,7 @@
 package openai
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -97,7 +98,7 @@ func TestEmbeddingOK(t *testing.T) {
 		c := NewOpenAIEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		ret, err := c.Embedding("text-embedding-3-small", []string{"sentence"}, 0, "", 0)
+		ret, err := c.Embedding(context.Background(), "text-embedding-3-small", []string{"sentence"}, 0, "", 0)
 		assert.True(t, err == nil)
 		assert.Equal(t, ret.Data[0].Index, 0)
 		assert.Equal(t, ret.Data[1].Index, 1)
@@ -106,7 +107,7 @@ func TestEmbeddingOK(t *testing.T) {
 		c := NewAzureOpenAIEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		ret, err := c.Embedding("text-embedding-3-small", []string{"sentence"}, 0, "", 0)
+		ret, err := c.Embedding(context.Background(), "text-embedding-3-small", []string{"sentence"}, 0, "", 0)
 		assert.True(t, err == nil)
 		assert.Equal(t, ret.Data[0].Index, 0)
 		assert.Equal(t, ret.Data[1].Index, 1)
@@ -144,7 +145,7 @@ func TestEmbeddingRetry(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if atomic.LoadInt32(&count) < 2 {
 			atomic.AddInt32(&count, 1)
-			w.WriteHeader(http.StatusUnauthorized)
+			w.WriteHeader(http.StatusTooManyRequests)
 		} else {
 			w.WriteHeader(http.StatusOK)
 			data, _ := json.Marshal(res)
@@ -159,7 +160,7 @@ func TestEmbeddingRetry(t *testing.T) {
 		c := NewOpenAIEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		ret, err := c.Embedding("text-embedding-3-small", []string{"sentence"}, 0, "", 0)
+		ret, err := c.Embedding(context.Background(), "text-embedding-3-small", []string{"sentence"}, 0, "", 0)
 		assert.True(t, err == nil)
 		assert.Equal(t, ret.Usage, res.Usage)
 		assert.Equal(t, ret.Object, res.Object)
@@ -173,7 +174,7 @@ func TestEmbeddingRetry(t *testing.T) {
 		c := NewAzureOpenAIEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		ret, err := c.Embedding("text-embedding-3-small", []string{"sentence"}, 0, "", 0)
+		ret, err := c.Embedding(context.Background(), "text-embedding-3-small", []string{"sentence"}, 0, "", 0)
 		assert.True(t, err == nil)
 		assert.Equal(t, ret.Usage, res.Usage)
 		assert.Equal(t, ret.Object, res.Object)
@@ -200,26 +201,28 @@ func TestEmbeddingFailed(t *testing.T) {
 		c := NewOpenAIEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		_, err = c.Embedding("text-embedding-3-small", []string{"sentence"}, 0, "", 0)
+		_, err = c.Embedding(context.Background(), "text-embedding-3-small", []string{"sentence"}, 0, "", 0)
 		assert.True(t, err != nil)
-		assert.Equal(t, atomic.LoadInt32(&count), int32(3))
+		// the client errors are not retried
+		assert.Equal(t, atomic.LoadInt32(&count), int32(1))
 	}
 	{
 		atomic.StoreInt32(&count, 0)
 		c := NewAzureOpenAIEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		_, err = c.Embedding("text-embedding-3-small", []string{"sentence"}, 0, "", 0)
+		_, err = c.Embedding(context.Background(), "text-embedding-3-small", []string{"sentence"}, 0, "", 0)
 		assert.True(t, err != nil)
-		assert.Equal(t, atomic.LoadInt32(&count), int32(3))
+		// the client errors are not retried
+		assert.Equal(t, atomic.LoadInt32(&count), int32(1))
 	}
 }
 
 func TestTimeout(t *testing.T) {
 	var st int32 = 0
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// (Timeout 1s + 2s + 4s + Wait 1s * 3)
-		time.Sleep(11 * time.Second)
+		// Timeout 1s, the timed out request is not retried
+		time.Sleep(3 * time.Second)
 		atomic.AddInt32(&st, 1)
 		w.WriteHeader(http.StatusUnauthorized)
 	}))
@@ -232,7 +235,7 @@ func TestTimeout(t *testing.T) {
 		c := NewOpenAIEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		_, err = c.Embedding("text-embedding-3-small", []string{"sentence"}, 0, "", 1)
+		_, err = c.Embedding(context.Background(), "text-embedding-3-small", []string{"sentence"}, 0, "", 1)
 		assert.True(t, err != nil)
 		assert.Equal(t, atomic.LoadInt32(&st), int32(0))
 		time.Sleep(3 * time.Second)
@@ -244,7 +247,7 @@ func TestTimeout(t *testing.T) {
 		c := NewAzureOpenAIEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		_, err = c.Embedding("text-embedding-3-small", []string{"sentence"}, 0, "", 1)
+		_, err = c.Embedding(context.Background(), "text-embedding-3-small", []string{"sentence"}, 0, "", 1)
 		assert.True(t, err != nil)
 		assert.Equal(t, atomic.LoadInt32(&st), int32(0))
 		time.Sleep(3 * time.Second)
@@ -100,7 +100,7 @@ func (c *SiliconflowEmbedding) Check() error {
 	return nil
 }
 
-func (c *SiliconflowEmbedding) Embedding(modelName string, texts []string, encodingFormat string, timeoutSec int64) (*EmbeddingResponse, error) {
+func (c *SiliconflowEmbedding) Embedding(ctx context.Context, modelName string, texts []string, encodingFormat string, timeoutSec int64) (*EmbeddingResponse, error) {
 	var r EmbeddingRequest
 	r.Model = modelName
 	r.Input = texts
@@ -114,7 +114,7 @@ func (c *SiliconflowEmbedding) Embedding(modelName string, texts []string, encod
 		timeoutSec = utils.DefaultTimeout
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSec)*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
 	defer cancel()
 
 	headers := map[string]string{
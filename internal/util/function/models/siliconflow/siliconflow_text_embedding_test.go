@@ -17,6 +17,7 @@
 package siliconflow
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -99,7 +100,7 @@ func TestEmbeddingOK(t *testing.T) {
 		c := NewSiliconflowEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		ret, err := c.Embedding("BAAI/bge-large-zh-v1.5", []string{"sentence"}, "float", 0)
+		ret, err := c.Embedding(context.Background(), "BAAI/bge-large-zh-v1.5", []string{"sentence"}, "float", 0)
 		assert.True(t, err == nil)
 		assert.Equal(t, ret.Data[0].Index, 0)
 		assert.Equal(t, ret.Data[1].Index, 1)
@@ -122,7 +123,7 @@ func TestEmbeddingFailed(t *testing.T) {
 		c := NewSiliconflowEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		_, err = c.Embedding("BAAI/bge-large-zh-v1.5", []string{"sentence"}, "float", 0)
+		_, err = c.Embedding(context.Background(), "BAAI/bge-large-zh-v1.5", []string{"sentence"}, "float", 0)
 		assert.True(t, err != nil)
 	}
 }
@@ -34,9 +34,16 @@ type EmbeddingRequest struct {
 	PromptName          string   `json:"prompt_name,omitempty"`
 }
 
+// SparseValue is a non-zero value of the sparse embedding returned by `/embed_sparse`.
+type SparseValue struct {
+	Index uint32  `json:"index"`
+	Value float32 `json:"value"`
+}
+
 type TEIEmbedding struct {
-	apiKey string
-	url    string
+	apiKey    string
+	url       string
+	sparseURL string
 }
 
 func NewTEIEmbeddingClient(apiKey string, endpoint string) (*TEIEmbedding, error) {
@@ -52,14 +59,44 @@ func NewTEIEmbeddingClient(apiKey string, endpoint string) (*TEIEmbedding, error
 	}
 
 	base.Path = "/embed"
+	embedURL := base.String()
+	base.Path = "/embed_sparse"
 
 	return &TEIEmbedding{
-		apiKey: apiKey,
-		url:    base.String(),
+		apiKey:    apiKey,
+		url:       embedURL,
+		sparseURL: base.String(),
 	}, nil
 }
 
-func (c *TEIEmbedding) Embedding(texts []string, truncate bool, truncationDirection string, prompt string, timeoutSec int64) ([][]float32, error) {
+func (c *TEIEmbedding) Embedding(ctx context.Context, texts []string, truncate bool, truncationDirection string, prompt string, timeoutSec int64) ([][]float32, error) {
+	body, err := c.send(ctx, c.url, texts, truncate, truncationDirection, prompt, timeoutSec)
+	if err != nil {
+		return nil, err
+	}
+	var res [][]float32
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, err
+}
+
+// SparseEmbedding calls `/embed_sparse` of the learned sparse models such as SPLADE.
+func (c *TEIEmbedding) SparseEmbedding(ctx context.Context, texts []string, truncate bool, truncationDirection string, prompt string, timeoutSec int64) ([][]SparseValue, error) {
+	body, err := c.send(ctx, c.sparseURL, texts, truncate, truncationDirection, prompt, timeoutSec)
+	if err != nil {
+		return nil, err
+	}
+	var res [][]SparseValue
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, err
+}
+
+func (c *TEIEmbedding) send(ctx context.Context, reqURL string, texts []string, truncate bool, truncationDirection string, prompt string, timeoutSec int64) ([]byte, error) {
 	var r EmbeddingRequest
 	if prompt != "" {
 		var newTexts []string
@@ -85,7 +122,7 @@ func (c *TEIEmbedding) Embedding(texts []string, truncate bool, truncationDirect
 		timeoutSec = utils.DefaultTimeout
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSec)*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
 	defer cancel()
 	headers := map[string]string{
 		"Content-Type": "application/json",
@@ -93,14 +130,5 @@ func (c *TEIEmbedding) Embedding(texts []string, truncate bool, truncationDirect
 	if c.apiKey != "" {
 		headers["Authorization"] = fmt.Sprintf("Bearer %s", c.apiKey)
 	}
-	body, err := utils.RetrySend(ctx, data, http.MethodPost, c.url, headers, 3)
-	if err != nil {
-		return nil, err
-	}
-	var res [][]float32
-	err = json.Unmarshal(body, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, err
+	return utils.RetrySend(ctx, data, http.MethodPost, reqURL, headers, 3)
 }
@@ -17,6 +17,7 @@
 package tei
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -64,14 +65,14 @@ func TestEmbeddingOK(t *testing.T) {
 
 	{
 		c, _ := NewTEIEmbeddingClient("mock_key", url)
-		ret, err := c.Embedding([]string{"sentence"}, true, "left", "query", 0)
+		ret, err := c.Embedding(context.Background(), []string{"sentence"}, true, "left", "query", 0)
 		assert.True(t, err == nil)
 		assert.Equal(t, ret, [][]float32{{0.0, 0.1}, {1.0, 1.1}, {2.0, 2.1}})
 	}
 
 	{
 		c, _ := NewTEIEmbeddingClient("mock_key", url)
-		ret, err := c.Embedding([]string{"sentence"}, false, "", "", 0)
+		ret, err := c.Embedding(context.Background(), []string{"sentence"}, false, "", "", 0)
 		assert.True(t, err == nil)
 		assert.Equal(t, ret, [][]float32{{0.0, 0.1}, {1.0, 1.1}, {2.0, 2.1}})
 	}
@@ -87,7 +88,25 @@ func TestEmbeddingFailed(t *testing.T) {
 
 	{
 		c, _ := NewTEIEmbeddingClient("mock_key", url)
-		_, err := c.Embedding([]string{"sentence"}, true, "left", "query", 0)
+		_, err := c.Embedding(context.Background(), []string{"sentence"}, true, "left", "query", 0)
 		assert.True(t, err != nil)
 	}
 }
+
+func TestSparseEmbeddingOK(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/embed_sparse" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[[{"index": 10, "value": 0.5}, {"index": 3, "value": 1.5}], []]`))
+	}))
+
+	defer ts.Close()
+
+	c, _ := NewTEIEmbeddingClient("", ts.URL)
+	ret, err := c.SparseEmbedding(context.Background(), []string{"sentence", "other"}, false, "", "", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]SparseValue{{{Index: 10, Value: 0.5}, {Index: 3, Value: 1.5}}, {}}, ret)
+}
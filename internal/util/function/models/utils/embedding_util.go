@@ -22,34 +22,127 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"net"
 	"net/http"
+	"strconv"
 	"time"
+
+	"github.com/cockroachdb/errors"
 )
 
 const DefaultTimeout int64 = 30
 
+// RequestLimiter limits the rate of the http requests sent to the model service.
+type RequestLimiter interface {
+	// Wait blocks until a request is allowed to be sent.
+	Wait(ctx context.Context) error
+}
+
+type requestLimiterKey struct{}
+
+// WithRequestLimiter returns a context whose http requests sent by RetrySend are limited by the limiter,
+// every attempt of RetrySend takes a token.
+func WithRequestLimiter(ctx context.Context, limiter RequestLimiter) context.Context {
+	return context.WithValue(ctx, requestLimiterKey{}, limiter)
+}
+
+// WaitRequestLimiter waits for the limiter of the context, it returns immediately if there's no limiter.
+func WaitRequestLimiter(ctx context.Context) error {
+	if limiter, ok := ctx.Value(requestLimiterKey{}).(RequestLimiter); ok {
+		return limiter.Wait(ctx)
+	}
+	return nil
+}
+
+// StatusError is returned when the service responds with a non-200 status code.
+type StatusError struct {
+	StatusCode int
+	Status     string
+	Body       []byte
+	// RetryAfter is parsed from the Retry-After header, zero if it is absent
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("Call service failed, errs:[%s, %s]", e.Status, e.Body)
+}
+
+// errTransport marks the errors of sending the request or reading the response, the request did not get
+// a complete response from the service.
+var errTransport = errors.New("transport error")
+
+// IsRetryableError reports whether the request may succeed if it is sent again, only the transport errors,
+// throttling and server side errors are retryable. The other errors, such as the client errors and the
+// errors of checking the response locally, fail again on retry.
+func IsRetryableError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	statusCode := 0
+	var statusErr *StatusError
+	var sdkErr interface{ HTTPStatusCode() int }
+	if errors.As(err, &statusErr) {
+		statusCode = statusErr.StatusCode
+	} else if errors.As(err, &sdkErr) {
+		// the response errors of the sdk clients, e.g. aws
+		statusCode = sdkErr.HTTPStatusCode()
+	}
+	if statusCode != 0 {
+		return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+	}
+	if errors.Is(err, errTransport) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
 func send(req *http.Request) ([]byte, error) {
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, errors.Mark(err, errTransport)
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("Call service failed, read response failed, errs:[%v]", err)
+		return nil, errors.Mark(fmt.Errorf("Call service failed, read response failed, errs:[%v]", err), errTransport)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("Call service failed, errs:[%s, %s]", resp.Status, body)
+		return nil, &StatusError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Body:       body,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
 	}
 	return body, nil
 }
 
+// RetrySend sends the request with exponential backoff, only the retryable errors are retried.
 func RetrySend(ctx context.Context, data []byte, httpMethod string, url string, headers map[string]string, maxRetries int) ([]byte, error) {
 	var err error
 	var body []byte
 	for i := 0; i < maxRetries; i++ {
+		if waitErr := WaitRequestLimiter(ctx); waitErr != nil {
+			return nil, waitErr
+		}
 		req, reqErr := http.NewRequestWithContext(ctx, httpMethod, url, bytes.NewBuffer(data))
 		if reqErr != nil {
 			return nil, reqErr
@@ -61,9 +154,21 @@ func RetrySend(ctx context.Context, data []byte, httpMethod string, url string,
 		if err == nil {
 			return body, nil
 		}
+		if !IsRetryableError(err) || i == maxRetries-1 {
+			break
+		}
 		backoffDelay := 1 << uint(i) * time.Second
 		jitter := time.Duration(rand.Int63n(int64(backoffDelay / 4)))
-		time.Sleep(backoffDelay + jitter)
+		delay := backoffDelay + jitter
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) && statusErr.RetryAfter > delay {
+			delay = statusErr.RetryAfter
+		}
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(delay):
+		}
 	}
 	return nil, err
 }
@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetrySend(t *testing.T) {
+	calls := 0
+	status := http.StatusOK
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(status)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	{
+		body, err := RetrySend(context.Background(), []byte(`{}`), http.MethodPost, ts.URL, map[string]string{}, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(`{}`), body)
+		assert.Equal(t, 1, calls)
+	}
+
+	// client errors are not retried
+	{
+		calls = 0
+		status = http.StatusBadRequest
+		_, err := RetrySend(context.Background(), []byte(`{}`), http.MethodPost, ts.URL, map[string]string{}, 3)
+		assert.Error(t, err)
+		assert.False(t, IsRetryableError(err))
+		assert.Equal(t, 1, calls)
+	}
+
+	// throttled requests are retried
+	{
+		calls = 0
+		status = http.StatusTooManyRequests
+		_, err := RetrySend(context.Background(), []byte(`{}`), http.MethodPost, ts.URL, map[string]string{}, 2)
+		assert.Error(t, err)
+		assert.True(t, IsRetryableError(err))
+		var statusErr *StatusError
+		assert.True(t, errors.As(err, &statusErr))
+		assert.Equal(t, http.StatusTooManyRequests, statusErr.StatusCode)
+		assert.Equal(t, 2, calls)
+	}
+
+	// the backoff stops when the context is done
+	{
+		calls = 0
+		status = http.StatusServiceUnavailable
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_, err := RetrySend(ctx, []byte(`{}`), http.MethodPost, ts.URL, map[string]string{}, 3)
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+	}
+}
+
+type countingLimiter struct {
+	waits int
+	err   error
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.waits++
+	return l.err
+}
+
+func TestRetrySendWithLimiter(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	// every attempt takes a token
+	limiter := &countingLimiter{}
+	ctx := WithRequestLimiter(context.Background(), limiter)
+	_, err := RetrySend(ctx, []byte(`{}`), http.MethodPost, ts.URL, map[string]string{}, 2)
+	assert.Error(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 2, limiter.waits)
+
+	// the request is not sent if the limiter rejects it
+	calls = 0
+	limiter = &countingLimiter{err: errors.New("rate limit exceeded")}
+	ctx = WithRequestLimiter(context.Background(), limiter)
+	_, err = RetrySend(ctx, []byte(`{}`), http.MethodPost, ts.URL, map[string]string{}, 2)
+	assert.ErrorIs(t, err, limiter.err)
+	assert.Equal(t, 0, calls)
+
+	assert.NoError(t, WaitRequestLimiter(context.Background()))
+}
+
+func TestIsRetryableError(t *testing.T) {
+	assert.False(t, IsRetryableError(nil))
+	assert.False(t, IsRetryableError(context.Canceled))
+	assert.True(t, IsRetryableError(&StatusError{StatusCode: http.StatusBadGateway}))
+	assert.True(t, IsRetryableError(&StatusError{StatusCode: http.StatusServiceUnavailable}))
+	assert.False(t, IsRetryableError(&StatusError{StatusCode: http.StatusUnauthorized}))
+	assert.False(t, IsRetryableError(&StatusError{StatusCode: http.StatusRequestTimeout}))
+	assert.True(t, IsRetryableError(errors.Wrap(&StatusError{StatusCode: http.StatusTooManyRequests}, "embedding")))
+	assert.True(t, IsRetryableError(sdkResponseError{statusCode: http.StatusTooManyRequests}))
+	assert.False(t, IsRetryableError(sdkResponseError{statusCode: http.StatusBadRequest}))
+
+	// the errors of checking the response locally are not retryable
+	assert.False(t, IsRetryableError(errors.New("The required embedding dim is [4], but the embedding obtained from the model is [8]")))
+	assert.False(t, IsRetryableError(errors.New("The number of inputs and embeddings does not match")))
+
+	// the transport errors are retryable
+	assert.True(t, IsRetryableError(errors.Mark(errors.New("connection reset by peer"), errTransport)))
+	assert.True(t, IsRetryableError(&net.OpError{Op: "dial", Err: errors.New("connection refused")}))
+	_, err := RetrySend(context.Background(), []byte(`{}`), http.MethodPost, "http://127.0.0.1:1", map[string]string{}, 1)
+	assert.True(t, IsRetryableError(err))
+}
+
+type sdkResponseError struct {
+	statusCode int
+}
+
+func (e sdkResponseError) Error() string {
+	return fmt.Sprintf("http status %d", e.statusCode)
+}
+
+func (e sdkResponseError) HTTPStatusCode() int {
+	return e.statusCode
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert.Equal(t, time.Duration(0), parseRetryAfter(""))
+	assert.Equal(t, 2*time.Second, parseRetryAfter("2"))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("invalid"))
+	assert.True(t, parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)) > 0)
+}
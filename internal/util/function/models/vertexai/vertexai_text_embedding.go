@@ -66,6 +66,35 @@ type EmbeddingResponse struct {
 	Metadata    Metadata     `json:"metadata"`
 }
 
+// MultimodalImage is either the base64 encoded image or the Cloud Storage URI of the image.
+type MultimodalImage struct {
+	BytesBase64Encoded string `json:"bytesBase64Encoded,omitempty"`
+	GcsURI             string `json:"gcsUri,omitempty"`
+}
+
+type MultimodalInstance struct {
+	Text  string           `json:"text,omitempty"`
+	Image *MultimodalImage `json:"image,omitempty"`
+}
+
+type MultimodalParameters struct {
+	Dimension int64 `json:"dimension,omitempty"`
+}
+
+type MultimodalEmbeddingRequest struct {
+	Instances  []MultimodalInstance `json:"instances"`
+	Parameters MultimodalParameters `json:"parameters,omitempty"`
+}
+
+type MultimodalPrediction struct {
+	TextEmbedding  []float32 `json:"textEmbedding"`
+	ImageEmbedding []float32 `json:"imageEmbedding"`
+}
+
+type MultimodalEmbeddingResponse struct {
+	Predictions []MultimodalPrediction `json:"predictions"`
+}
+
 type ErrorInfo struct {
 	Code      string `json:"code"`
 	Message   string `json:"message"`
@@ -114,7 +143,7 @@ func (c *VertexAIEmbedding) getAccessToken() (string, error) {
 	return token.AccessToken, nil
 }
 
-func (c *VertexAIEmbedding) Embedding(modelName string, texts []string, dim int64, taskType string, timeoutSec int64) (*EmbeddingResponse, error) {
+func (c *VertexAIEmbedding) Embedding(ctx context.Context, modelName string, texts []string, dim int64, taskType string, timeoutSec int64) (*EmbeddingResponse, error) {
 	var r EmbeddingRequest
 	for _, text := range texts {
 		r.Instances = append(r.Instances, Instance{TaskType: taskType, Content: text})
@@ -123,6 +152,39 @@ func (c *VertexAIEmbedding) Embedding(modelName string, texts []string, dim int6
 		r.Parameters.OutputDimensionality = dim
 	}
 
+	body, err := c.send(ctx, r, timeoutSec)
+	if err != nil {
+		return nil, err
+	}
+	var res EmbeddingResponse
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, err
+}
+
+// MultimodalEmbedding calls the multimodal embedding model, the image and the text of an instance
+// are embedded separately into the same vector space.
+func (c *VertexAIEmbedding) MultimodalEmbedding(ctx context.Context, instances []MultimodalInstance, dim int64, timeoutSec int64) (*MultimodalEmbeddingResponse, error) {
+	r := MultimodalEmbeddingRequest{Instances: instances}
+	if dim != 0 {
+		r.Parameters.Dimension = dim
+	}
+
+	body, err := c.send(ctx, r, timeoutSec)
+	if err != nil {
+		return nil, err
+	}
+	var res MultimodalEmbeddingResponse
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, err
+}
+
+func (c *VertexAIEmbedding) send(ctx context.Context, r any, timeoutSec int64) ([]byte, error) {
 	data, err := json.Marshal(r)
 	if err != nil {
 		return nil, err
@@ -132,7 +194,7 @@ func (c *VertexAIEmbedding) Embedding(modelName string, texts []string, dim int6
 		timeoutSec = utils.DefaultTimeout
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSec)*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
 	defer cancel()
 	var token string
 	if c.token != "" {
@@ -148,14 +210,5 @@ func (c *VertexAIEmbedding) Embedding(modelName string, texts []string, dim int6
 		"Content-Type":  "application/json",
 		"Authorization": fmt.Sprintf("Bearer %s", token),
 	}
-	body, err := utils.RetrySend(ctx, data, http.MethodPost, c.url, headers, 3)
-	if err != nil {
-		return nil, err
-	}
-	var res EmbeddingResponse
-	err = json.Unmarshal(body, &res)
-	if err != nil {
-		return nil, err
-	}
-	return &res, err
+	return utils.RetrySend(ctx, data, http.MethodPost, c.url, headers, 3)
 }
@@ -17,8 +17,10 @@
 package vertexai
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -67,7 +69,7 @@ func TestEmbeddingOK(t *testing.T) {
 		c := NewVertexAIEmbedding(url, []byte{1, 2, 3}, "mock_scopes", "mock_token")
 		err := c.Check()
 		assert.True(t, err == nil)
-		_, err = c.Embedding("text-embedding-005", []string{"sentence"}, 0, "query", 0)
+		_, err = c.Embedding(context.Background(), "text-embedding-005", []string{"sentence"}, 0, "query", 0)
 		assert.True(t, err == nil)
 	}
 }
@@ -84,7 +86,27 @@ func TestEmbeddingFailed(t *testing.T) {
 		c := NewVertexAIEmbedding(url, []byte{1, 2, 3}, "mock_scopes", "mock_token")
 		err := c.Check()
 		assert.True(t, err == nil)
-		_, err = c.Embedding("text-embedding-v2", []string{"sentence"}, 0, "query", 0)
+		_, err = c.Embedding(context.Background(), "text-embedding-v2", []string{"sentence"}, 0, "query", 0)
 		assert.True(t, err != nil)
 	}
 }
+
+func TestMultimodalEmbeddingOK(t *testing.T) {
+	var req MultimodalEmbeddingRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &req)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"predictions": [{"imageEmbedding": [0.1, 0.2], "textEmbedding": [0.3, 0.4]}]}`))
+	}))
+	defer ts.Close()
+
+	c := NewVertexAIEmbedding(ts.URL, []byte{1, 2, 3}, "mock_scopes", "mock_token")
+	instances := []MultimodalInstance{{Text: "sentence", Image: &MultimodalImage{GcsURI: "gs://bucket/a.jpg"}}}
+	res, err := c.MultimodalEmbedding(context.Background(), instances, 128, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{0.1, 0.2}, res.Predictions[0].ImageEmbedding)
+	assert.Equal(t, []float32{0.3, 0.4}, res.Predictions[0].TextEmbedding)
+	assert.Equal(t, int64(128), req.Parameters.Dimension)
+	assert.Equal(t, instances, req.Instances)
+}
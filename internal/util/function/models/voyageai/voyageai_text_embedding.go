@@ -109,7 +109,7 @@ func (c *VoyageAIEmbedding) Check() error {
 	return nil
 }
 
-func (c *VoyageAIEmbedding) Embedding(modelName string, texts []string, dim int, textType string, outputType string, truncation bool, timeoutSec int64) (any, error) {
+func (c *VoyageAIEmbedding) Embedding(ctx context.Context, modelName string, texts []string, dim int, textType string, outputType string, truncation bool, timeoutSec int64) (any, error) {
 	if outputType != "float" && outputType != "int8" {
 		return nil, fmt.Errorf("Voyageai: unsupport output type: [%s], only support float and int8", outputType)
 	}
@@ -132,7 +132,7 @@ func (c *VoyageAIEmbedding) Embedding(modelName string, texts []string, dim int,
 		timeoutSec = utils.DefaultTimeout
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSec)*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
 	defer cancel()
 	headers := map[string]string{
 		"Content-Type":  "application/json",
@@ -17,6 +17,7 @@
 package voyageai
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -98,7 +99,7 @@ func TestEmbeddingOK(t *testing.T) {
 		c := NewVoyageAIEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		r, err := c.Embedding("voyage-3", []string{"sentence"}, 0, "query", "float", true, 0)
+		r, err := c.Embedding(context.Background(), "voyage-3", []string{"sentence"}, 0, "query", "float", true, 0)
 		ret := r.(*EmbeddingResponse[float32])
 		assert.True(t, err == nil)
 		assert.Equal(t, ret.Data[0].Index, 0)
@@ -158,7 +159,7 @@ func TestEmbeddingInt8Embed(t *testing.T) {
 		c := NewVoyageAIEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		r, err := c.Embedding("voyage-3", []string{"sentence"}, 0, "query", "int8", false, 0)
+		r, err := c.Embedding(context.Background(), "voyage-3", []string{"sentence"}, 0, "query", "int8", false, 0)
 		ret := r.(*EmbeddingResponse[int8])
 		assert.True(t, err == nil)
 		assert.Equal(t, ret.Data[0].Index, 0)
@@ -169,7 +170,7 @@ func TestEmbeddingInt8Embed(t *testing.T) {
 		assert.Equal(t, ret.Data[1].Embedding, []int8{3, 4})
 		assert.Equal(t, ret.Data[2].Embedding, []int8{5, 6})
 
-		_, err = c.Embedding("voyage-3", []string{"sentence"}, 0, "query", "unknow", true, 0)
+		_, err = c.Embedding(context.Background(), "voyage-3", []string{"sentence"}, 0, "query", "unknow", true, 0)
 		assert.Error(t, err)
 	}
 }
@@ -186,7 +187,7 @@ func TestEmbeddingFailed(t *testing.T) {
 		c := NewVoyageAIEmbeddingClient("mock_key", url)
 		err := c.Check()
 		assert.True(t, err == nil)
-		_, err = c.Embedding("voyage-3", []string{"sentence"}, 0, "query", "float", false, 0)
+		_, err = c.Embedding(context.Background(), "voyage-3", []string{"sentence"}, 0, "query", "float", false, 0)
 		assert.True(t, err != nil)
 	}
 }
@@ -0,0 +1,285 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func MultimodalEmbeddingOutputsCheck(fields []*schemapb.FieldSchema) error {
+	if len(fields) != 1 || fields[0].DataType != schemapb.DataType_FloatVector {
+		return errors.New("MultimodalEmbedding function output field must be a FloatVector field")
+	}
+	return nil
+}
+
+// MultimodalEmbeddingFunction embeds the image field, and optionally the text field describing the image,
+// into the same vector space as the texts, so the collection can be searched by text queries.
+type MultimodalEmbeddingFunction struct {
+	FunctionBase
+
+	embProvider multimodalEmbeddingProvider
+	// the image field, and the optional text field
+	inputFields []*schemapb.FieldSchema
+}
+
+func NewMultimodalEmbeddingFunction(coll *schemapb.CollectionSchema, functionSchema *schemapb.FunctionSchema) (*MultimodalEmbeddingFunction, error) {
+	if len(functionSchema.GetOutputFieldNames()) != 1 {
+		return nil, fmt.Errorf("MultimodalEmbedding function should only have one output field, but now is %d", len(functionSchema.GetOutputFieldNames()))
+	}
+
+	base, err := NewFunctionBase(coll, functionSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(functionSchema.GetInputFieldNames()) != 1 && len(functionSchema.GetInputFieldNames()) != 2 {
+		return nil, fmt.Errorf("MultimodalEmbedding function receives an image field and an optional text field, but got [%d] input fields", len(functionSchema.GetInputFieldNames()))
+	}
+	inputFields := make([]*schemapb.FieldSchema, 0, len(functionSchema.GetInputFieldNames()))
+	for _, fieldName := range functionSchema.GetInputFieldNames() {
+		for _, field := range coll.GetFields() {
+			if field.GetName() == fieldName {
+				inputFields = append(inputFields, field)
+				break
+			}
+		}
+	}
+	if len(inputFields) != len(functionSchema.GetInputFieldNames()) {
+		return nil, fmt.Errorf("The collection [%s]'s information is wrong, function [%s]'s inputs does not match the schema", coll.Name, functionSchema.Name)
+	}
+	for _, field := range inputFields {
+		if !isValidInputDataType(field.DataType) {
+			return nil, fmt.Errorf("MultimodalEmbedding function only supports varchar or text field as input field, but got %s", schemapb.DataType_name[int32(field.DataType)])
+		}
+	}
+
+	if err := MultimodalEmbeddingOutputsCheck(base.outputFields); err != nil {
+		return nil, err
+	}
+
+	var embP multimodalEmbeddingProvider
+	var newProviderErr error
+	conf := paramtable.Get().FunctionCfg.GetMultimodalEmbeddingProviderConfig(base.provider)
+	credentials := credentials.NewCredentials(paramtable.Get().CredentialCfg.GetCredentials())
+	switch base.provider {
+	case clipProvider:
+		embP, newProviderErr = NewCLIPEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
+	case vertexAIProvider:
+		embP, newProviderErr = NewVertexAIMultimodalEmbeddingProvider(base.outputFields[0], functionSchema, nil, conf, credentials)
+	default:
+		return nil, fmt.Errorf("Unsupported multimodal embedding service provider: [%s] , list of supported [%s, %s]", base.provider, clipProvider, vertexAIProvider)
+	}
+
+	if newProviderErr != nil {
+		return nil, newProviderErr
+	}
+	return &MultimodalEmbeddingFunction{
+		FunctionBase: *base,
+		embProvider:  embP,
+		inputFields:  inputFields,
+	}, nil
+}
+
+func (runner *MultimodalEmbeddingFunction) Check() error {
+	embds, err := runner.embProvider.CallEmbedding(context.Background(), []*multimodalInput{{text: "check"}})
+	if err != nil {
+		return err
+	}
+	if len(embds) != 1 || len(embds[0]) != int(runner.embProvider.FieldDim()) {
+		return fmt.Errorf("The dim set in the schema is inconsistent with the dim of the model, dim in schema is %d", runner.embProvider.FieldDim())
+	}
+	return nil
+}
+
+func (runner *MultimodalEmbeddingFunction) MaxBatch() int {
+	return runner.embProvider.MaxBatch()
+}
+
+func (runner *MultimodalEmbeddingFunction) GetCollectionName() string {
+	return runner.collectionName
+}
+
+func (runner *MultimodalEmbeddingFunction) GetFunctionProvider() string {
+	return runner.provider
+}
+
+func (runner *MultimodalEmbeddingFunction) GetFunctionTypeName() string {
+	return runner.functionTypeName
+}
+
+func (runner *MultimodalEmbeddingFunction) GetFunctionName() string {
+	return runner.functionName
+}
+
+// buildInputs pairs every image with the text of the same row, the texts can be empty.
+// All the images are checked here, so an unsupported image fails the batch before any request is sent.
+func (runner *MultimodalEmbeddingFunction) buildInputs(images []string, texts []string) ([]*multimodalInput, error) {
+	if texts != nil && len(texts) != len(images) {
+		return nil, fmt.Errorf("The number of images [%d] and texts [%d] does not match", len(images), len(texts))
+	}
+	inputs := make([]*multimodalInput, 0, len(images))
+	for i, image := range images {
+		if image == "" {
+			return nil, errors.New("There is an empty image in the input data, MultimodalEmbedding function does not support empty image")
+		}
+		img, err := parseImageInput(image)
+		if err != nil {
+			return nil, err
+		}
+		if err := runner.embProvider.CheckImage(img); err != nil {
+			return nil, err
+		}
+		input := &multimodalInput{image: img}
+		if texts != nil {
+			input.text = texts[i]
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs, nil
+}
+
+func (runner *MultimodalEmbeddingFunction) ProcessInsert(ctx context.Context, inputs []*schemapb.FieldData) ([]*schemapb.FieldData, error) {
+	if len(inputs) != len(runner.inputFields) {
+		return nil, fmt.Errorf("MultimodalEmbedding function receives [%d] input fields, but got [%d]", len(runner.inputFields), len(inputs))
+	}
+	for _, input := range inputs {
+		if !isValidInputDataType(input.Type) {
+			return nil, fmt.Errorf("MultimodalEmbedding function only supports varchar or text field as input field, but got %s", schemapb.DataType_name[int32(input.Type)])
+		}
+	}
+
+	images := inputs[0].GetScalars().GetStringData().GetData()
+	if images == nil {
+		return nil, errors.New("Input images is empty")
+	}
+	var texts []string
+	if len(inputs) == 2 {
+		texts = inputs[1].GetScalars().GetStringData().GetData()
+		if texts == nil {
+			texts = make([]string, len(images))
+		}
+	}
+	if len(images) > runner.MaxBatch() {
+		return nil, fmt.Errorf("Embedding supports up to [%d] pieces of data at a time, got [%d]", runner.MaxBatch(), len(images))
+	}
+	embInputs, err := runner.buildInputs(images, texts)
+	if err != nil {
+		return nil, err
+	}
+	embds, err := runner.embProvider.CallEmbedding(ctx, embInputs)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]float32, 0, len(embds)*int(runner.embProvider.FieldDim()))
+	for _, emb := range embds {
+		data = append(data, emb...)
+	}
+	var outputField schemapb.FieldData
+	outputField.FieldId = runner.GetOutputFields()[0].FieldID
+	outputField.FieldName = runner.GetOutputFields()[0].Name
+	outputField.Type = runner.GetOutputFields()[0].DataType
+	outputField.IsDynamic = runner.GetOutputFields()[0].IsDynamic
+	outputField.Field = &schemapb.FieldData_Vectors{
+		Vectors: &schemapb.VectorField{
+			Data: &schemapb.VectorField_FloatVector{
+				FloatVector: &schemapb.FloatArray{
+					Data: data,
+				},
+			},
+			Dim: runner.embProvider.FieldDim(),
+		},
+	}
+	return []*schemapb.FieldData{&outputField}, nil
+}
+
+// ProcessSearch embeds the text queries, the text and image embeddings share the same vector space
+func (runner *MultimodalEmbeddingFunction) ProcessSearch(ctx context.Context, placeholderGroup *commonpb.PlaceholderGroup) (*commonpb.PlaceholderGroup, error) {
+	texts := funcutil.GetVarCharFromPlaceholder(placeholderGroup.Placeholders[0]) // Already checked externally
+	numRows := len(texts)
+	if numRows > runner.MaxBatch() {
+		return nil, fmt.Errorf("Embedding supports up to [%d] pieces of data at a time, got [%d]", runner.MaxBatch(), numRows)
+	}
+	if hasEmptyString(texts) {
+		return nil, errors.New("There is an empty string in the queries, MultimodalEmbedding function does not support empty text")
+	}
+	embInputs := make([]*multimodalInput, 0, numRows)
+	for _, text := range texts {
+		embInputs = append(embInputs, &multimodalInput{text: text})
+	}
+	embds, err := runner.embProvider.CallEmbedding(ctx, embInputs)
+	if err != nil {
+		return nil, err
+	}
+	return funcutil.Float32VectorsToPlaceholderGroup(embds), nil
+}
+
+func (runner *MultimodalEmbeddingFunction) ProcessBulkInsert(inputs []storage.FieldData) (map[storage.FieldID]storage.FieldData, error) {
+	if len(inputs) != len(runner.inputFields) {
+		return nil, fmt.Errorf("MultimodalEmbedding function receives [%d] input fields, but got [%d]", len(runner.inputFields), len(inputs))
+	}
+	for _, input := range inputs {
+		if !isValidInputDataType(input.GetDataType()) {
+			return nil, fmt.Errorf("MultimodalEmbedding function only supports varchar or text field as input field, but got %s", schemapb.DataType_name[int32(input.GetDataType())])
+		}
+	}
+
+	images, ok := inputs[0].GetDataRows().([]string)
+	if !ok {
+		return nil, errors.New("Input images is empty")
+	}
+	var texts []string
+	if len(inputs) == 2 {
+		// In storage.FieldData, null is also stored as an empty string
+		if texts, ok = inputs[1].GetDataRows().([]string); !ok {
+			return nil, errors.New("Input texts is empty")
+		}
+	}
+	embInputs, err := runner.buildInputs(images, texts)
+	if err != nil {
+		return nil, err
+	}
+	embds, err := runner.embProvider.CallEmbedding(context.Background(), embInputs)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]float32, 0, len(embds)*int(runner.embProvider.FieldDim()))
+	for _, emb := range embds {
+		data = append(data, emb...)
+	}
+	field := &storage.FloatVectorFieldData{
+		Data: data,
+		Dim:  int(runner.embProvider.FieldDim()),
+	}
+	return map[storage.FieldID]storage.FieldData{
+		runner.outputFields[0].FieldID: field,
+	}, nil
+}
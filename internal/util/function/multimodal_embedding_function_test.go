@@ -0,0 +1,374 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function/models/vertexai"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	mockImageURL    string = "https://example.com/cat.png"
+	mockImageBase64 string = "aGVsbG8="
+)
+
+func TestMultimodalEmbeddingFunction(t *testing.T) {
+	suite.Run(t, new(MultimodalEmbeddingFunctionSuite))
+}
+
+type MultimodalEmbeddingFunctionSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *MultimodalEmbeddingFunctionSuite) SetupTest() {
+	paramtable.Init()
+	paramtable.Get().CredentialCfg.Credential.GetFunc = func() map[string]string {
+		return map[string]string{
+			"mock.apikey": "mock",
+		}
+	}
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "image", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "caption", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 103, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+			{FieldID: 104, Name: "ts", DataType: schemapb.DataType_Int64},
+		},
+	}
+}
+
+func multimodalFunctionSchema(inputs []string, params ...*commonpb.KeyValuePair) *schemapb.FunctionSchema {
+	ids := map[string]int64{"image": 101, "caption": 102, "ts": 104}
+	inputIDs := make([]int64, 0, len(inputs))
+	for _, name := range inputs {
+		inputIDs = append(inputIDs, ids[name])
+	}
+	return &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             schemapb.FunctionType_MultimodalEmbedding,
+		InputFieldNames:  inputs,
+		OutputFieldNames: []string{"vector"},
+		InputFieldIds:    inputIDs,
+		OutputFieldIds:   []int64{103},
+		Params:           params,
+	}
+}
+
+func createStringFieldData(fieldID int64, values []string) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:    schemapb.DataType_VarChar,
+		FieldId: fieldID,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{
+					StringData: &schemapb.StringArray{
+						Data: values,
+					},
+				},
+			},
+		},
+	}
+}
+
+func createTextPlaceholderGroup(texts []string) (*commonpb.PlaceholderGroup, error) {
+	placeholderGroupBytes, err := funcutil.FieldDataToPlaceholderGroupBytes(createStringFieldData(102, texts))
+	if err != nil {
+		return nil, err
+	}
+	placeholderGroup := &commonpb.PlaceholderGroup{}
+	if err := proto.Unmarshal(placeholderGroupBytes, placeholderGroup); err != nil {
+		return nil, err
+	}
+	return placeholderGroup, nil
+}
+
+func (s *MultimodalEmbeddingFunctionSuite) TestParseImageInput() {
+	{
+		img, err := parseImageInput(mockImageURL)
+		s.NoError(err)
+		s.Equal(mockImageURL, img.url)
+		img, err = parseImageInput("gs://bucket/cat.png")
+		s.NoError(err)
+		s.Equal("gs://bucket/cat.png", img.url)
+	}
+	{
+		img, err := parseImageInput("data:image/png;base64," + mockImageBase64)
+		s.NoError(err)
+		s.Equal(mockImageBase64, img.base64)
+		img, err = parseImageInput(mockImageBase64)
+		s.NoError(err)
+		s.Equal(mockImageBase64, img.base64)
+	}
+	{
+		_, err := parseImageInput("data:image/png,raw")
+		s.ErrorContains(err, "must be base64 encoded")
+		_, err = parseImageInput("not an image")
+		s.ErrorContains(err, "http/https/gs url or a base64 encoded image")
+		_, err = parseImageInput("")
+		s.Error(err)
+	}
+}
+
+func (s *MultimodalEmbeddingFunctionSuite) TestNewMultimodalEmbeddingFunction() {
+	ts := CreateCLIPEmbeddingServer(4)
+	defer ts.Close()
+	{
+		runner, err := NewMultimodalEmbeddingFunction(s.schema, multimodalFunctionSchema([]string{"image", "caption"},
+			&commonpb.KeyValuePair{Key: Provider, Value: clipProvider},
+			&commonpb.KeyValuePair{Key: EndpointParamKey, Value: ts.URL},
+			&commonpb.KeyValuePair{Key: credentialParamKey, Value: "mock"},
+		))
+		s.NoError(err)
+		s.NoError(runner.Check())
+		s.Equal("MultimodalEmbedding", runner.GetFunctionTypeName())
+		s.Equal(clipProvider, runner.GetFunctionProvider())
+		s.Equal(160, runner.MaxBatch())
+	}
+	{
+		// url from milvus.yaml
+		paramtable.Get().FunctionCfg.MultimodalEmbeddingProviders.GetFunc = func() map[string]string {
+			return map[string]string{
+				"clip.url": ts.URL,
+			}
+		}
+		defer func() {
+			paramtable.Get().FunctionCfg.MultimodalEmbeddingProviders.GetFunc = nil
+		}()
+		_, err := NewMultimodalEmbeddingFunction(s.schema, multimodalFunctionSchema([]string{"image"},
+			&commonpb.KeyValuePair{Key: Provider, Value: clipProvider},
+		))
+		s.NoError(err)
+	}
+	{
+		_, err := NewMultimodalEmbeddingFunction(s.schema, multimodalFunctionSchema([]string{"image", "caption", "image"},
+			&commonpb.KeyValuePair{Key: Provider, Value: clipProvider},
+		))
+		s.ErrorContains(err, "an image field and an optional text field")
+		_, err = NewMultimodalEmbeddingFunction(s.schema, multimodalFunctionSchema([]string{"ts"},
+			&commonpb.KeyValuePair{Key: Provider, Value: clipProvider},
+		))
+		s.ErrorContains(err, "only supports varchar or text field")
+		_, err = NewMultimodalEmbeddingFunction(s.schema, multimodalFunctionSchema([]string{"image"},
+			&commonpb.KeyValuePair{Key: Provider, Value: openAIProvider},
+		))
+		s.ErrorContains(err, "Unsupported multimodal embedding service provider")
+		_, err = NewMultimodalEmbeddingFunction(s.schema, multimodalFunctionSchema([]string{"image"},
+			&commonpb.KeyValuePair{Key: Provider, Value: clipProvider},
+			&commonpb.KeyValuePair{Key: EndpointParamKey, Value: "localhost:51000"},
+		))
+		s.ErrorContains(err, "is not a valid http/https link")
+		_, err = NewMultimodalEmbeddingFunction(s.schema, multimodalFunctionSchema([]string{"image"},
+			&commonpb.KeyValuePair{Key: Provider, Value: clipProvider},
+			&commonpb.KeyValuePair{Key: EndpointParamKey, Value: ts.URL},
+			&commonpb.KeyValuePair{Key: maxClientBatchSizeParamKey, Value: "-1"},
+		))
+		s.ErrorContains(err, "is not a valid positive number")
+	}
+	{
+		fSchema := multimodalFunctionSchema([]string{"image"}, &commonpb.KeyValuePair{Key: Provider, Value: clipProvider})
+		fSchema.OutputFieldNames = []string{"ts"}
+		fSchema.OutputFieldIds = []int64{104}
+		_, err := NewMultimodalEmbeddingFunction(s.schema, fSchema)
+		s.ErrorContains(err, "output field must be a FloatVector field")
+	}
+}
+
+func (s *MultimodalEmbeddingFunctionSuite) TestProcess() {
+	ts := CreateCLIPEmbeddingServer(4)
+	defer ts.Close()
+	runner, err := NewMultimodalEmbeddingFunction(s.schema, multimodalFunctionSchema([]string{"image", "caption"},
+		&commonpb.KeyValuePair{Key: Provider, Value: clipProvider},
+		&commonpb.KeyValuePair{Key: EndpointParamKey, Value: ts.URL},
+		&commonpb.KeyValuePair{Key: maxClientBatchSizeParamKey, Value: "2"},
+	))
+	s.NoError(err)
+
+	// the image embeddings are 3, the text embeddings are 1, the image with the caption gets the average 2
+	{
+		images := createStringFieldData(101, []string{mockImageURL, "data:image/png;base64," + mockImageBase64, mockImageBase64})
+		captions := createStringFieldData(102, []string{"a cat", "", "a dog"})
+		ret, err := runner.ProcessInsert(context.Background(), []*schemapb.FieldData{images, captions})
+		s.NoError(err)
+		s.Equal(int64(103), ret[0].FieldId)
+		s.Equal(int64(4), ret[0].GetVectors().Dim)
+		s.Equal([]float32{2, 2, 2, 2, 3, 3, 3, 3, 2, 2, 2, 2}, ret[0].GetVectors().GetFloatVector().Data)
+	}
+	{
+		images := createStringFieldData(101, []string{mockImageURL, ""})
+		captions := createStringFieldData(102, []string{"a cat", "a dog"})
+		_, err := runner.ProcessInsert(context.Background(), []*schemapb.FieldData{images, captions})
+		s.ErrorContains(err, "empty image")
+		_, err = runner.ProcessInsert(context.Background(), []*schemapb.FieldData{images})
+		s.ErrorContains(err, "receives [2] input fields, but got [1]")
+		images = createStringFieldData(101, []string{"not an image"})
+		_, err = runner.ProcessInsert(context.Background(), []*schemapb.FieldData{images, createStringFieldData(102, []string{""})})
+		s.Error(err)
+	}
+	// search with text queries
+	{
+		placeholderGroup, err := createTextPlaceholderGroup([]string{"a cat", "a dog"})
+		s.NoError(err)
+		ret, err := runner.ProcessSearch(context.Background(), placeholderGroup)
+		s.NoError(err)
+		s.Equal(commonpb.PlaceholderType_FloatVector, ret.Placeholders[0].Type)
+		s.Equal(2, len(ret.Placeholders[0].Values))
+
+		placeholderGroup, _ = createTextPlaceholderGroup([]string{""})
+		_, err = runner.ProcessSearch(context.Background(), placeholderGroup)
+		s.ErrorContains(err, "empty string in the queries")
+	}
+	// bulk insert
+	{
+		images := &storage.StringFieldData{DataType: schemapb.DataType_VarChar, Data: []string{mockImageURL, mockImageURL, mockImageURL}}
+		captions := &storage.StringFieldData{DataType: schemapb.DataType_VarChar, Data: []string{"a cat", "", ""}}
+		ret, err := runner.ProcessBulkInsert([]storage.FieldData{images, captions})
+		s.NoError(err)
+		s.Equal([]float32{2, 2, 2, 2, 3, 3, 3, 3, 3, 3, 3, 3}, ret[103].(*storage.FloatVectorFieldData).Data)
+
+		_, err = runner.ProcessBulkInsert([]storage.FieldData{images})
+		s.Error(err)
+	}
+}
+
+func (s *MultimodalEmbeddingFunctionSuite) TestCLIPDimNotMatch() {
+	ts := CreateCLIPEmbeddingServer(2)
+	defer ts.Close()
+	runner, err := NewMultimodalEmbeddingFunction(s.schema, multimodalFunctionSchema([]string{"image"},
+		&commonpb.KeyValuePair{Key: Provider, Value: clipProvider},
+		&commonpb.KeyValuePair{Key: EndpointParamKey, Value: ts.URL},
+	))
+	s.NoError(err)
+	s.Error(runner.Check())
+	_, err = runner.ProcessInsert(context.Background(), []*schemapb.FieldData{createStringFieldData(101, []string{mockImageURL})})
+	s.ErrorContains(err, "The required embedding dim is [4]")
+}
+
+func (s *MultimodalEmbeddingFunctionSuite) TestVertexAIProvider() {
+	ts := CreateVertexAIMultimodalEmbeddingServer(4)
+	defer ts.Close()
+	fSchema := multimodalFunctionSchema([]string{"image", "caption"},
+		&commonpb.KeyValuePair{Key: Provider, Value: vertexAIProvider},
+		&commonpb.KeyValuePair{Key: projectIDParamKey, Value: "mock_id"},
+		&commonpb.KeyValuePair{Key: dimParamKey, Value: "4"},
+	)
+	mockClient := vertexai.NewVertexAIEmbedding(ts.URL, []byte{1, 2, 3}, "mock scope", "mock token")
+	provider, err := NewVertexAIMultimodalEmbeddingProvider(s.schema.Fields[3], fSchema, mockClient, map[string]string{}, credentials.NewCredentials(map[string]string{}))
+	s.NoError(err)
+	{
+		ret, err := provider.CallEmbedding(context.Background(), []*multimodalInput{
+			{image: &imageInput{url: "gs://bucket/cat.png"}, text: "a cat"},
+			{image: &imageInput{base64: mockImageBase64}},
+			{text: "a dog"},
+		})
+		s.NoError(err)
+		s.Equal([][]float32{{2, 2, 2, 2}, {3, 3, 3, 3}, {1, 1, 1, 1}}, ret)
+	}
+	{
+		_, err := provider.CallEmbedding(context.Background(), []*multimodalInput{{image: &imageInput{url: mockImageURL}}})
+		s.ErrorContains(err, "only supports the gs:// image url")
+	}
+	{
+		// the http image url fails the batch before any request is sent
+		numRequests := atomic.NewInt32(0)
+		countingTs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			numRequests.Inc()
+			ts.Config.Handler.ServeHTTP(w, r)
+		}))
+		defer countingTs.Close()
+		countingProvider, err := NewVertexAIMultimodalEmbeddingProvider(s.schema.Fields[3], fSchema,
+			vertexai.NewVertexAIEmbedding(countingTs.URL, []byte{1, 2, 3}, "mock scope", "mock token"), map[string]string{}, credentials.NewCredentials(map[string]string{}))
+		s.NoError(err)
+		runner := &MultimodalEmbeddingFunction{embProvider: countingProvider, inputFields: s.schema.Fields[1:3]}
+		images := createStringFieldData(101, []string{"gs://bucket/cat.png", mockImageURL})
+		_, err = runner.ProcessInsert(context.Background(), []*schemapb.FieldData{images, createStringFieldData(102, []string{"", ""})})
+		s.ErrorContains(err, "only supports the gs:// image url")
+		s.Equal(int32(0), numRequests.Load())
+	}
+	{
+		// one request per input, at most vertexAIMultimodalConcurrency requests in flight
+		var inflight, maxInflight atomic.Int32
+		concurrentTs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := inflight.Inc()
+			defer inflight.Dec()
+			for {
+				m := maxInflight.Load()
+				if n <= m || maxInflight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			ts.Config.Handler.ServeHTTP(w, r)
+		}))
+		defer concurrentTs.Close()
+		concurrentProvider, err := NewVertexAIMultimodalEmbeddingProvider(s.schema.Fields[3], fSchema,
+			vertexai.NewVertexAIEmbedding(concurrentTs.URL, []byte{1, 2, 3}, "mock scope", "mock token"), map[string]string{}, credentials.NewCredentials(map[string]string{}))
+		s.NoError(err)
+		s.Equal(vertexAIMultimodalMaxBatch, concurrentProvider.MaxBatch())
+		inputs := make([]*multimodalInput, 0, concurrentProvider.MaxBatch())
+		expected := make([][]float32, 0, concurrentProvider.MaxBatch())
+		for i := 0; i < concurrentProvider.MaxBatch(); i++ {
+			if i%2 == 0 {
+				inputs = append(inputs, &multimodalInput{image: &imageInput{base64: mockImageBase64}})
+				expected = append(expected, []float32{3, 3, 3, 3})
+			} else {
+				inputs = append(inputs, &multimodalInput{text: "a dog"})
+				expected = append(expected, []float32{1, 1, 1, 1})
+			}
+		}
+		ret, err := concurrentProvider.CallEmbedding(context.Background(), inputs)
+		s.NoError(err)
+		s.Equal(expected, ret)
+		s.LessOrEqual(maxInflight.Load(), int32(vertexAIMultimodalConcurrency))
+		s.Greater(maxInflight.Load(), int32(1))
+	}
+	{
+		fSchema := multimodalFunctionSchema([]string{"image"},
+			&commonpb.KeyValuePair{Key: Provider, Value: vertexAIProvider},
+		)
+		_, err := NewVertexAIMultimodalEmbeddingProvider(s.schema.Fields[3], fSchema, mockClient, map[string]string{}, credentials.NewCredentials(map[string]string{}))
+		s.ErrorContains(err, "lost param: projectid")
+
+		fSchema.Params = append(fSchema.Params, &commonpb.KeyValuePair{Key: dimParamKey, Value: "8"})
+		_, err = NewVertexAIMultimodalEmbeddingProvider(s.schema.Fields[3], fSchema, mockClient, map[string]string{"url": ts.URL}, credentials.NewCredentials(map[string]string{}))
+		s.Error(err)
+	}
+}
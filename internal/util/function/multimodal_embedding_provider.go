@@ -0,0 +1,357 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function/models/clip"
+	"github.com/milvus-io/milvus/internal/util/function/models/vertexai"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const (
+	clipProvider string = "clip"
+
+	defaultVertexAIMultimodalModel string = "multimodalembedding@001"
+	// the multimodal model of VertexAI accepts one instance per request, the requests of a batch are sent concurrently
+	vertexAIMultimodalMaxBatch    int = 32
+	vertexAIMultimodalConcurrency int = 8
+)
+
+// imageInput is an image stored in a VARCHAR field, either the url of the image or the base64 encoded image.
+type imageInput struct {
+	url    string
+	base64 string
+}
+
+// parseImageInput accepts http/https/gs urls, data urls such as `data:image/png;base64,...` and plain base64 strings.
+func parseImageInput(value string) (*imageInput, error) {
+	value = strings.TrimSpace(value)
+	lower := strings.ToLower(value)
+	if strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") || strings.HasPrefix(lower, "gs://") {
+		return &imageInput{url: value}, nil
+	}
+	if strings.HasPrefix(lower, "data:") {
+		idx := strings.Index(value, ";base64,")
+		if idx < 0 {
+			return nil, errors.New("The image data url must be base64 encoded")
+		}
+		value = value[idx+len(";base64,"):]
+	}
+	if _, err := base64.StdEncoding.DecodeString(value); err != nil || value == "" {
+		return nil, errors.New("The image must be a http/https/gs url or a base64 encoded image")
+	}
+	return &imageInput{base64: value}, nil
+}
+
+// multimodalInput is a row of the multimodal embedding function, at least one of the image and the text is set.
+type multimodalInput struct {
+	image *imageInput
+	text  string
+}
+
+type multimodalEmbeddingProvider interface {
+	MaxBatch() int
+	FieldDim() int64
+	// CheckImage returns an error if the provider can't embed the image, the function checks all
+	// the images before sending any request.
+	CheckImage(image *imageInput) error
+	// CallEmbedding returns an embedding for every input, the embedding of an input with both
+	// the image and the text is the average of the image embedding and the text embedding.
+	CallEmbedding(ctx context.Context, inputs []*multimodalInput) ([][]float32, error)
+}
+
+func fuseEmbeddings(imageEmbd []float32, textEmbd []float32) ([]float32, error) {
+	if len(imageEmbd) != len(textEmbd) {
+		return nil, fmt.Errorf("The dim of the image embedding [%d] and the text embedding [%d] does not match", len(imageEmbd), len(textEmbd))
+	}
+	embd := make([]float32, len(imageEmbd))
+	for i := range embd {
+		embd[i] = (imageEmbd[i] + textEmbd[i]) / 2
+	}
+	return embd, nil
+}
+
+func checkEmbeddingDim(embd []float32, fieldDim int64) error {
+	if len(embd) != int(fieldDim) {
+		return fmt.Errorf("The required embedding dim is [%d], but the embedding obtained from the model is [%d]", fieldDim, len(embd))
+	}
+	return nil
+}
+
+type CLIPEmbeddingProvider struct {
+	fieldDim int64
+
+	client     *clip.CLIPEmbedding
+	maxBatch   int
+	timeoutSec int64
+}
+
+func NewCLIPEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchema *schemapb.FunctionSchema, params map[string]string, credentials *credentials.Credentials) (*CLIPEmbeddingProvider, error) {
+	fieldDim, err := typeutil.GetDim(fieldSchema)
+	if err != nil {
+		return nil, err
+	}
+	var endpoint string
+	maxBatch := 32
+	for _, param := range functionSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case EndpointParamKey:
+			endpoint = param.Value
+		case maxClientBatchSizeParamKey:
+			if maxBatch, err = strconv.Atoi(param.Value); err != nil || maxBatch <= 0 {
+				return nil, fmt.Errorf("[%s param's value: %s] is not a valid positive number", maxClientBatchSizeParamKey, param.Value)
+			}
+		default:
+		}
+	}
+
+	apiKey, url, err := parseAKAndURL(credentials, functionSchema.Params, params, clipAKEnvStr)
+	if err != nil {
+		return nil, err
+	}
+	// function param > milvus.yaml
+	if endpoint == "" {
+		endpoint = url
+	}
+	c, err := clip.NewCLIPEmbeddingClient(apiKey, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &CLIPEmbeddingProvider{
+		fieldDim:   fieldDim,
+		client:     c,
+		maxBatch:   maxBatch,
+		timeoutSec: 30,
+	}, nil
+}
+
+func (provider *CLIPEmbeddingProvider) MaxBatch() int {
+	return 5 * provider.maxBatch
+}
+
+func (provider *CLIPEmbeddingProvider) FieldDim() int64 {
+	return provider.fieldDim
+}
+
+func (provider *CLIPEmbeddingProvider) CheckImage(image *imageInput) error {
+	return nil
+}
+
+func (provider *CLIPEmbeddingProvider) CallEmbedding(ctx context.Context, inputs []*multimodalInput) ([][]float32, error) {
+	// the images and the texts are sent as separate documents, docIdx records the documents of every input
+	docs := make([]clip.Document, 0, len(inputs))
+	docIdx := make([][2]int, 0, len(inputs))
+	for _, input := range inputs {
+		idx := [2]int{-1, -1}
+		if input.image != nil {
+			idx[0] = len(docs)
+			docs = append(docs, clip.Document{URI: input.image.url, Blob: input.image.base64})
+		}
+		if input.text != "" {
+			idx[1] = len(docs)
+			docs = append(docs, clip.Document{Text: input.text})
+		}
+		docIdx = append(docIdx, idx)
+	}
+
+	embds := make([][]float32, 0, len(docs))
+	for i := 0; i < len(docs); i += provider.maxBatch {
+		end := i + provider.maxBatch
+		if end > len(docs) {
+			end = len(docs)
+		}
+		resp, err := provider.client.Embedding(ctx, docs[i:end], provider.timeoutSec)
+		if err != nil {
+			return nil, err
+		}
+		if end-i != len(resp) {
+			return nil, fmt.Errorf("Get embedding failed. The number of inputs and embeddings does not match input:[%d], embedding:[%d]", end-i, len(resp))
+		}
+		for _, embd := range resp {
+			if err := checkEmbeddingDim(embd, provider.fieldDim); err != nil {
+				return nil, err
+			}
+		}
+		embds = append(embds, resp...)
+	}
+
+	data := make([][]float32, 0, len(inputs))
+	for _, idx := range docIdx {
+		switch {
+		case idx[0] >= 0 && idx[1] >= 0:
+			embd, err := fuseEmbeddings(embds[idx[0]], embds[idx[1]])
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, embd)
+		case idx[0] >= 0:
+			data = append(data, embds[idx[0]])
+		default:
+			data = append(data, embds[idx[1]])
+		}
+	}
+	return data, nil
+}
+
+type VertexAIMultimodalEmbeddingProvider struct {
+	fieldDim int64
+
+	client        *vertexai.VertexAIEmbedding
+	embedDimParam int64
+	timeoutSec    int64
+}
+
+func NewVertexAIMultimodalEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchema *schemapb.FunctionSchema, c *vertexai.VertexAIEmbedding, params map[string]string, credentials *credentials.Credentials) (*VertexAIMultimodalEmbeddingProvider, error) {
+	fieldDim, err := typeutil.GetDim(fieldSchema)
+	if err != nil {
+		return nil, err
+	}
+	var location, projectID string
+	var dim int64
+	modelName := defaultVertexAIMultimodalModel
+	for _, param := range functionSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case modelNameParamKey:
+			modelName = param.Value
+		case dimParamKey:
+			dim, err = parseAndCheckFieldDim(param.Value, fieldDim, fieldSchema.Name)
+			if err != nil {
+				return nil, err
+			}
+		case locationParamKey:
+			location = param.Value
+		case projectIDParamKey:
+			projectID = param.Value
+		default:
+		}
+	}
+
+	if location == "" {
+		location = "us-central1"
+	}
+
+	url := params["url"]
+	if url == "" {
+		if projectID == "" {
+			return nil, fmt.Errorf("VertexAI multimodal embedding lost param: %s", projectIDParamKey)
+		}
+		url = fmt.Sprintf("https://%s-aiplatform.googleapis.com/v1/projects/%s/locations/%s/publishers/google/models/%s:predict", location, projectID, location, modelName)
+	}
+	var client *vertexai.VertexAIEmbedding
+	if c == nil {
+		jsonKey, err := parseGcpCredentialInfo(credentials, functionSchema.Params, params)
+		if err != nil {
+			return nil, err
+		}
+		client, err = createVertexAIEmbeddingClient(url, jsonKey)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		client = c
+	}
+
+	return &VertexAIMultimodalEmbeddingProvider{
+		fieldDim:      fieldDim,
+		client:        client,
+		embedDimParam: dim,
+		timeoutSec:    30,
+	}, nil
+}
+
+func (provider *VertexAIMultimodalEmbeddingProvider) MaxBatch() int {
+	return vertexAIMultimodalMaxBatch
+}
+
+func (provider *VertexAIMultimodalEmbeddingProvider) FieldDim() int64 {
+	return provider.fieldDim
+}
+
+func (provider *VertexAIMultimodalEmbeddingProvider) CheckImage(image *imageInput) error {
+	if image.url != "" && !strings.HasPrefix(strings.ToLower(image.url), "gs://") {
+		return fmt.Errorf("VertexAI multimodal embedding only supports the gs:// image url or the base64 encoded image, got [%s]", image.url)
+	}
+	return nil
+}
+
+func (provider *VertexAIMultimodalEmbeddingProvider) CallEmbedding(ctx context.Context, inputs []*multimodalInput) ([][]float32, error) {
+	instances := make([]vertexai.MultimodalInstance, 0, len(inputs))
+	for _, input := range inputs {
+		instance := vertexai.MultimodalInstance{Text: input.text}
+		if input.image != nil {
+			if err := provider.CheckImage(input.image); err != nil {
+				return nil, err
+			}
+			if input.image.url != "" {
+				instance.Image = &vertexai.MultimodalImage{GcsURI: input.image.url}
+			} else {
+				instance.Image = &vertexai.MultimodalImage{BytesBase64Encoded: input.image.base64}
+			}
+		}
+		instances = append(instances, instance)
+	}
+
+	data := make([][]float32, len(instances))
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(vertexAIMultimodalConcurrency)
+	for i := range instances {
+		instance := instances[i]
+		group.Go(func() error {
+			resp, err := provider.client.MultimodalEmbedding(ctx, []vertexai.MultimodalInstance{instance}, provider.embedDimParam, provider.timeoutSec)
+			if err != nil {
+				return err
+			}
+			if len(resp.Predictions) != 1 {
+				return fmt.Errorf("Get embedding failed. The number of inputs and embeddings does not match input:[1], embedding:[%d]", len(resp.Predictions))
+			}
+			prediction := resp.Predictions[0]
+			var embd []float32
+			switch {
+			case instance.Image != nil && instance.Text != "":
+				if embd, err = fuseEmbeddings(prediction.ImageEmbedding, prediction.TextEmbedding); err != nil {
+					return err
+				}
+			case instance.Image != nil:
+				embd = prediction.ImageEmbedding
+			default:
+				embd = prediction.TextEmbedding
+			}
+			if err := checkEmbeddingDim(embd, provider.fieldDim); err != nil {
+				return err
+			}
+			data[i] = embd
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,206 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function/models/ollama"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestOllamaEmbeddingProvider(t *testing.T) {
+	suite.Run(t, new(OllamaEmbeddingProviderSuite))
+}
+
+type OllamaEmbeddingProviderSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *OllamaEmbeddingProviderSuite) SetupTest() {
+	paramtable.Init()
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+		},
+	}
+}
+
+func (s *OllamaEmbeddingProviderSuite) newFunctionSchema(params ...*commonpb.KeyValuePair) *schemapb.FunctionSchema {
+	return &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             schemapb.FunctionType_TextEmbedding,
+		InputFieldNames:  []string{"text"},
+		OutputFieldNames: []string{"vector"},
+		InputFieldIds:    []int64{101},
+		OutputFieldIds:   []int64{102},
+		Params:           params,
+	}
+}
+
+func (s *OllamaEmbeddingProviderSuite) TestEmbedding() {
+	ts := CreateOllamaEmbeddingServer(4)
+	defer ts.Close()
+
+	functionSchema := s.newFunctionSchema(
+		&commonpb.KeyValuePair{Key: Provider, Value: ollamaProvider},
+		&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "nomic-embed-text"},
+		&commonpb.KeyValuePair{Key: EndpointParamKey, Value: ts.URL},
+		&commonpb.KeyValuePair{Key: maxClientBatchSizeParamKey, Value: "2"},
+	)
+	provider, err := NewOllamaEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, credentials.NewCredentials(map[string]string{}))
+	s.NoError(err)
+	s.Equal(int64(4), provider.FieldDim())
+	s.Equal(10, provider.MaxBatch())
+
+	{
+		r, err := provider.CallEmbedding(context.Background(), []string{"sentence"}, InsertMode)
+		s.NoError(err)
+		ret := r.([][]float32)
+		s.Equal(1, len(ret))
+		s.Equal(4, len(ret[0]))
+	}
+	{
+		r, err := provider.CallEmbedding(context.Background(), []string{"sentence 1", "sentence 2", "sentence 3"}, SearchMode)
+		s.NoError(err)
+		s.Equal(3, len(r.([][]float32)))
+	}
+
+	// created by the text embedding function
+	coll := &schemapb.CollectionSchema{
+		Name:      "test",
+		Fields:    s.schema.Fields,
+		Functions: []*schemapb.FunctionSchema{functionSchema},
+	}
+	_, err = NewTextEmbeddingFunction(coll, functionSchema)
+	s.NoError(err)
+}
+
+func (s *OllamaEmbeddingProviderSuite) TestParams() {
+	var req ollama.EmbeddingRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &req)
+		res := ollama.EmbeddingResponse{Embeddings: mockEmbedding[float32](req.Input, 4)}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(res)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	functionSchema := s.newFunctionSchema(
+		&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "nomic-embed-text"},
+		&commonpb.KeyValuePair{Key: truncateParamKey, Value: "false"},
+		&commonpb.KeyValuePair{Key: keepAliveParamKey, Value: "10m"},
+		&commonpb.KeyValuePair{Key: ingestionPromptParamKey, Value: "search_document: "},
+		&commonpb.KeyValuePair{Key: searchPromptParamKey, Value: "search_query: "},
+	)
+	// the endpoint is configured in milvus.yaml
+	provider, err := NewOllamaEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{embeddingURLParamKey: ts.URL}, credentials.NewCredentials(map[string]string{}))
+	s.NoError(err)
+	_, err = provider.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+	s.NoError(err)
+	s.Equal([]string{"search_document: a"}, req.Input)
+	s.False(*req.Truncate)
+	s.Equal("10m", req.KeepAlive)
+	_, err = provider.CallEmbedding(context.Background(), []string{"a"}, SearchMode)
+	s.NoError(err)
+	s.Equal([]string{"search_query: a"}, req.Input)
+}
+
+func (s *OllamaEmbeddingProviderSuite) TestEmbeddingNumberNotMatch() {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res := ollama.EmbeddingResponse{Embeddings: [][]float32{{0.1, 0.1, 0.1, 0.1}}}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(res)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	functionSchema := s.newFunctionSchema(
+		&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "nomic-embed-text"},
+		&commonpb.KeyValuePair{Key: EndpointParamKey, Value: ts.URL},
+	)
+	provider, err := NewOllamaEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, credentials.NewCredentials(map[string]string{}))
+	s.NoError(err)
+	_, err = provider.CallEmbedding(context.Background(), []string{"a", "b"}, InsertMode)
+	s.Error(err)
+}
+
+func (s *OllamaEmbeddingProviderSuite) TestNewProvider() {
+	creds := credentials.NewCredentials(map[string]string{})
+	// default endpoint
+	{
+		functionSchema := s.newFunctionSchema(&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "nomic-embed-text"})
+		_, err := NewOllamaEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, creds)
+		s.NoError(err)
+	}
+	// missing model name
+	{
+		functionSchema := s.newFunctionSchema()
+		_, err := NewOllamaEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, creds)
+		s.Error(err)
+	}
+	// invalid truncate
+	{
+		functionSchema := s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "nomic-embed-text"},
+			&commonpb.KeyValuePair{Key: truncateParamKey, Value: "invalid"},
+		)
+		_, err := NewOllamaEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, creds)
+		s.Error(err)
+	}
+	// invalid endpoint
+	{
+		functionSchema := s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "nomic-embed-text"},
+			&commonpb.KeyValuePair{Key: EndpointParamKey, Value: "localhost:11434"},
+		)
+		_, err := NewOllamaEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, creds)
+		s.Error(err)
+	}
+	// unknown credential
+	{
+		functionSchema := s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "nomic-embed-text"},
+			&commonpb.KeyValuePair{Key: credentialParamKey, Value: "unknown"},
+		)
+		_, err := NewOllamaEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, creds)
+		s.Error(err)
+	}
+}
@@ -0,0 +1,150 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function/models/openai"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// OpenAICompatibleEmbeddingProvider calls the self-hosted services which implement the OpenAI embeddings api,
+// such as vLLM, LocalAI and LM Studio.
+type OpenAICompatibleEmbeddingProvider struct {
+	fieldDim int64
+
+	client        *openai.OpenAICompatibleEmbeddingClient
+	modelName     string
+	embedDimParam int64
+	user          string
+
+	ingestionPrompt string
+	searchPrompt    string
+
+	maxBatch   int
+	timeoutSec int64
+}
+
+func NewOpenAICompatibleEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchema *schemapb.FunctionSchema, params map[string]string, credentials *credentials.Credentials) (*OpenAICompatibleEmbeddingProvider, error) {
+	fieldDim, err := typeutil.GetDim(fieldSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	var modelName, user, endpoint, ingestionPrompt, searchPrompt string
+	var dim int64
+	maxBatch := 32
+	for _, param := range functionSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case modelNameParamKey:
+			modelName = param.Value
+		case dimParamKey:
+			dim, err = parseAndCheckFieldDim(param.Value, fieldDim, fieldSchema.Name)
+			if err != nil {
+				return nil, err
+			}
+		case userParamKey:
+			user = param.Value
+		case EndpointParamKey:
+			endpoint = param.Value
+		case ingestionPromptParamKey:
+			ingestionPrompt = param.Value
+		case searchPromptParamKey:
+			searchPrompt = param.Value
+		case maxClientBatchSizeParamKey:
+			if maxBatch, err = strconv.Atoi(param.Value); err != nil || maxBatch <= 0 {
+				return nil, fmt.Errorf("[%s param's value: %s] is not a valid positive number", maxClientBatchSizeParamKey, param.Value)
+			}
+		default:
+		}
+	}
+	if modelName == "" {
+		return nil, fmt.Errorf("The OpenAI compatible embedding function param [%s] is required", modelNameParamKey)
+	}
+
+	// the api key is optional for the self-hosted services
+	apiKey, url, err := parseAKAndURL(credentials, functionSchema.Params, params, openAICompatibleAKEnvStr)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == "" {
+		endpoint = url
+	}
+	if endpoint == "" {
+		return nil, fmt.Errorf("The OpenAI compatible embedding service endpoint is not set, configure the function param [%s] or the url in milvus.yaml", EndpointParamKey)
+	}
+	c, err := openai.NewOpenAICompatibleEmbeddingClient(apiKey, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := OpenAICompatibleEmbeddingProvider{
+		client:          c,
+		fieldDim:        fieldDim,
+		modelName:       modelName,
+		user:            user,
+		embedDimParam:   dim,
+		ingestionPrompt: ingestionPrompt,
+		searchPrompt:    searchPrompt,
+		maxBatch:        maxBatch,
+		timeoutSec:      30,
+	}
+	return &provider, nil
+}
+
+func (provider *OpenAICompatibleEmbeddingProvider) MaxBatch() int {
+	return 5 * provider.maxBatch
+}
+
+func (provider *OpenAICompatibleEmbeddingProvider) FieldDim() int64 {
+	return provider.fieldDim
+}
+
+func (provider *OpenAICompatibleEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, mode TextEmbeddingMode) (any, error) {
+	numRows := len(texts)
+	data := make([][]float32, 0, numRows)
+	texts = addPrompt(texts, provider.ingestionPrompt, provider.searchPrompt, mode)
+	for i := 0; i < numRows; i += provider.maxBatch {
+		end := i + provider.maxBatch
+		if end > numRows {
+			end = numRows
+		}
+		resp, err := provider.client.Embedding(ctx, provider.modelName, texts[i:end], int(provider.embedDimParam), provider.user, provider.timeoutSec)
+		if err != nil {
+			return nil, err
+		}
+		if end-i != len(resp.Data) {
+			return nil, fmt.Errorf("Get embedding failed. The number of texts and embeddings does not match text:[%d], embedding:[%d]", end-i, len(resp.Data))
+		}
+		for _, item := range resp.Data {
+			if len(item.Embedding) != int(provider.fieldDim) {
+				return nil, fmt.Errorf("The required embedding dim is [%d], but the embedding obtained from the model is [%d]",
+					provider.fieldDim, len(item.Embedding))
+			}
+			data = append(data, item.Embedding)
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,224 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function/models/openai"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestOpenAICompatibleEmbeddingProvider(t *testing.T) {
+	suite.Run(t, new(OpenAICompatibleEmbeddingProviderSuite))
+}
+
+type OpenAICompatibleEmbeddingProviderSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *OpenAICompatibleEmbeddingProviderSuite) SetupTest() {
+	paramtable.Init()
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+		},
+	}
+}
+
+func (s *OpenAICompatibleEmbeddingProviderSuite) newFunctionSchema(params ...*commonpb.KeyValuePair) *schemapb.FunctionSchema {
+	return &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             schemapb.FunctionType_TextEmbedding,
+		InputFieldNames:  []string{"text"},
+		OutputFieldNames: []string{"vector"},
+		InputFieldIds:    []int64{101},
+		OutputFieldIds:   []int64{102},
+		Params:           params,
+	}
+}
+
+func (s *OpenAICompatibleEmbeddingProviderSuite) TestEmbedding() {
+	ts := CreateOpenAIEmbeddingServer()
+	defer ts.Close()
+
+	functionSchema := s.newFunctionSchema(
+		&commonpb.KeyValuePair{Key: Provider, Value: openAICompatibleProvider},
+		&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "BAAI/bge-m3"},
+		&commonpb.KeyValuePair{Key: dimParamKey, Value: "4"},
+		&commonpb.KeyValuePair{Key: EndpointParamKey, Value: ts.URL + "/v1"},
+		&commonpb.KeyValuePair{Key: maxClientBatchSizeParamKey, Value: "2"},
+	)
+	// no api key is configured
+	provider, err := NewOpenAICompatibleEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, credentials.NewCredentials(map[string]string{}))
+	s.NoError(err)
+	s.Equal(int64(4), provider.FieldDim())
+	s.Equal(10, provider.MaxBatch())
+
+	{
+		r, err := provider.CallEmbedding(context.Background(), []string{"sentence"}, InsertMode)
+		s.NoError(err)
+		ret := r.([][]float32)
+		s.Equal(1, len(ret))
+		s.Equal(4, len(ret[0]))
+	}
+	{
+		r, err := provider.CallEmbedding(context.Background(), []string{"sentence 1", "sentence 2", "sentence 3"}, SearchMode)
+		s.NoError(err)
+		s.Equal(3, len(r.([][]float32)))
+	}
+
+	// created by the text embedding function
+	coll := &schemapb.CollectionSchema{
+		Name:      "test",
+		Fields:    s.schema.Fields,
+		Functions: []*schemapb.FunctionSchema{functionSchema},
+	}
+	_, err = NewTextEmbeddingFunction(coll, functionSchema)
+	s.NoError(err)
+}
+
+func (s *OpenAICompatibleEmbeddingProviderSuite) TestPrompt() {
+	var inputs []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req openai.EmbeddingRequest
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &req)
+		inputs = req.Input
+		var res openai.EmbeddingResponse
+		for i := range req.Input {
+			res.Data = append(res.Data, openai.EmbeddingData{Embedding: []float32{0.1, 0.1, 0.1, 0.1}, Index: i})
+		}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(res)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	functionSchema := s.newFunctionSchema(
+		&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "e5"},
+		&commonpb.KeyValuePair{Key: ingestionPromptParamKey, Value: "passage: "},
+		&commonpb.KeyValuePair{Key: searchPromptParamKey, Value: "query: "},
+	)
+	// the endpoint is configured in milvus.yaml
+	provider, err := NewOpenAICompatibleEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{embeddingURLParamKey: ts.URL}, credentials.NewCredentials(map[string]string{}))
+	s.NoError(err)
+	_, err = provider.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+	s.NoError(err)
+	s.Equal([]string{"passage: a"}, inputs)
+	_, err = provider.CallEmbedding(context.Background(), []string{"a"}, SearchMode)
+	s.NoError(err)
+	s.Equal([]string{"query: a"}, inputs)
+}
+
+func (s *OpenAICompatibleEmbeddingProviderSuite) TestEmbeddingDimNotMatch() {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var res openai.EmbeddingResponse
+		res.Data = append(res.Data, openai.EmbeddingData{Embedding: []float32{0.1, 0.1, 0.1}, Index: 0})
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(res)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	functionSchema := s.newFunctionSchema(
+		&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "e5"},
+		&commonpb.KeyValuePair{Key: EndpointParamKey, Value: ts.URL},
+	)
+	provider, err := NewOpenAICompatibleEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, credentials.NewCredentials(map[string]string{}))
+	s.NoError(err)
+	_, err = provider.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+	s.Error(err)
+	_, err = provider.CallEmbedding(context.Background(), []string{"a", "b"}, InsertMode)
+	s.Error(err)
+}
+
+func (s *OpenAICompatibleEmbeddingProviderSuite) TestNewProvider() {
+	creds := credentials.NewCredentials(map[string]string{"mock.apikey": "mock"})
+	// missing model name
+	{
+		functionSchema := s.newFunctionSchema(&commonpb.KeyValuePair{Key: EndpointParamKey, Value: "http://localhost:8000/v1"})
+		_, err := NewOpenAICompatibleEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, creds)
+		s.Error(err)
+	}
+	// missing endpoint
+	{
+		functionSchema := s.newFunctionSchema(&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "e5"})
+		_, err := NewOpenAICompatibleEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, creds)
+		s.Error(err)
+	}
+	// invalid endpoint
+	{
+		functionSchema := s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "e5"},
+			&commonpb.KeyValuePair{Key: EndpointParamKey, Value: "localhost:8000"},
+		)
+		_, err := NewOpenAICompatibleEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, creds)
+		s.Error(err)
+	}
+	// invalid batch size
+	{
+		functionSchema := s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "e5"},
+			&commonpb.KeyValuePair{Key: EndpointParamKey, Value: "http://localhost:8000/v1"},
+			&commonpb.KeyValuePair{Key: maxClientBatchSizeParamKey, Value: "0"},
+		)
+		_, err := NewOpenAICompatibleEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, creds)
+		s.Error(err)
+	}
+	// dim not match
+	{
+		functionSchema := s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "e5"},
+			&commonpb.KeyValuePair{Key: EndpointParamKey, Value: "http://localhost:8000/v1"},
+			&commonpb.KeyValuePair{Key: dimParamKey, Value: "8"},
+		)
+		_, err := NewOpenAICompatibleEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, creds)
+		s.Error(err)
+	}
+	// with credential
+	{
+		functionSchema := s.newFunctionSchema(
+			&commonpb.KeyValuePair{Key: modelNameParamKey, Value: "e5"},
+			&commonpb.KeyValuePair{Key: EndpointParamKey, Value: "http://localhost:8000/v1"},
+			&commonpb.KeyValuePair{Key: credentialParamKey, Value: "mock"},
+		)
+		_, err := NewOpenAICompatibleEmbeddingProvider(s.schema.Fields[2], functionSchema, map[string]string{}, creds)
+		s.NoError(err)
+	}
+}
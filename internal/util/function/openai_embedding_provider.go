@@ -19,6 +19,7 @@
 package function
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -147,7 +148,7 @@ func (provider *OpenAIEmbeddingProvider) FieldDim() int64 {
 	return provider.fieldDim
 }
 
-func (provider *OpenAIEmbeddingProvider) CallEmbedding(texts []string, _ TextEmbeddingMode) (any, error) {
+func (provider *OpenAIEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, _ TextEmbeddingMode) (any, error) {
 	numRows := len(texts)
 	data := make([][]float32, 0, numRows)
 	for i := 0; i < numRows; i += provider.maxBatch {
@@ -155,7 +156,7 @@ func (provider *OpenAIEmbeddingProvider) CallEmbedding(texts []string, _ TextEmb
 		if end > numRows {
 			end = numRows
 		}
-		resp, err := provider.client.Embedding(provider.modelName, texts[i:end], int(provider.embedDimParam), provider.user, provider.timeoutSec)
+		resp, err := provider.client.Embedding(ctx, provider.modelName, texts[i:end], int(provider.embedDimParam), provider.user, provider.timeoutSec)
 		if err != nil {
 			return nil, err
 		}
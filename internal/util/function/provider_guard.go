@@ -0,0 +1,253 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/util/function/models/utils"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// maxRPSParamKey is the provider config in milvus.yaml which limits the embedding calls per second,
+// e.g. function.textEmbedding.providers.openai.max_rps, zero or absent means unlimited.
+const maxRPSParamKey string = "max_rps"
+
+var errRateLimitExceeded = errors.New("rate limit exceeded")
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+var providerGuards = typeutil.NewConcurrentMap[string, *providerGuard]()
+
+// providerGuard is shared by all the functions calling the same provider endpoint with the same credential,
+// it limits the call rate with a token bucket and stops calling the provider when it keeps failing.
+type providerGuard struct {
+	provider   string
+	credential string
+
+	limiter *rate.Limiter
+	maxRPS  float64
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func getProviderGuard(provider string, credential string, endpoint string, maxRPS float64) *providerGuard {
+	key := strings.Join([]string{provider, credential, endpoint}, "/")
+	guard, _ := providerGuards.GetOrInsert(key, newProviderGuard(provider, credential, maxRPS))
+	guard.setMaxRPS(maxRPS)
+	return guard
+}
+
+func newProviderGuard(provider string, credential string, maxRPS float64) *providerGuard {
+	guard := &providerGuard{
+		provider:   provider,
+		credential: credential,
+		limiter:    rate.NewLimiter(rate.Inf, 1),
+	}
+	guard.setMaxRPS(maxRPS)
+	return guard
+}
+
+func (guard *providerGuard) setMaxRPS(maxRPS float64) {
+	guard.mu.Lock()
+	defer guard.mu.Unlock()
+	if guard.maxRPS == maxRPS {
+		return
+	}
+	guard.maxRPS = maxRPS
+	if maxRPS <= 0 {
+		guard.limiter.SetLimit(rate.Inf)
+	} else {
+		// allow a burst of one second of requests
+		guard.limiter.SetBurst(int(math.Max(1, math.Ceil(maxRPS))))
+		guard.limiter.SetLimit(rate.Limit(maxRPS))
+	}
+}
+
+// Wait reserves a token of the bucket and blocks until the reservation is due, it fails at once if the
+// reservation is due after the max wait. It's called before every http request sent to the provider,
+// including the retries.
+func (guard *providerGuard) Wait(ctx context.Context) error {
+	maxWait := paramtable.Get().FunctionCfg.RateLimitMaxWait.GetAsDuration(time.Second)
+	reservation := guard.limiter.Reserve()
+	delay := reservation.Delay()
+	if delay == 0 {
+		return nil
+	}
+	if delay > maxWait {
+		reservation.Cancel()
+		return errors.Mark(fmt.Errorf("Call provider [%s] failed, the rate limit [%v/s] is exceeded", guard.provider, guard.limiter.Limit()), errRateLimitExceeded)
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		reservation.Cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// allow checks the circuit breaker, an open breaker lets a single probe call through after the open timeout.
+func (guard *providerGuard) allow() error {
+	guard.mu.Lock()
+	defer guard.mu.Unlock()
+	switch guard.state {
+	case circuitOpen:
+		openTimeout := paramtable.Get().FunctionCfg.CircuitBreakerOpenTimeout.GetAsDuration(time.Second)
+		if time.Since(guard.openedAt) < openTimeout {
+			return fmt.Errorf("Call provider [%s] failed, the circuit breaker is open after [%d] consecutive failures", guard.provider, guard.failures)
+		}
+		guard.setState(circuitHalfOpen)
+		guard.probing = true
+	case circuitHalfOpen:
+		if guard.probing {
+			return fmt.Errorf("Call provider [%s] failed, the circuit breaker is half open and waiting for the probe call", guard.provider)
+		}
+		guard.probing = true
+	default:
+	}
+	return nil
+}
+
+// done records the result of a call, only the retryable errors such as throttling and
+// server side errors count as failures, the provider is alive if it rejects an invalid request.
+func (guard *providerGuard) done(err error) {
+	guard.mu.Lock()
+	defer guard.mu.Unlock()
+	guard.probing = false
+	if !utils.IsRetryableError(err) {
+		guard.failures = 0
+		guard.setState(circuitClosed)
+		return
+	}
+	guard.failures++
+	threshold := paramtable.Get().FunctionCfg.CircuitBreakerFailureThreshold.GetAsInt()
+	if guard.state == circuitHalfOpen || guard.failures >= threshold {
+		guard.openedAt = time.Now()
+		guard.setState(circuitOpen)
+	}
+}
+
+// release gives up the call allowed by the breaker without a result.
+func (guard *providerGuard) release() {
+	guard.mu.Lock()
+	defer guard.mu.Unlock()
+	guard.probing = false
+}
+
+func (guard *providerGuard) setState(state circuitState) {
+	guard.state = state
+	metrics.ProxyFunctionCircuitBreakerState.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), guard.provider, guard.credential).Set(float64(state))
+}
+
+// guardedEmbeddingProvider splits the texts into batches of the provider's max batch,
+// and sends every batch through the provider guard. The circuit breaker is checked per batch,
+// while every http request sent for the batch, including the retries, takes a token of the rate limiter.
+type guardedEmbeddingProvider struct {
+	textEmbeddingProvider
+
+	guard *providerGuard
+}
+
+func newGuardedEmbeddingProvider(provider textEmbeddingProvider, providerName string, functionParams []*commonpb.KeyValuePair, conf map[string]string) (*guardedEmbeddingProvider, error) {
+	credential := conf[credentialParamKey]
+	endpoint := conf[embeddingURLParamKey]
+	for _, param := range functionParams {
+		switch strings.ToLower(param.Key) {
+		case credentialParamKey:
+			credential = param.Value
+		case EndpointParamKey:
+			endpoint = param.Value
+		default:
+		}
+	}
+	var maxRPS float64
+	if v, ok := conf[maxRPSParamKey]; ok && v != "" {
+		var err error
+		if maxRPS, err = strconv.ParseFloat(v, 64); err != nil {
+			return nil, fmt.Errorf("[%s config's value: %s] of provider [%s] is not a valid number", maxRPSParamKey, v, providerName)
+		}
+	}
+	return &guardedEmbeddingProvider{
+		textEmbeddingProvider: provider,
+		guard:                 getProviderGuard(providerName, credential, endpoint, maxRPS),
+	}, nil
+}
+
+func (provider *guardedEmbeddingProvider) call(ctx context.Context, texts []string, mode TextEmbeddingMode) (any, error) {
+	if err := provider.guard.allow(); err != nil {
+		return nil, err
+	}
+	embds, err := provider.textEmbeddingProvider.CallEmbedding(utils.WithRequestLimiter(ctx, provider.guard), texts, mode)
+	if errors.Is(err, errRateLimitExceeded) {
+		// the rate limit is not a failure of the provider
+		provider.guard.release()
+		return nil, err
+	}
+	provider.guard.done(err)
+	return embds, err
+}
+
+func (provider *guardedEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, mode TextEmbeddingMode) (any, error) {
+	maxBatch := provider.MaxBatch()
+	if len(texts) <= maxBatch {
+		return provider.call(ctx, texts, mode)
+	}
+	rows := make([]any, 0, len(texts))
+	for i := 0; i < len(texts); i += maxBatch {
+		end := i + maxBatch
+		if end > len(texts) {
+			end = len(texts)
+		}
+		embds, err := provider.call(ctx, texts[i:end], mode)
+		if err != nil {
+			return nil, err
+		}
+		batchRows, err := splitEmbeddings(embds)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, batchRows...)
+	}
+	return mergeEmbeddings(rows)
+}
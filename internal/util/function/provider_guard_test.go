@@ -0,0 +1,200 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/util/function/models/utils"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestProviderGuard(t *testing.T) {
+	suite.Run(t, new(ProviderGuardSuite))
+}
+
+type ProviderGuardSuite struct {
+	suite.Suite
+}
+
+func (s *ProviderGuardSuite) SetupTest() {
+	paramtable.Init()
+}
+
+type failingEmbeddingProvider struct {
+	countingEmbeddingProvider
+	err error
+}
+
+func (p *failingEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, mode TextEmbeddingMode) (any, error) {
+	if p.err != nil {
+		p.calls = append(p.calls, texts)
+		return nil, p.err
+	}
+	return p.countingEmbeddingProvider.CallEmbedding(ctx, texts, mode)
+}
+
+func (s *ProviderGuardSuite) TestBatching() {
+	inner := &countingEmbeddingProvider{}
+	provider, err := newGuardedEmbeddingProvider(inner, "mock", nil, map[string]string{})
+	s.NoError(err)
+
+	texts := make([]string, 250)
+	for i := range texts {
+		texts[i] = "text"
+	}
+	r, err := provider.CallEmbedding(context.Background(), texts, InsertMode)
+	s.NoError(err)
+	s.Equal(250, len(r.([][]float32)))
+	// the max batch of the provider is 100
+	s.Equal(3, len(inner.calls))
+	s.Equal(50, len(inner.calls[2]))
+}
+
+func (s *ProviderGuardSuite) TestCircuitBreaker() {
+	paramtable.Get().Save(paramtable.Get().FunctionCfg.CircuitBreakerFailureThreshold.Key, "2")
+	defer paramtable.Get().Reset(paramtable.Get().FunctionCfg.CircuitBreakerFailureThreshold.Key)
+	paramtable.Get().Save(paramtable.Get().FunctionCfg.CircuitBreakerOpenTimeout.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().FunctionCfg.CircuitBreakerOpenTimeout.Key)
+
+	inner := &failingEmbeddingProvider{err: &utils.StatusError{StatusCode: http.StatusServiceUnavailable}}
+	provider, err := newGuardedEmbeddingProvider(inner, "mock", []*commonpb.KeyValuePair{
+		{Key: credentialParamKey, Value: "breaker"},
+	}, map[string]string{})
+	s.NoError(err)
+
+	for i := 0; i < 2; i++ {
+		_, err = provider.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+		s.Error(err)
+	}
+	s.Equal(circuitOpen, provider.guard.state)
+	s.Equal(2, len(inner.calls))
+
+	// fail fast while the breaker is open
+	_, err = provider.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+	s.Error(err)
+	s.Equal(2, len(inner.calls))
+
+	// a failed probe opens the breaker again
+	provider.guard.openedAt = time.Now().Add(-time.Second)
+	_, err = provider.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+	s.Error(err)
+	s.Equal(3, len(inner.calls))
+	s.Equal(circuitOpen, provider.guard.state)
+
+	// a successful probe closes the breaker
+	inner.err = nil
+	provider.guard.openedAt = time.Now().Add(-time.Second)
+	_, err = provider.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+	s.NoError(err)
+	s.Equal(circuitClosed, provider.guard.state)
+
+	// the client errors don't count as failures
+	inner.err = &utils.StatusError{StatusCode: http.StatusBadRequest}
+	for i := 0; i < 3; i++ {
+		_, err = provider.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+		s.Error(err)
+	}
+	s.Equal(circuitClosed, provider.guard.state)
+
+	// the errors of checking the response locally don't count as failures
+	inner.err = errors.New("The number of inputs and embeddings does not match")
+	for i := 0; i < 3; i++ {
+		_, err = provider.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+		s.Error(err)
+	}
+	s.Equal(circuitClosed, provider.guard.state)
+
+	// the network errors count as failures
+	inner.err = &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	for i := 0; i < 2; i++ {
+		_, err = provider.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+		s.Error(err)
+	}
+	s.Equal(circuitOpen, provider.guard.state)
+}
+
+func (s *ProviderGuardSuite) TestRateLimit() {
+	paramtable.Get().Save(paramtable.Get().FunctionCfg.RateLimitMaxWait.Key, "0")
+	defer paramtable.Get().Reset(paramtable.Get().FunctionCfg.RateLimitMaxWait.Key)
+
+	inner := &countingEmbeddingProvider{}
+	provider, err := newGuardedEmbeddingProvider(inner, "mock", []*commonpb.KeyValuePair{
+		{Key: credentialParamKey, Value: "rate_limit"},
+	}, map[string]string{maxRPSParamKey: "1"})
+	s.NoError(err)
+
+	_, err = provider.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+	s.NoError(err)
+	_, err = provider.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+	s.True(errors.Is(err, errRateLimitExceeded))
+	s.Equal(1, len(inner.calls))
+	// the rate limit doesn't open the breaker
+	s.Equal(circuitClosed, provider.guard.state)
+
+	// the functions with the same credential share the limiter
+	other, err := newGuardedEmbeddingProvider(inner, "mock", []*commonpb.KeyValuePair{
+		{Key: credentialParamKey, Value: "rate_limit"},
+	}, map[string]string{maxRPSParamKey: "1"})
+	s.NoError(err)
+	s.Same(provider.guard, other.guard)
+
+	// every http request of the call takes a token, including the retries
+	inner = &countingEmbeddingProvider{requestsPerCall: 2}
+	limited, err := newGuardedEmbeddingProvider(inner, "mock", []*commonpb.KeyValuePair{
+		{Key: credentialParamKey, Value: "rate_limit_per_request"},
+	}, map[string]string{maxRPSParamKey: "1"})
+	s.NoError(err)
+	_, err = limited.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+	s.True(errors.Is(err, errRateLimitExceeded))
+	s.Equal(0, len(inner.calls))
+	s.Equal(circuitClosed, limited.guard.state)
+
+	// the request waits for the reservation of the token within the max wait
+	paramtable.Get().Save(paramtable.Get().FunctionCfg.RateLimitMaxWait.Key, "2")
+	waiting := newProviderGuard("mock", "wait", 10)
+	for i := 0; i < 10; i++ {
+		s.NoError(waiting.Wait(context.Background()))
+	}
+	start := time.Now()
+	s.NoError(waiting.Wait(context.Background()))
+	s.GreaterOrEqual(time.Since(start), 50*time.Millisecond)
+	// the canceled wait gives back the reservation
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.ErrorIs(waiting.Wait(ctx), context.Canceled)
+
+	// unlimited
+	provider.guard.setMaxRPS(0)
+	for i := 0; i < 10; i++ {
+		_, err = provider.CallEmbedding(context.Background(), []string{"a"}, InsertMode)
+		s.NoError(err)
+	}
+
+	_, err = newGuardedEmbeddingProvider(inner, "mock", nil, map[string]string{maxRPSParamKey: "invalid"})
+	s.Error(err)
+}
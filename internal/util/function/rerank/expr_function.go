@@ -0,0 +1,299 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	antlrparser "github.com/milvus-io/milvus/internal/parser/planparserv2/generated"
+)
+
+const (
+	expressionKey string = "expression"
+
+	// scoreVariable refers to the original search score in the expression
+	scoreVariable string = "score"
+)
+
+// scoreExpr evaluates the expression with the variables, vars[0] is the search score,
+// and vars[i] is the value of the (i-1)th input field.
+type scoreExpr func(vars []float64) float64
+
+var scoreExprFuncs = map[string]struct {
+	numArgs int
+	call    func(args []float64) float64
+}{
+	"log":   {1, func(args []float64) float64 { return math.Log(args[0]) }},
+	"log2":  {1, func(args []float64) float64 { return math.Log2(args[0]) }},
+	"log10": {1, func(args []float64) float64 { return math.Log10(args[0]) }},
+	"log1p": {1, func(args []float64) float64 { return math.Log1p(args[0]) }},
+	"exp":   {1, func(args []float64) float64 { return math.Exp(args[0]) }},
+	"sqrt":  {1, func(args []float64) float64 { return math.Sqrt(args[0]) }},
+	"abs":   {1, func(args []float64) float64 { return math.Abs(args[0]) }},
+	"floor": {1, func(args []float64) float64 { return math.Floor(args[0]) }},
+	"ceil":  {1, func(args []float64) float64 { return math.Ceil(args[0]) }},
+	"pow":   {2, func(args []float64) float64 { return math.Pow(args[0], args[1]) }},
+	"min":   {2, func(args []float64) float64 { return math.Min(args[0], args[1]) }},
+	"max":   {2, func(args []float64) float64 { return math.Max(args[0], args[1]) }},
+}
+
+// ExprFunction rescores the results with an arithmetic expression over the search score and
+// the numeric input fields, e.g. `score * log(1 + likes) + 0.1 * freshness`.
+type ExprFunction[T PKType] struct {
+	RerankBase
+
+	expression string
+	eval       scoreExpr
+}
+
+func newExprFunction(collSchema *schemapb.CollectionSchema, funcSchema *schemapb.FunctionSchema) (Reranker, error) {
+	base, err := newRerankBase(collSchema, funcSchema, exprName, true)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := map[string]int{scoreVariable: 0}
+	for i, name := range base.GetInputFieldNames() {
+		if name == scoreVariable {
+			return nil, fmt.Errorf("Expr function input field name can not be %s, it refers to the search score", scoreVariable)
+		}
+		switch base.GetInputFieldTypes()[i] {
+		case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+			schemapb.DataType_Float, schemapb.DataType_Double:
+		default:
+			return nil, fmt.Errorf("Expr rerank: unsupported input field type:%s, only support numberic field", base.GetInputFieldTypes()[i].String())
+		}
+		variables[name] = i + 1
+	}
+
+	expression := ""
+	for _, param := range funcSchema.Params {
+		if strings.ToLower(param.Key) == expressionKey {
+			expression = param.Value
+		}
+	}
+	if strings.TrimSpace(expression) == "" {
+		return nil, fmt.Errorf("Expr function lost param: %s", expressionKey)
+	}
+	eval, err := compileScoreExpr(expression, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if base.pkType == schemapb.DataType_Int64 {
+		return &ExprFunction[int64]{RerankBase: *base, expression: expression, eval: eval}, nil
+	} else {
+		return &ExprFunction[string]{RerankBase: *base, expression: expression, eval: eval}, nil
+	}
+}
+
+// compileScoreExpr parses the expression with the grammar of the filter expressions,
+// only the numbers, variables, arithmetic operators and math functions are allowed.
+func compileScoreExpr(expression string, variables map[string]int) (eval scoreExpr, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("Invalid expression: %s", expression)
+		}
+	}()
+	ast, err := planparserv2.ParseExprAST(expression)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid expression: %s, error: %s", expression, err)
+	}
+	if eval, err = compileScoreExprNode(ast, variables); err != nil {
+		return nil, fmt.Errorf("Invalid expression: %s, error: %s", expression, err)
+	}
+	return eval, nil
+}
+
+func compileScoreExprNode(node antlrparser.IExprContext, variables map[string]int) (scoreExpr, error) {
+	switch ctx := node.(type) {
+	case *antlrparser.IntegerContext:
+		i, err := strconv.ParseInt(ctx.IntegerConstant().GetText(), 0, 64)
+		if err != nil {
+			return nil, err
+		}
+		return func([]float64) float64 { return float64(i) }, nil
+	case *antlrparser.FloatingContext:
+		f, err := strconv.ParseFloat(ctx.FloatingConstant().GetText(), 64)
+		if err != nil {
+			return nil, err
+		}
+		return func([]float64) float64 { return f }, nil
+	case *antlrparser.IdentifierContext:
+		idx, ok := variables[ctx.GetText()]
+		if !ok {
+			return nil, fmt.Errorf("unknown variable %s, it should be %s or one of the input fields", ctx.GetText(), scoreVariable)
+		}
+		return func(vars []float64) float64 { return vars[idx] }, nil
+	case *antlrparser.ParensContext:
+		return compileScoreExprNode(ctx.Expr(), variables)
+	case *antlrparser.UnaryContext:
+		operand, err := compileScoreExprNode(ctx.Expr(), variables)
+		if err != nil {
+			return nil, err
+		}
+		switch ctx.GetOp().GetTokenType() {
+		case antlrparser.PlanParserADD:
+			return operand, nil
+		case antlrparser.PlanParserSUB:
+			return func(vars []float64) float64 { return -operand(vars) }, nil
+		default:
+			return nil, fmt.Errorf("unsupported operator %s", ctx.GetOp().GetText())
+		}
+	case *antlrparser.PowerContext:
+		left, right, err := compileScoreExprOperands(ctx.Expr(0), ctx.Expr(1), variables)
+		if err != nil {
+			return nil, err
+		}
+		return func(vars []float64) float64 { return math.Pow(left(vars), right(vars)) }, nil
+	case *antlrparser.MulDivModContext:
+		left, right, err := compileScoreExprOperands(ctx.Expr(0), ctx.Expr(1), variables)
+		if err != nil {
+			return nil, err
+		}
+		switch ctx.GetOp().GetTokenType() {
+		case antlrparser.PlanParserMUL:
+			return func(vars []float64) float64 { return left(vars) * right(vars) }, nil
+		case antlrparser.PlanParserDIV:
+			return func(vars []float64) float64 { return left(vars) / right(vars) }, nil
+		default:
+			return func(vars []float64) float64 { return math.Mod(left(vars), right(vars)) }, nil
+		}
+	case *antlrparser.AddSubContext:
+		left, right, err := compileScoreExprOperands(ctx.Expr(0), ctx.Expr(1), variables)
+		if err != nil {
+			return nil, err
+		}
+		if ctx.GetOp().GetTokenType() == antlrparser.PlanParserADD {
+			return func(vars []float64) float64 { return left(vars) + right(vars) }, nil
+		}
+		return func(vars []float64) float64 { return left(vars) - right(vars) }, nil
+	case *antlrparser.CallContext:
+		name := strings.ToLower(ctx.Identifier().GetText())
+		fn, ok := scoreExprFuncs[name]
+		if !ok {
+			return nil, fmt.Errorf("unsupported function %s", name)
+		}
+		if len(ctx.AllExpr()) != fn.numArgs {
+			return nil, fmt.Errorf("function %s requires %d arguments, but got %d", name, fn.numArgs, len(ctx.AllExpr()))
+		}
+		args := make([]scoreExpr, 0, fn.numArgs)
+		for _, arg := range ctx.AllExpr() {
+			argExpr, err := compileScoreExprNode(arg, variables)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, argExpr)
+		}
+		return func(vars []float64) float64 {
+			values := make([]float64, len(args))
+			for i, arg := range args {
+				values[i] = arg(vars)
+			}
+			return fn.call(values)
+		}, nil
+	default:
+		return nil, fmt.Errorf("%s is not an arithmetic expression", node.GetText())
+	}
+}
+
+func compileScoreExprOperands(leftNode, rightNode antlrparser.IExprContext, variables map[string]int) (scoreExpr, scoreExpr, error) {
+	left, err := compileScoreExprNode(leftNode, variables)
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err := compileScoreExprNode(rightNode, variables)
+	if err != nil {
+		return nil, nil, err
+	}
+	return left, right, nil
+}
+
+func getNumber(data any, idx int) (float64, error) {
+	switch nums := data.(type) {
+	case []int32:
+		return float64(nums[idx]), nil
+	case []int64:
+		return float64(nums[idx]), nil
+	case []float32:
+		return float64(nums[idx]), nil
+	case []float64:
+		return nums[idx], nil
+	default:
+		return 0, fmt.Errorf("Expr rerank: unsupported input field data type %T", data)
+	}
+}
+
+func (e *ExprFunction[T]) processOneSearchData(ctx context.Context, searchParams *SearchParams, cols []*columns, idGroup map[any]any) (*IDScores[T], error) {
+	srcScores := maxMerge[T](cols)
+	exprScores := map[T]float32{}
+	vars := make([]float64, len(e.GetInputFieldNames())+1)
+	for _, col := range cols {
+		if col.size == 0 {
+			continue
+		}
+		ids := col.ids.([]T)
+		for idx, id := range ids {
+			if _, ok := exprScores[id]; ok {
+				continue
+			}
+			vars[0] = float64(srcScores[id])
+			for i, data := range col.data {
+				num, err := getNumber(data, idx)
+				if err != nil {
+					return nil, err
+				}
+				vars[i+1] = num
+			}
+			score := e.eval(vars)
+			if math.IsNaN(score) || math.IsInf(score, 0) {
+				return nil, fmt.Errorf("Expr rerank: the expression [%s] gets invalid score %v for the id %v", e.expression, score, id)
+			}
+			exprScores[id] = float32(score)
+		}
+	}
+	if searchParams.isGrouping() {
+		return newGroupingIDScores(exprScores, searchParams, idGroup)
+	}
+	return newIDScores(exprScores, searchParams), nil
+}
+
+func (e *ExprFunction[T]) Process(ctx context.Context, searchParams *SearchParams, inputs *rerankInputs) (*rerankOutputs, error) {
+	outputs := newRerankOutputs(searchParams)
+	for _, cols := range inputs.data {
+		for i, col := range cols {
+			metricType := searchParams.searchMetrics[i]
+			for j, score := range col.scores {
+				col.scores[j] = toGreaterScore(score, metricType)
+			}
+		}
+		idScore, err := e.processOneSearchData(ctx, searchParams, cols, inputs.idGroupValue)
+		if err != nil {
+			return nil, err
+		}
+		appendResult(outputs, idScore.ids, idScore.scores)
+	}
+	return outputs, nil
+}
@@ -0,0 +1,171 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function"
+)
+
+func TestExprFunction(t *testing.T) {
+	suite.Run(t, new(ExprFunctionSuite))
+}
+
+type ExprFunctionSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *ExprFunctionSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+			{FieldID: 103, Name: "ts", DataType: schemapb.DataType_Int64},
+			{FieldID: 104, Name: "likes", DataType: schemapb.DataType_Float},
+			{FieldID: 105, Name: "score", DataType: schemapb.DataType_Double},
+		},
+	}
+}
+
+func exprFunctionSchema(inputs []string, expression string) *schemapb.FunctionSchema {
+	return &schemapb.FunctionSchema{
+		Name:            "test",
+		Type:            schemapb.FunctionType_Rerank,
+		InputFieldNames: inputs,
+		Params: []*commonpb.KeyValuePair{
+			{Key: reranker, Value: exprName},
+			{Key: expressionKey, Value: expression},
+		},
+	}
+}
+
+func (s *ExprFunctionSuite) TestNewExprFunction() {
+	{
+		f, err := createFunction(s.schema, exprFunctionSchema([]string{"ts", "likes"}, "score * log(1 + likes) + 0.1 * ts"))
+		s.NoError(err)
+		s.Equal(exprName, f.GetRankName())
+		s.True(f.IsSupportGroup())
+		eval := f.(*ExprFunction[int64]).eval
+		s.InDelta(1.0, eval([]float64{1, 10, 0}), 1e-9)
+	}
+	{
+		// the expression only uses the score
+		_, err := newExprFunction(s.schema, exprFunctionSchema([]string{}, "-score ** 2 + max(score, 1) % 3"))
+		s.NoError(err)
+	}
+	{
+		_, err := newExprFunction(s.schema, exprFunctionSchema([]string{"ts"}, ""))
+		s.ErrorContains(err, "Expr function lost param: expression")
+		_, err = newExprFunction(s.schema, exprFunctionSchema([]string{"text"}, "score"))
+		s.ErrorContains(err, "only support numberic field")
+		_, err = newExprFunction(s.schema, exprFunctionSchema([]string{"score"}, "score"))
+		s.ErrorContains(err, "it refers to the search score")
+	}
+	{
+		invalids := map[string]string{
+			"score + likes":       "unknown variable likes",
+			"sin(score)":          "unsupported function sin",
+			"pow(score)":          "requires 2 arguments",
+			"score > 1":           "is not an arithmetic expression",
+			"ts in [1, 2]":        "is not an arithmetic expression",
+			"score +":             "Invalid expression",
+			"text_match(ts, 'a')": "Invalid expression",
+		}
+		for expression, msg := range invalids {
+			_, err := newExprFunction(s.schema, exprFunctionSchema([]string{"ts"}, expression))
+			s.ErrorContains(err, msg, expression)
+		}
+	}
+}
+
+func (s *ExprFunctionSuite) TestRerankProcess() {
+	// scores: [0 1 2 ... 9], ts: [0 1 2 ... 9]
+	// new scores: [0 1 0 -3 -8 ...]
+	{
+		nq := int64(1)
+		f, err := newExprFunction(s.schema, exprFunctionSchema([]string{"ts"}, "score * 2 - ts * ts"))
+		s.NoError(err)
+		data := function.GenSearchResultData(nq, 10, schemapb.DataType_Int64, "ts", 103)
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(nq, 3, 0, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{3}, ret.searchResultData.Topks)
+		s.Equal([]int64{1, 0, 2}, ret.searchResultData.Ids.GetIntId().Data)
+		s.Equal([]float32{1, 0, 0}, ret.searchResultData.Scores)
+	}
+	// nq = 3, offset
+	{
+		nq := int64(3)
+		f, err := newExprFunction(s.schema, exprFunctionSchema([]string{"ts"}, "score - ts / 2"))
+		s.NoError(err)
+		data := function.GenSearchResultData(nq, 10, schemapb.DataType_Int64, "ts", 103)
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(nq, 2, 1, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{2, 2, 2}, ret.searchResultData.Topks)
+		s.Equal([]int64{8, 7, 18, 17, 28, 27}, ret.searchResultData.Ids.GetIntId().Data)
+	}
+	// the invalid scores
+	{
+		nq := int64(1)
+		f, err := newExprFunction(s.schema, exprFunctionSchema([]string{"ts"}, "score / ts"))
+		s.NoError(err)
+		data := function.GenSearchResultData(nq, 10, schemapb.DataType_Int64, "ts", 103)
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		_, err = f.Process(context.Background(), NewSearchParams(nq, 3, 0, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.ErrorContains(err, "gets invalid score")
+	}
+	// empty
+	{
+		nq := int64(1)
+		f, err := newExprFunction(s.schema, exprFunctionSchema([]string{"ts"}, "score"))
+		s.NoError(err)
+		inputs, _ := newRerankInputs([]*schemapb.SearchResultData{}, f.GetInputFieldIDs(), false)
+		ret, err := f.Process(context.Background(), NewSearchParams(nq, 3, 0, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{}, ret.searchResultData.Topks)
+	}
+}
+
+func (s *ExprFunctionSuite) TestGetNumber() {
+	for _, data := range []any{[]int32{1, 2}, []int64{1, 2}, []float32{1, 2}, []float64{1, 2}} {
+		num, err := getNumber(data, 1)
+		s.NoError(err)
+		s.Equal(float64(2), num)
+	}
+	_, err := getNumber([]string{"a"}, 0)
+	s.Error(err)
+}
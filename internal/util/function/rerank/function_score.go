@@ -40,6 +40,8 @@ const (
 	modelFunctionName string = "model"
 	rrfName           string = "rrf"
 	weightedName      string = "weighted"
+	mmrName           string = "mmr"
+	exprName          string = "expr"
 )
 
 const (
@@ -115,6 +117,11 @@ func getRerankName(funcSchema *schemapb.FunctionSchema) string {
 	return ""
 }
 
+// candidateSizer is implemented by the rerankers which pick from more search results than limit+offset
+type candidateSizer interface {
+	GetCandidateSize() int64
+}
+
 // Currently only supports single rerank
 type FunctionScore struct {
 	reranker Reranker
@@ -140,8 +147,12 @@ func createFunction(collSchema *schemapb.CollectionSchema, funcSchema *schemapb.
 		rerankFunc, newRerankErr = newRRFFunction(collSchema, funcSchema)
 	case weightedName:
 		rerankFunc, newRerankErr = newWeightedFunction(collSchema, funcSchema)
+	case mmrName:
+		rerankFunc, newRerankErr = newMMRFunction(collSchema, funcSchema)
+	case exprName:
+		rerankFunc, newRerankErr = newExprFunction(collSchema, funcSchema)
 	default:
-		return nil, fmt.Errorf("Unsupported rerank function: [%s] , list of supported [%s,%s,%s,%s]", rerankerName, decayFunctionName, modelFunctionName, rrfName, weightedName)
+		return nil, fmt.Errorf("Unsupported rerank function: [%s] , list of supported [%s,%s,%s,%s,%s,%s]", rerankerName, decayFunctionName, modelFunctionName, rrfName, weightedName, mmrName, exprName)
 	}
 
 	if newRerankErr != nil {
@@ -280,3 +291,15 @@ func (fScore *FunctionScore) IsSupportGroup() bool {
 	}
 	return fScore.reranker.IsSupportGroup()
 }
+
+// GetCandidateSize returns the number of search results the reranker needs for each query,
+// 0 means the reranker only needs limit+offset results.
+func (fScore *FunctionScore) GetCandidateSize() int64 {
+	if fScore == nil {
+		return 0
+	}
+	if r, ok := fScore.reranker.(candidateSizer); ok {
+		return r.GetCandidateSize()
+	}
+	return 0
+}
@@ -0,0 +1,252 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	lambdaKey        string = "lambda"
+	candidateSizeKey string = "candidate_size"
+
+	defaultLambda        float64 = 0.5
+	defaultCandidateSize int64   = 100
+)
+
+// MMRFunction reranks the results with Maximal Marginal Relevance, it greedily picks the candidate
+// which balances the relevance to the query and the similarity to the already picked results:
+//
+//	mmr = lambda * relevance - (1 - lambda) * max(cosine(candidate, picked))
+//
+// the relevance is the search score min-max normalized within each query. The search fetches
+// candidate_size results for every query, so there are more candidates than limit+offset to pick from.
+type MMRFunction[T PKType] struct {
+	RerankBase
+
+	lambda        float64
+	candidateSize int64
+}
+
+func newMMRFunction(collSchema *schemapb.CollectionSchema, funcSchema *schemapb.FunctionSchema) (Reranker, error) {
+	base, err := newRerankBase(collSchema, funcSchema, mmrName, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(base.GetInputFieldNames()) != 1 {
+		return nil, fmt.Errorf("MMR function only supports single input, but gets [%s] input", base.GetInputFieldNames())
+	}
+	if base.GetInputFieldTypes()[0] != schemapb.DataType_FloatVector {
+		return nil, fmt.Errorf("MMR function only supports FloatVector input field, but gets [%s]", base.GetInputFieldTypes()[0].String())
+	}
+
+	lambda := defaultLambda
+	candidateSize := defaultCandidateSize
+	for _, param := range funcSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case lambdaKey:
+			if lambda, err = strconv.ParseFloat(param.Value, 64); err != nil {
+				return nil, fmt.Errorf("Param %s:%s is not a number", lambdaKey, param.Value)
+			}
+			if lambda < 0 || lambda > 1 || math.IsNaN(lambda) {
+				return nil, fmt.Errorf("MMR function param: %s must be in range [0, 1], but got %s", lambdaKey, param.Value)
+			}
+		case candidateSizeKey:
+			if candidateSize, err = strconv.ParseInt(param.Value, 10, 64); err != nil {
+				return nil, fmt.Errorf("Param %s:%s is not an integer", candidateSizeKey, param.Value)
+			}
+			topKLimit := paramtable.Get().QuotaConfig.TopKLimit.GetAsInt64()
+			if candidateSize <= 0 || candidateSize > topKLimit {
+				return nil, fmt.Errorf("MMR function param: %s must be in range [1, %d], but got %s", candidateSizeKey, topKLimit, param.Value)
+			}
+		}
+	}
+
+	if base.pkType == schemapb.DataType_Int64 {
+		return &MMRFunction[int64]{RerankBase: *base, lambda: lambda, candidateSize: candidateSize}, nil
+	} else {
+		return &MMRFunction[string]{RerankBase: *base, lambda: lambda, candidateSize: candidateSize}, nil
+	}
+}
+
+// GetCandidateSize returns the number of search results MMR picks from for each query
+func (mmr *MMRFunction[T]) GetCandidateSize() int64 {
+	return mmr.candidateSize
+}
+
+func (mmr *MMRFunction[T]) processOneSearchData(ctx context.Context, searchParams *SearchParams, cols []*columns) (*IDScores[T], error) {
+	relevance := map[T]float32{}
+	vectors := map[T][]float32{}
+	for i, col := range cols {
+		if col.size == 0 {
+			continue
+		}
+		ids := col.ids.([]T)
+		rows, ok := col.data[0].([][]float32)
+		if !ok || len(rows) != len(ids) {
+			return nil, fmt.Errorf("MMR function input field data mismatch with search results")
+		}
+		for j, id := range ids {
+			score := toGreaterScore(col.scores[j], searchParams.searchMetrics[i])
+			if s, ok := relevance[id]; !ok || score > s {
+				relevance[id] = score
+			}
+			if _, ok := vectors[id]; !ok {
+				vectors[id] = rows[j]
+			}
+		}
+	}
+
+	candidates := make([]T, 0, len(relevance))
+	for id := range relevance {
+		candidates = append(candidates, id)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if relevance[candidates[i]] == relevance[candidates[j]] {
+			return candidates[i] < candidates[j]
+		}
+		return relevance[candidates[i]] > relevance[candidates[j]]
+	})
+	normalized := normalizeRelevance(candidates, relevance)
+
+	topk := int(searchParams.offset + searchParams.limit)
+	if topk > len(candidates) {
+		topk = len(candidates)
+	}
+	norms := make([]float64, len(candidates))
+	for i, id := range candidates {
+		norms[i] = vectorNorm(vectors[id])
+	}
+	// the max similarity of every candidate to the picked results
+	maxSim := make([]float64, len(candidates))
+	for i := range maxSim {
+		maxSim[i] = math.Inf(-1)
+	}
+	picked := make([]bool, len(candidates))
+	selected := make([]T, 0, topk)
+	scores := make([]float32, 0, topk)
+	for len(selected) < topk {
+		best, bestScore := -1, math.Inf(-1)
+		for i := range candidates {
+			if picked[i] {
+				continue
+			}
+			score := mmr.lambda * normalized[i]
+			if len(selected) > 0 {
+				score -= (1 - mmr.lambda) * maxSim[i]
+			}
+			if score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+		// the scores of all the remaining candidates are NaN, none of them can be ranked
+		if best == -1 {
+			break
+		}
+		picked[best] = true
+		selected = append(selected, candidates[best])
+		scores = append(scores, float32(bestScore))
+		for i := range candidates {
+			if picked[i] {
+				continue
+			}
+			sim := cosineSimilarity(vectors[candidates[i]], vectors[candidates[best]], norms[i], norms[best])
+			if sim > maxSim[i] {
+				maxSim[i] = sim
+			}
+		}
+	}
+
+	ret := IDScores[T]{
+		make([]T, 0, searchParams.limit),
+		make([]float32, 0, searchParams.limit),
+		0,
+	}
+	for index := int(searchParams.offset); index < len(selected); index++ {
+		score := scores[index]
+		if searchParams.roundDecimal != -1 {
+			multiplier := math.Pow(10.0, float64(searchParams.roundDecimal))
+			score = float32(math.Floor(float64(score)*multiplier+0.5) / multiplier)
+		}
+		ret.ids = append(ret.ids, selected[index])
+		ret.scores = append(ret.scores, score)
+	}
+	ret.size = int64(len(ret.ids))
+	return &ret, nil
+}
+
+func (mmr *MMRFunction[T]) Process(ctx context.Context, searchParams *SearchParams, inputs *rerankInputs) (*rerankOutputs, error) {
+	outputs := newRerankOutputs(searchParams)
+	for _, cols := range inputs.data {
+		idScore, err := mmr.processOneSearchData(ctx, searchParams, cols)
+		if err != nil {
+			return nil, err
+		}
+		appendResult(outputs, idScore.ids, idScore.scores)
+	}
+	return outputs, nil
+}
+
+// normalizeRelevance min-max normalizes the scores of the sorted candidates into [0, 1],
+// all the candidates get 1 if their scores are the same.
+func normalizeRelevance[T PKType](candidates []T, relevance map[T]float32) []float64 {
+	normalized := make([]float64, len(candidates))
+	if len(candidates) == 0 {
+		return normalized
+	}
+	maxScore := float64(relevance[candidates[0]])
+	minScore := float64(relevance[candidates[len(candidates)-1]])
+	for i, id := range candidates {
+		if maxScore == minScore {
+			normalized[i] = 1
+		} else {
+			normalized[i] = (float64(relevance[id]) - minScore) / (maxScore - minScore)
+		}
+	}
+	return normalized
+}
+
+func vectorNorm(v []float32) float64 {
+	var sum float64
+	for _, x := range v {
+		sum += float64(x) * float64(x)
+	}
+	return math.Sqrt(sum)
+}
+
+// cosineSimilarity treats the zero vectors as not similar to any vector
+func cosineSimilarity(a, b []float32, normA, normB float64) float64 {
+	if normA == 0 || normB == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+	}
+	return dot / (normA * normB)
+}
@@ -0,0 +1,252 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestMMRFunction(t *testing.T) {
+	suite.Run(t, new(MMRFunctionSuite))
+}
+
+type MMRFunctionSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *MMRFunctionSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *MMRFunctionSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "2"},
+				},
+			},
+			{FieldID: 103, Name: "ts", DataType: schemapb.DataType_Int64},
+		},
+	}
+}
+
+func mmrFunctionSchema(inputs []string, params ...*commonpb.KeyValuePair) *schemapb.FunctionSchema {
+	return &schemapb.FunctionSchema{
+		Name:            "test",
+		Type:            schemapb.FunctionType_Rerank,
+		InputFieldNames: inputs,
+		Params:          append([]*commonpb.KeyValuePair{{Key: reranker, Value: mmrName}}, params...),
+	}
+}
+
+func genVectorSearchResultData(ids []int64, scores []float32, vectors [][]float32, topks []int64) *schemapb.SearchResultData {
+	data := make([]float32, 0, len(vectors)*2)
+	for _, v := range vectors {
+		data = append(data, v...)
+	}
+	return &schemapb.SearchResultData{
+		NumQueries: int64(len(topks)),
+		TopK:       topks[0],
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+		Scores:     scores,
+		Topks:      topks,
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_FloatVector,
+				FieldName: "vector",
+				FieldId:   102,
+				Field: &schemapb.FieldData_Vectors{
+					Vectors: &schemapb.VectorField{
+						Dim:  2,
+						Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: data}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *MMRFunctionSuite) TestNewMMRFunction() {
+	{
+		f, err := createFunction(s.schema, mmrFunctionSchema([]string{"vector"}, &commonpb.KeyValuePair{Key: lambdaKey, Value: "0.7"}))
+		s.NoError(err)
+		s.Equal(mmrName, f.GetRankName())
+		s.False(f.IsSupportGroup())
+		s.Equal(0.7, f.(*MMRFunction[int64]).lambda)
+	}
+	{
+		f, err := newMMRFunction(s.schema, mmrFunctionSchema([]string{"vector"}))
+		s.NoError(err)
+		s.Equal(defaultLambda, f.(*MMRFunction[int64]).lambda)
+		s.Equal(defaultCandidateSize, f.(*MMRFunction[int64]).candidateSize)
+	}
+	{
+		f, err := newMMRFunction(s.schema, mmrFunctionSchema([]string{"vector"}, &commonpb.KeyValuePair{Key: candidateSizeKey, Value: "500"}))
+		s.NoError(err)
+		s.Equal(int64(500), f.(*MMRFunction[int64]).GetCandidateSize())
+
+		fScore, err := NewFunctionScore(s.schema, &schemapb.FunctionScore{
+			Functions: []*schemapb.FunctionSchema{mmrFunctionSchema([]string{"vector"}, &commonpb.KeyValuePair{Key: candidateSizeKey, Value: "500"})},
+		})
+		s.NoError(err)
+		s.Equal(int64(500), fScore.GetCandidateSize())
+		var nilScore *FunctionScore
+		s.Equal(int64(0), nilScore.GetCandidateSize())
+	}
+	{
+		_, err := newMMRFunction(s.schema, mmrFunctionSchema([]string{}))
+		s.ErrorContains(err, "MMR function only supports single input")
+		_, err = newMMRFunction(s.schema, mmrFunctionSchema([]string{"vector", "ts"}))
+		s.ErrorContains(err, "MMR function only supports single input")
+		_, err = newMMRFunction(s.schema, mmrFunctionSchema([]string{"ts"}))
+		s.ErrorContains(err, "only supports FloatVector input field")
+	}
+	{
+		_, err := newMMRFunction(s.schema, mmrFunctionSchema([]string{"vector"}, &commonpb.KeyValuePair{Key: lambdaKey, Value: "NotNum"}))
+		s.ErrorContains(err, "is not a number")
+		_, err = newMMRFunction(s.schema, mmrFunctionSchema([]string{"vector"}, &commonpb.KeyValuePair{Key: lambdaKey, Value: "1.5"}))
+		s.ErrorContains(err, "must be in range [0, 1]")
+		_, err = newMMRFunction(s.schema, mmrFunctionSchema([]string{"vector"}, &commonpb.KeyValuePair{Key: candidateSizeKey, Value: "1.5"}))
+		s.ErrorContains(err, "is not an integer")
+		_, err = newMMRFunction(s.schema, mmrFunctionSchema([]string{"vector"}, &commonpb.KeyValuePair{Key: candidateSizeKey, Value: "0"}))
+		s.ErrorContains(err, "candidate_size must be in range")
+		_, err = newMMRFunction(s.schema, mmrFunctionSchema([]string{"vector"}, &commonpb.KeyValuePair{Key: candidateSizeKey, Value: "100000000"}))
+		s.ErrorContains(err, "candidate_size must be in range")
+	}
+	{
+		schema := &schemapb.CollectionSchema{
+			Name: "test",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_VarChar, IsPrimaryKey: true},
+				{FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector},
+			},
+		}
+		f, err := newMMRFunction(schema, mmrFunctionSchema([]string{"vector"}))
+		s.NoError(err)
+		_, ok := f.(*MMRFunction[string])
+		s.True(ok)
+	}
+}
+
+func (s *MMRFunctionSuite) TestRerankProcess() {
+	// ids 1 and 2 are near duplicates, 3 is less relevant but diverse
+	ids := []int64{1, 2, 3}
+	scores := []float32{0.9, 0.89, 0.5}
+	vectors := [][]float32{{1, 0}, {1, 0.01}, {0, 1}}
+
+	// pure relevance
+	{
+		f, err := newMMRFunction(s.schema, mmrFunctionSchema([]string{"vector"}, &commonpb.KeyValuePair{Key: lambdaKey, Value: "1"}))
+		s.NoError(err)
+		data := genVectorSearchResultData(ids, scores, vectors, []int64{3})
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 2, 0, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{1, 2}, ret.searchResultData.Ids.GetIntId().Data)
+		s.Equal([]int64{2}, ret.searchResultData.Topks)
+	}
+	// diversity
+	{
+		f, err := newMMRFunction(s.schema, mmrFunctionSchema([]string{"vector"}, &commonpb.KeyValuePair{Key: lambdaKey, Value: "0.5"}))
+		s.NoError(err)
+		data := genVectorSearchResultData(ids, scores, vectors, []int64{3})
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 3, 0, 2, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{1, 3, 2}, ret.searchResultData.Ids.GetIntId().Data)
+		s.Equal(float32(0.5), ret.searchResultData.Scores[0])
+		s.Equal(float32(0), ret.searchResultData.Scores[1])
+
+		// offset
+		inputs, err = newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err = f.Process(context.Background(), NewSearchParams(1, 1, 1, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{3}, ret.searchResultData.Ids.GetIntId().Data)
+	}
+	// L2 distances, the smaller the more relevant
+	{
+		f, err := newMMRFunction(s.schema, mmrFunctionSchema([]string{"vector"}, &commonpb.KeyValuePair{Key: lambdaKey, Value: "1"}))
+		s.NoError(err)
+		data := genVectorSearchResultData(ids, []float32{0.9, 0.1, 0.5}, vectors, []int64{3})
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 3, 0, -1, -1, 1, false, "", []string{"L2"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{2, 3, 1}, ret.searchResultData.Ids.GetIntId().Data)
+	}
+	// nq = 2, and the hybrid search results are merged
+	{
+		f, err := newMMRFunction(s.schema, mmrFunctionSchema([]string{"vector"}, &commonpb.KeyValuePair{Key: lambdaKey, Value: "0.5"}))
+		s.NoError(err)
+		data1 := genVectorSearchResultData([]int64{1, 2, 11}, []float32{0.9, 0.89, 0.8}, [][]float32{{1, 0}, {1, 0.01}, {1, 0}}, []int64{2, 1})
+		data2 := genVectorSearchResultData([]int64{3, 12, 13}, []float32{0.5, 0.9, 0.1}, [][]float32{{0, 1}, {1, 1}, {-1, 0}}, []int64{1, 2})
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data1, data2}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(2, 2, 0, -1, -1, 1, false, "", []string{"COSINE", "COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{2, 2}, ret.searchResultData.Topks)
+		s.Equal([]int64{1, 3, 12, 13}, ret.searchResultData.Ids.GetIntId().Data)
+	}
+	// empty
+	{
+		f, err := newMMRFunction(s.schema, mmrFunctionSchema([]string{"vector"}))
+		s.NoError(err)
+		data := genVectorSearchResultData([]int64{}, []float32{}, [][]float32{}, []int64{0})
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 3, 0, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{0}, ret.searchResultData.Topks)
+	}
+	// NaN scores can not be ranked
+	{
+		f, err := newMMRFunction(s.schema, mmrFunctionSchema([]string{"vector"}, &commonpb.KeyValuePair{Key: lambdaKey, Value: "0.5"}))
+		s.NoError(err)
+		nan := float32(math.NaN())
+		data := genVectorSearchResultData(ids, []float32{nan, nan, nan}, vectors, []int64{3})
+		inputs, err := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 3, 0, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{0}, ret.searchResultData.Topks)
+	}
+}
+
+func (s *MMRFunctionSuite) TestCosineSimilarity() {
+	s.Equal(float64(0), cosineSimilarity([]float32{0, 0}, []float32{1, 0}, 0, 1))
+	s.InDelta(1.0, cosineSimilarity([]float32{2, 0}, []float32{1, 0}, 2, 1), 1e-9)
+	s.InDelta(-1.0, cosineSimilarity([]float32{-1, 0}, []float32{1, 0}, 1, 1), 1e-9)
+}
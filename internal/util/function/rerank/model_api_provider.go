@@ -0,0 +1,225 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function"
+)
+
+const (
+	modelNameParamName string = "model_name"
+
+	cohereMaxTokensPerDocParamName string = "max_tokens_per_doc"
+	voyageTruncationParamName      string = "truncation"
+
+	cohereRerankURL string = "https://api.cohere.com/v2/rerank"
+	voyageRerankURL string = "https://api.voyageai.com/v1/rerank"
+	jinaRerankURL   string = "https://api.jina.ai/v1/rerank"
+)
+
+type apiRerankResult struct {
+	Index          int     `json:"index"`
+	RelevanceScore float32 `json:"relevance_score"`
+}
+
+// apiRerankResponse covers the responses of cohere and jina, which return `results`, and voyageai, which returns `data`
+type apiRerankResponse struct {
+	Results []apiRerankResult `json:"results"`
+	Data    []apiRerankResult `json:"data"`
+}
+
+type apiModelParams struct {
+	apiKey    string
+	endpoint  string
+	modelName string
+	maxBatch  int
+}
+
+// parseAPIModelParams parses the params shared by the hosted rerank services,
+// the credentials are configured in the same way as the embedding providers.
+func parseAPIModelParams(providerName string, params []*commonpb.KeyValuePair, conf map[string]string, credentials *credentials.Credentials, apiKeyEnv string, defaultURL string) (*apiModelParams, error) {
+	p := &apiModelParams{maxBatch: 32}
+	for _, param := range params {
+		switch strings.ToLower(param.Key) {
+		case modelNameParamName:
+			p.modelName = param.Value
+		case function.EndpointParamKey:
+			base, err := url.Parse(param.Value)
+			if err != nil {
+				return nil, err
+			}
+			if (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+				return nil, fmt.Errorf("Rerank endpoint: [%s] is not a valid http/https link", param.Value)
+			}
+			p.endpoint = base.String()
+		case maxBatchKeyName:
+			batch, err := strconv.ParseInt(param.Value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Rerank params error, maxBatch: %s is not a number", param.Value)
+			}
+			p.maxBatch = int(batch)
+		}
+	}
+	if p.modelName == "" {
+		return nil, fmt.Errorf("Rerank function lost params %s of provider %s", modelNameParamName, providerName)
+	}
+	if p.maxBatch <= 0 {
+		return nil, fmt.Errorf("Rerank function params max_batch must > 0, but got %d", p.maxBatch)
+	}
+
+	apiKey, confURL, err := function.ParseAKAndURL(credentials, params, conf, apiKeyEnv)
+	if err != nil {
+		return nil, err
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("Missing credentials config or configure the %s environment variable in the Milvus service.", apiKeyEnv)
+	}
+	p.apiKey = apiKey
+	// function param > milvus.yaml > official url
+	if p.endpoint == "" {
+		p.endpoint = confURL
+	}
+	if p.endpoint == "" {
+		p.endpoint = defaultURL
+	}
+	return p, nil
+}
+
+// parseIndexedScores maps the results to the documents by index, the services sort the results by relevance
+// and only return top n of them, top n is always set to the batch size so that every document is scored.
+func parseIndexedScores(providerName string) func([]byte, int) ([]float32, error) {
+	return func(body []byte, numDocs int) ([]float32, error) {
+		var resp apiRerankResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("Rerank error, parsing %s response failed: %v", providerName, err)
+		}
+		results := resp.Results
+		if len(results) == 0 {
+			results = resp.Data
+		}
+		if len(results) != numDocs {
+			return nil, fmt.Errorf("Rerank error, %s returns %d results for %d documents", providerName, len(results), numDocs)
+		}
+		scores := make([]float32, numDocs)
+		seen := make([]bool, numDocs)
+		for _, result := range results {
+			if result.Index < 0 || result.Index >= numDocs || seen[result.Index] {
+				return nil, fmt.Errorf("Rerank error, %s returns invalid document index %d", providerName, result.Index)
+			}
+			seen[result.Index] = true
+			scores[result.Index] = result.RelevanceScore
+		}
+		return scores, nil
+	}
+}
+
+type cohereProvider struct {
+	baseModel
+}
+
+func newCohereProvider(params []*commonpb.KeyValuePair, conf map[string]string, credentials *credentials.Credentials) (modelProvider, error) {
+	p, err := parseAPIModelParams(cohereProviderName, params, conf, credentials, function.CohereAIAKEnvStr, cohereRerankURL)
+	if err != nil {
+		return nil, err
+	}
+	extraParams := map[string]any{"model": p.modelName}
+	for _, param := range params {
+		if strings.ToLower(param.Key) == cohereMaxTokensPerDocParamName {
+			maxTokens, err := strconv.ParseInt(param.Value, 10, 64)
+			if err != nil || maxTokens <= 0 {
+				return nil, fmt.Errorf("Rerank params error, %s: %s is not a positive number", cohereMaxTokensPerDocParamName, param.Value)
+			}
+			extraParams[cohereMaxTokensPerDocParamName] = maxTokens
+		}
+	}
+	model := baseModel{
+		url:         p.endpoint,
+		maxBatch:    p.maxBatch,
+		headers:     map[string]string{"Authorization": fmt.Sprintf("bearer %s", p.apiKey)},
+		queryKey:    "query",
+		docKey:      "documents",
+		topNKey:     "top_n",
+		extraParams: extraParams,
+		parseScores: parseIndexedScores(cohereProviderName),
+	}
+	return &cohereProvider{baseModel: model}, nil
+}
+
+type voyageProvider struct {
+	baseModel
+}
+
+func newVoyageProvider(params []*commonpb.KeyValuePair, conf map[string]string, credentials *credentials.Credentials) (modelProvider, error) {
+	p, err := parseAPIModelParams(voyageProviderName, params, conf, credentials, function.VoyageAIAKEnvStr, voyageRerankURL)
+	if err != nil {
+		return nil, err
+	}
+	extraParams := map[string]any{"model": p.modelName}
+	for _, param := range params {
+		if strings.ToLower(param.Key) == voyageTruncationParamName {
+			truncation, err := strconv.ParseBool(param.Value)
+			if err != nil {
+				return nil, fmt.Errorf("Rerank params error, %s: %s is not bool type", voyageTruncationParamName, param.Value)
+			}
+			extraParams[voyageTruncationParamName] = truncation
+		}
+	}
+	model := baseModel{
+		url:         p.endpoint,
+		maxBatch:    p.maxBatch,
+		headers:     map[string]string{"Authorization": fmt.Sprintf("Bearer %s", p.apiKey)},
+		queryKey:    "query",
+		docKey:      "documents",
+		topNKey:     "top_k",
+		extraParams: extraParams,
+		parseScores: parseIndexedScores(voyageProviderName),
+	}
+	return &voyageProvider{baseModel: model}, nil
+}
+
+type jinaProvider struct {
+	baseModel
+}
+
+func newJinaProvider(params []*commonpb.KeyValuePair, conf map[string]string, credentials *credentials.Credentials) (modelProvider, error) {
+	p, err := parseAPIModelParams(jinaProviderName, params, conf, credentials, function.JinaAIAKEnvStr, jinaRerankURL)
+	if err != nil {
+		return nil, err
+	}
+	model := baseModel{
+		url:      p.endpoint,
+		maxBatch: p.maxBatch,
+		headers:  map[string]string{"Authorization": fmt.Sprintf("Bearer %s", p.apiKey)},
+		queryKey: "query",
+		docKey:   "documents",
+		topNKey:  "top_n",
+		// the documents exceeding the context length of the model are truncated by jina
+		extraParams: map[string]any{"model": p.modelName, "return_documents": false},
+		parseScores: parseIndexedScores(jinaProviderName),
+	}
+	return &jinaProvider{baseModel: model}, nil
+}
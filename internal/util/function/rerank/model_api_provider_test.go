@@ -0,0 +1,207 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestRerankAPIProvider(t *testing.T) {
+	suite.Run(t, new(RerankAPIProviderSuite))
+}
+
+type RerankAPIProviderSuite struct {
+	suite.Suite
+}
+
+func (s *RerankAPIProviderSuite) SetupTest() {
+	paramtable.Init()
+	paramtable.Get().FunctionCfg.RerankModelProviders.GetFunc = func() map[string]string {
+		return map[string]string{}
+	}
+	paramtable.Get().CredentialCfg.Credential.GetFunc = func() map[string]string {
+		return map[string]string{"mock.apikey": "mock"}
+	}
+}
+
+// createAPIRerankServer returns the results in the reverse order of the documents like the real services,
+// which sort the results by relevance.
+func createAPIRerankServer(resultsKey string, requests *[]map[string]any) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &req)
+		req["authorization"] = r.Header.Get("Authorization")
+		*requests = append(*requests, req)
+		docs := req["documents"].([]any)
+		results := make([]apiRerankResult, 0, len(docs))
+		for i := len(docs) - 1; i >= 0; i-- {
+			results = append(results, apiRerankResult{Index: i, RelevanceScore: float32(i) / 10})
+		}
+		data, _ := json.Marshal(map[string]any{resultsKey: results})
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	}))
+}
+
+func (s *RerankAPIProviderSuite) TestRerank() {
+	cases := []struct {
+		provider   string
+		resultsKey string
+		topNKey    string
+		extraKey   string
+		extraValue string
+		expected   any
+	}{
+		{cohereProviderName, "results", "top_n", cohereMaxTokensPerDocParamName, "512", float64(512)},
+		{voyageProviderName, "data", "top_k", voyageTruncationParamName, "true", true},
+		{jinaProviderName, "results", "top_n", "", "", nil},
+	}
+	for _, c := range cases {
+		var requests []map[string]any
+		ts := createAPIRerankServer(c.resultsKey, &requests)
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: c.provider},
+			{Key: modelNameParamName, Value: "rerank-model"},
+			{Key: "credential", Value: "mock"},
+			{Key: function.EndpointParamKey, Value: ts.URL},
+			{Key: maxBatchKeyName, Value: "2"},
+		}
+		if c.extraKey != "" {
+			params = append(params, &commonpb.KeyValuePair{Key: c.extraKey, Value: c.extraValue})
+		}
+		provider, err := newProvider(params)
+		s.NoError(err)
+		scores, err := provider.rerank(context.Background(), "query", []string{"t0", "t1", "t2"})
+		s.NoError(err)
+		s.Equal([]float32{0.0, 0.1, 0.0}, scores)
+
+		// batched by max_batch, and top n is the batch size
+		s.Equal(2, len(requests))
+		s.Equal(float64(2), requests[0][c.topNKey])
+		s.Equal(float64(1), requests[1][c.topNKey])
+		s.Equal("rerank-model", requests[0]["model"])
+		s.Contains(requests[0]["authorization"], "mock")
+		if c.extraKey != "" {
+			s.Equal(c.expected, requests[0][c.extraKey])
+		}
+		ts.Close()
+	}
+}
+
+func (s *RerankAPIProviderSuite) TestParseScores() {
+	parse := parseIndexedScores(cohereProviderName)
+	scores, err := parse([]byte(`{"results": [{"index": 1, "relevance_score": 0.9}, {"index": 0, "relevance_score": 0.1}]}`), 2)
+	s.NoError(err)
+	s.Equal([]float32{0.1, 0.9}, scores)
+
+	_, err = parse([]byte(`not json`), 2)
+	s.ErrorContains(err, "parsing cohere response failed")
+	// top n is less than the documents
+	_, err = parse([]byte(`{"results": [{"index": 1, "relevance_score": 0.9}]}`), 2)
+	s.Error(err)
+	_, err = parse([]byte(`{"results": [{"index": 1, "relevance_score": 0.9}, {"index": 1, "relevance_score": 0.1}]}`), 2)
+	s.Error(err)
+	_, err = parse([]byte(`{"results": [{"index": 5, "relevance_score": 0.9}, {"index": 0, "relevance_score": 0.1}]}`), 2)
+	s.Error(err)
+}
+
+func (s *RerankAPIProviderSuite) TestNewProvider() {
+	creds := credentials.NewCredentials(map[string]string{"mock.apikey": "mock"})
+	withCredential := []*commonpb.KeyValuePair{
+		{Key: modelNameParamName, Value: "rerank-model"},
+		{Key: "credential", Value: "mock"},
+	}
+	// default url
+	{
+		provider, err := newCohereProvider(withCredential, map[string]string{}, creds)
+		s.NoError(err)
+		s.Equal(cohereRerankURL, provider.getURL())
+		provider, err = newVoyageProvider(withCredential, map[string]string{}, creds)
+		s.NoError(err)
+		s.Equal(voyageRerankURL, provider.getURL())
+		provider, err = newJinaProvider(withCredential, map[string]string{}, creds)
+		s.NoError(err)
+		s.Equal(jinaRerankURL, provider.getURL())
+	}
+	// url and credential in milvus.yaml
+	{
+		params := []*commonpb.KeyValuePair{{Key: modelNameParamName, Value: "rerank-model"}}
+		provider, err := newJinaProvider(params, map[string]string{"credential": "mock", "url": "http://localhost:8080/v1/rerank"}, creds)
+		s.NoError(err)
+		s.Equal("http://localhost:8080/v1/rerank", provider.getURL())
+	}
+	// api key from env
+	{
+		os.Setenv(function.JinaAIAKEnvStr, "mock")
+		defer os.Unsetenv(function.JinaAIAKEnvStr)
+		params := []*commonpb.KeyValuePair{{Key: modelNameParamName, Value: "rerank-model"}}
+		_, err := newJinaProvider(params, map[string]string{}, creds)
+		s.NoError(err)
+	}
+	// missing api key
+	{
+		params := []*commonpb.KeyValuePair{{Key: modelNameParamName, Value: "rerank-model"}}
+		_, err := newCohereProvider(params, map[string]string{}, creds)
+		s.ErrorContains(err, function.CohereAIAKEnvStr)
+	}
+	// unknown credential
+	{
+		params := []*commonpb.KeyValuePair{
+			{Key: modelNameParamName, Value: "rerank-model"},
+			{Key: "credential", Value: "unknown"},
+		}
+		_, err := newVoyageProvider(params, map[string]string{}, creds)
+		s.Error(err)
+	}
+	// missing model name
+	{
+		params := []*commonpb.KeyValuePair{{Key: "credential", Value: "mock"}}
+		_, err := newCohereProvider(params, map[string]string{}, creds)
+		s.ErrorContains(err, modelNameParamName)
+	}
+	// invalid params
+	{
+		invalids := [][]*commonpb.KeyValuePair{
+			append([]*commonpb.KeyValuePair{{Key: function.EndpointParamKey, Value: "mock"}}, withCredential...),
+			append([]*commonpb.KeyValuePair{{Key: maxBatchKeyName, Value: "0"}}, withCredential...),
+			append([]*commonpb.KeyValuePair{{Key: maxBatchKeyName, Value: "invalid"}}, withCredential...),
+			append([]*commonpb.KeyValuePair{{Key: cohereMaxTokensPerDocParamName, Value: "invalid"}}, withCredential...),
+		}
+		for _, params := range invalids {
+			_, err := newCohereProvider(params, map[string]string{}, creds)
+			s.Error(err)
+		}
+		_, err := newVoyageProvider(append([]*commonpb.KeyValuePair{{Key: voyageTruncationParamName, Value: "invalid"}}, withCredential...), map[string]string{}, creds)
+		s.Error(err)
+	}
+}
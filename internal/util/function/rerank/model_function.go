@@ -32,15 +32,19 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credentials"
 	"github.com/milvus-io/milvus/internal/util/function"
 	"github.com/milvus-io/milvus/internal/util/function/models/utils"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
 const (
-	providerParamName string = "provider"
-	vllmProviderName  string = "vllm"
-	teiProviderName   string = "tei"
+	providerParamName  string = "provider"
+	vllmProviderName   string = "vllm"
+	teiProviderName    string = "tei"
+	cohereProviderName string = "cohere"
+	voyageProviderName string = "voyageai"
+	jinaProviderName   string = "jina"
 
 	queryKeyName    string = "queries"
 	maxBatchKeyName string = "max_batch"
@@ -58,13 +62,17 @@ type modelProvider interface {
 type baseModel struct {
 	url      string
 	maxBatch int
+	headers  map[string]string
 
 	queryKey string
 	docKey   string
+	// topNKey is set to the batch size for the services which only return the top n results
+	topNKey string
 
-	truncateParams map[string]any
+	// extraParams are sent in every request, such as the model name and the truncation params
+	extraParams map[string]any
 
-	parseScores func([]byte) ([]float32, error)
+	parseScores func([]byte, int) ([]float32, error)
 }
 
 func (base *baseModel) getURL() string {
@@ -72,13 +80,17 @@ func (base *baseModel) getURL() string {
 }
 
 func (base *baseModel) rerank(ctx context.Context, query string, docs []string) ([]float32, error) {
-	requestBodies, err := genRerankRequestBody(query, docs, base.maxBatch, base.queryKey, base.docKey, base.truncateParams)
+	requestBodies, err := genRerankRequestBody(query, docs, base.maxBatch, base.queryKey, base.docKey, base.topNKey, base.extraParams)
 	if err != nil {
 		return nil, err
 	}
 	scores := []float32{}
-	for _, requestBody := range requestBodies {
-		rerankResp, err := base.callService(ctx, requestBody, 30)
+	for i, requestBody := range requestBodies {
+		batchSize := base.maxBatch
+		if remain := len(docs) - i*base.maxBatch; remain < batchSize {
+			batchSize = remain
+		}
+		rerankResp, err := base.callService(ctx, requestBody, batchSize, 30)
 		if err != nil {
 			return nil, fmt.Errorf("Call rerank model failed: %v\n", err)
 		}
@@ -91,17 +103,20 @@ func (base *baseModel) rerank(ctx context.Context, query string, docs []string)
 	return scores, nil
 }
 
-func (base *baseModel) callService(ctx context.Context, requestBody []byte, timeoutSec int64) ([]float32, error) {
+func (base *baseModel) callService(ctx context.Context, requestBody []byte, numDocs int, timeoutSec int64) ([]float32, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
 	defer cancel()
 	headers := map[string]string{
 		"Content-Type": "application/json",
 	}
+	for k, v := range base.headers {
+		headers[k] = v
+	}
 	body, err := utils.RetrySend(ctx, requestBody, http.MethodPost, base.url, headers, 3)
 	if err != nil {
 		return nil, err
 	}
-	return base.parseScores(body)
+	return base.parseScores(body, numDocs)
 }
 
 type vllmRerankRequest struct {
@@ -146,12 +161,12 @@ func newVllmProvider(params []*commonpb.KeyValuePair, conf map[string]string) (m
 	base, _ := url.Parse(endpoint)
 	base.Path = "/v2/rerank"
 	model := baseModel{
-		url:            base.String(),
-		maxBatch:       maxBatch,
-		queryKey:       "query",
-		docKey:         "documents",
-		truncateParams: truncateParams,
-		parseScores: func(body []byte) ([]float32, error) {
+		url:         base.String(),
+		maxBatch:    maxBatch,
+		queryKey:    "query",
+		docKey:      "documents",
+		extraParams: truncateParams,
+		parseScores: func(body []byte, _ int) ([]float32, error) {
 			var rerankResp vllmRerankResponse
 			if err := json.Unmarshal(body, &rerankResp); err != nil {
 				return nil, fmt.Errorf("Rerank error, parsing vllm response failed: %v", err)
@@ -192,12 +207,12 @@ func newTeiProvider(params []*commonpb.KeyValuePair, conf map[string]string) (mo
 	base, _ := url.Parse(endpoint)
 	base.Path = "/rerank"
 	model := baseModel{
-		url:            base.String(),
-		maxBatch:       maxBatch,
-		queryKey:       "query",
-		docKey:         "texts",
-		truncateParams: truncateParams,
-		parseScores: func(body []byte) ([]float32, error) {
+		url:         base.String(),
+		maxBatch:    maxBatch,
+		queryKey:    "query",
+		docKey:      "texts",
+		extraParams: truncateParams,
+		parseScores: func(body []byte, _ int) ([]float32, error) {
 			var results []TEIResponse
 			if err := json.Unmarshal(body, &results); err != nil {
 				return nil, fmt.Errorf("Rerank error, parsing TEI response failed: %v", err)
@@ -274,7 +289,7 @@ func parseParams(params []*commonpb.KeyValuePair) (string, int, map[string]any,
 	return endpoint, maxBatch, truncateParams, nil
 }
 
-func genRerankRequestBody(query string, documents []string, maxSize int, queryKey string, docKey string, truncateParams map[string]any) ([][]byte, error) {
+func genRerankRequestBody(query string, documents []string, maxSize int, queryKey string, docKey string, topNKey string, extraParams map[string]any) ([][]byte, error) {
 	requestBodies := [][]byte{}
 	for i := 0; i < len(documents); i += maxSize {
 		end := i + maxSize
@@ -285,9 +300,12 @@ func genRerankRequestBody(query string, documents []string, maxSize int, queryKe
 			queryKey: query,
 			docKey:   documents[i:end],
 		}
-		for k, v := range truncateParams {
+		for k, v := range extraParams {
 			requestBody[k] = v
 		}
+		if topNKey != "" {
+			requestBody[topNKey] = end - i
+		}
 		jsonData, err := json.Marshal(requestBody)
 		if err != nil {
 			return nil, fmt.Errorf("Create model rerank request failed, err: %s", err)
@@ -307,6 +325,12 @@ func newProvider(params []*commonpb.KeyValuePair) (modelProvider, error) {
 				return newVllmProvider(params, conf)
 			case teiProviderName:
 				return newTeiProvider(params, conf)
+			case cohereProviderName:
+				return newCohereProvider(params, conf, credentials.NewCredentials(paramtable.Get().CredentialCfg.GetCredentials()))
+			case voyageProviderName:
+				return newVoyageProvider(params, conf, credentials.NewCredentials(paramtable.Get().CredentialCfg.GetCredentials()))
+			case jinaProviderName:
+				return newJinaProvider(params, conf, credentials.NewCredentials(paramtable.Get().CredentialCfg.GetCredentials()))
 			default:
 				return nil, fmt.Errorf("Unknow rerank provider:%s", param.Value)
 			}
@@ -351,6 +351,17 @@ func getField(inputField *schemapb.FieldData, start int64, size int64) (any, err
 			return inputField.GetScalars().GetStringData().Data[start : start+size], nil
 		}
 		return []string{}, nil
+	case schemapb.DataType_FloatVector:
+		if inputField.GetVectors() != nil && inputField.GetVectors().GetFloatVector() != nil {
+			dim := inputField.GetVectors().GetDim()
+			data := inputField.GetVectors().GetFloatVector().Data
+			rows := make([][]float32, 0, size)
+			for i := start; i < start+size; i++ {
+				rows = append(rows, data[i*dim:(i+1)*dim])
+			}
+			return rows, nil
+		}
+		return [][]float32{}, nil
 	default:
 		return nil, fmt.Errorf("Unsupported field type:%s", inputField.Type.String())
 	}
@@ -19,6 +19,7 @@
 package function
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -94,7 +95,7 @@ func (provider *SiliconflowEmbeddingProvider) FieldDim() int64 {
 	return provider.fieldDim
 }
 
-func (provider *SiliconflowEmbeddingProvider) CallEmbedding(texts []string, _ TextEmbeddingMode) (any, error) {
+func (provider *SiliconflowEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, _ TextEmbeddingMode) (any, error) {
 	numRows := len(texts)
 	data := make([][]float32, 0, numRows)
 	for i := 0; i < numRows; i += provider.maxBatch {
@@ -102,7 +103,7 @@ func (provider *SiliconflowEmbeddingProvider) CallEmbedding(texts []string, _ Te
 		if end > numRows {
 			end = numRows
 		}
-		resp, err := provider.client.Embedding(provider.modelName, texts[i:end], "float", provider.timeoutSec)
+		resp, err := provider.client.Embedding(ctx, provider.modelName, texts[i:end], "float", provider.timeoutSec)
 		if err != nil {
 			return nil, err
 		}
@@ -19,6 +19,7 @@
 package function
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -90,7 +91,7 @@ func (s *SiliconflowTextEmbeddingProviderSuite) TestEmbedding() {
 		s.NoError(err)
 		{
 			data := []string{"sentence"}
-			r, err2 := provder.CallEmbedding(data, InsertMode)
+			r, err2 := provder.CallEmbedding(context.Background(), data, InsertMode)
 			ret := r.([][]float32)
 			s.NoError(err2)
 			s.Equal(1, len(ret))
@@ -98,7 +99,7 @@ func (s *SiliconflowTextEmbeddingProviderSuite) TestEmbedding() {
 		}
 		{
 			data := []string{"sentence 1", "sentence 2", "sentence 3"}
-			_, err := provder.CallEmbedding(data, SearchMode)
+			_, err := provder.CallEmbedding(context.Background(), data, SearchMode)
 			s.NoError(err)
 		}
 	}
@@ -133,7 +134,7 @@ func (s *SiliconflowTextEmbeddingProviderSuite) TestEmbeddingDimNotMatch() {
 
 		// embedding dim not match
 		data := []string{"sentence", "sentence"}
-		_, err2 := provder.CallEmbedding(data, InsertMode)
+		_, err2 := provder.CallEmbedding(context.Background(), data, InsertMode)
 		s.Error(err2)
 	}
 }
@@ -162,7 +163,7 @@ func (s *SiliconflowTextEmbeddingProviderSuite) TestEmbeddingNumberNotMatch() {
 
 		// embedding dim not match
 		data := []string{"sentence", "sentence2"}
-		_, err2 := provder.CallEmbedding(data, InsertMode)
+		_, err2 := provder.CallEmbedding(context.Background(), data, InsertMode)
 		s.Error(err2)
 	}
 }
@@ -19,6 +19,7 @@
 package function
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -34,6 +35,9 @@ import (
 
 type TeiEmbeddingProvider struct {
 	fieldDim int64
+	// sparse is set when the output field is a sparse float vector,
+	// the embeddings are then obtained from the learned sparse models such as SPLADE.
+	sparse bool
 
 	client *tei.TEIEmbedding
 
@@ -56,9 +60,13 @@ func createTEIEmbeddingClient(apiKey string, endpoint string) (*tei.TEIEmbedding
 }
 
 func NewTEIEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchema *schemapb.FunctionSchema, params map[string]string, credentials *credentials.Credentials) (*TeiEmbeddingProvider, error) {
-	fieldDim, err := typeutil.GetDim(fieldSchema)
-	if err != nil {
-		return nil, err
+	var fieldDim int64
+	var err error
+	sparse := fieldSchema.GetDataType() == schemapb.DataType_SparseFloatVector
+	if !sparse {
+		if fieldDim, err = typeutil.GetDim(fieldSchema); err != nil {
+			return nil, err
+		}
 	}
 	var endpoint, ingestionPrompt, searchPrompt string
 	// TEI default client batch size
@@ -103,6 +111,7 @@ func NewTEIEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchema *
 	provider := TeiEmbeddingProvider{
 		client:   c,
 		fieldDim: fieldDim,
+		sparse:   sparse,
 
 		ingestionPrompt:     ingestionPrompt,
 		searchPrompt:        searchPrompt,
@@ -122,22 +131,27 @@ func (provider *TeiEmbeddingProvider) FieldDim() int64 {
 	return provider.fieldDim
 }
 
-func (provider *TeiEmbeddingProvider) CallEmbedding(texts []string, mode TextEmbeddingMode) (any, error) {
-	numRows := len(texts)
-	data := make([][]float32, 0, numRows)
-	var prompt string
+func (provider *TeiEmbeddingProvider) prompt(mode TextEmbeddingMode) string {
 	if mode == InsertMode {
-		prompt = provider.ingestionPrompt
-	} else {
-		prompt = provider.searchPrompt
+		return provider.ingestionPrompt
 	}
+	return provider.searchPrompt
+}
+
+func (provider *TeiEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, mode TextEmbeddingMode) (any, error) {
+	if provider.sparse {
+		return provider.callSparseEmbedding(ctx, texts, mode)
+	}
+	numRows := len(texts)
+	data := make([][]float32, 0, numRows)
+	prompt := provider.prompt(mode)
 
 	for i := 0; i < numRows; i += provider.maxBatch {
 		end := i + provider.maxBatch
 		if end > numRows {
 			end = numRows
 		}
-		resp, err := provider.client.Embedding(texts[i:end], provider.truncate, provider.truncationDirection, prompt, provider.timeoutSec)
+		resp, err := provider.client.Embedding(ctx, texts[i:end], provider.truncate, provider.truncationDirection, prompt, provider.timeoutSec)
 		if err != nil {
 			return nil, err
 		}
@@ -154,3 +168,31 @@ func (provider *TeiEmbeddingProvider) CallEmbedding(texts []string, mode TextEmb
 	}
 	return data, nil
 }
+
+func (provider *TeiEmbeddingProvider) callSparseEmbedding(ctx context.Context, texts []string, mode TextEmbeddingMode) (*schemapb.SparseFloatArray, error) {
+	numRows := len(texts)
+	data := make([]map[uint32]float32, 0, numRows)
+	prompt := provider.prompt(mode)
+
+	for i := 0; i < numRows; i += provider.maxBatch {
+		end := i + provider.maxBatch
+		if end > numRows {
+			end = numRows
+		}
+		resp, err := provider.client.SparseEmbedding(ctx, texts[i:end], provider.truncate, provider.truncationDirection, prompt, provider.timeoutSec)
+		if err != nil {
+			return nil, err
+		}
+		if end-i != len(resp) {
+			return nil, fmt.Errorf("Get embedding failed. The number of texts and embeddings does not match text:[%d], embedding:[%d]", end-i, len(resp))
+		}
+		for _, item := range resp {
+			row := make(map[uint32]float32, len(item))
+			for _, v := range item {
+				row[v.Index] = v.Value
+			}
+			data = append(data, row)
+		}
+	}
+	return buildSparseFloatArray(data), nil
+}
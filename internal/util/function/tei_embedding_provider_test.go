@@ -19,6 +19,7 @@
 package function
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -92,7 +93,7 @@ func (s *TEITextEmbeddingProviderSuite) TestEmbedding() {
 		s.NoError(err)
 		{
 			data := []string{"sentence"}
-			r, err2 := provder.CallEmbedding(data, InsertMode)
+			r, err2 := provder.CallEmbedding(context.Background(), data, InsertMode)
 			ret := r.([][]float32)
 			s.NoError(err2)
 			s.Equal(1, len(ret))
@@ -100,12 +101,48 @@ func (s *TEITextEmbeddingProviderSuite) TestEmbedding() {
 		}
 		{
 			data := []string{"sentence 1", "sentence 2", "sentence 3"}
-			_, err := provder.CallEmbedding(data, SearchMode)
+			_, err := provder.CallEmbedding(context.Background(), data, SearchMode)
 			s.NoError(err)
 		}
 	}
 }
 
+func (s *TEITextEmbeddingProviderSuite) TestSparseEmbedding() {
+	ts := CreateTEISparseEmbeddingServer()
+	defer ts.Close()
+
+	sparseField := &schemapb.FieldSchema{FieldID: 102, Name: "vector", DataType: schemapb.DataType_SparseFloatVector}
+	provider, err := createTEIProvider(ts.URL, sparseField, teiProvider)
+	s.NoError(err)
+	s.Equal(int64(0), provider.FieldDim())
+	{
+		r, err := provider.CallEmbedding(context.Background(), []string{"sentence"}, InsertMode)
+		s.NoError(err)
+		ret := r.(*schemapb.SparseFloatArray)
+		s.Equal(1, len(ret.Contents))
+		s.Equal(int64(101), ret.Dim)
+	}
+	{
+		r, err := provider.CallEmbedding(context.Background(), []string{"sentence 1", "sentence 2", "sentence 3"}, SearchMode)
+		s.NoError(err)
+		s.Equal(3, len(r.(*schemapb.SparseFloatArray).Contents))
+	}
+}
+
+func (s *TEITextEmbeddingProviderSuite) TestSparseEmbeddingNumberNotMatch() {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[[{"index": 1, "value": 0.5}]]`))
+	}))
+	defer ts.Close()
+
+	sparseField := &schemapb.FieldSchema{FieldID: 102, Name: "vector", DataType: schemapb.DataType_SparseFloatVector}
+	provider, err := createTEIProvider(ts.URL, sparseField, teiProvider)
+	s.NoError(err)
+	_, err = provider.CallEmbedding(context.Background(), []string{"a", "b"}, InsertMode)
+	s.Error(err)
+}
+
 func (s *TEITextEmbeddingProviderSuite) TestEmbeddingDimNotMatch() {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		res := [][]float32{{0.1, 0.1, 0.1, 0.1}, {0.1, 0.1, 0.1}}
@@ -121,7 +158,7 @@ func (s *TEITextEmbeddingProviderSuite) TestEmbeddingDimNotMatch() {
 
 		// embedding dim not match
 		data := []string{"sentence", "sentence"}
-		_, err2 := provder.CallEmbedding(data, InsertMode)
+		_, err2 := provder.CallEmbedding(context.Background(), data, InsertMode)
 		s.Error(err2)
 	}
 }
@@ -142,7 +179,7 @@ func (s *TEITextEmbeddingProviderSuite) TestEmbeddingNumberNotMatch() {
 
 		// embedding dim not match
 		data := []string{"sentence", "sentence2"}
-		_, err2 := provder.CallEmbedding(data, InsertMode)
+		_, err2 := provder.CallEmbedding(context.Background(), data, InsertMode)
 		s.Error(err2)
 	}
 }
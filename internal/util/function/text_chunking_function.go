@@ -0,0 +1,418 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const (
+	textChunkingChunkSizeKey    = "chunk_size"
+	textChunkingChunkOverlapKey = "chunk_overlap"
+	textChunkingStrategyKey     = "strategy"
+
+	// textChunkingStrategyFixed splits the document into windows of chunk_size characters.
+	textChunkingStrategyFixed = "fixed"
+	// textChunkingStrategyPunctuation splits the document after the terminal punctuations, and packs the
+	// segments into chunks of at most chunk_size characters. It does not use the analyzer of the input field,
+	// so the languages which do not end the sentences with the punctuations are split by the fixed size.
+	textChunkingStrategyPunctuation = "punctuation"
+
+	defaultTextChunkingChunkSize = 512
+	textChunkingProvider         = "milvus"
+)
+
+func TextChunkingOutputsCheck(fields []*schemapb.FieldSchema) error {
+	if len(fields) != 1 || fields[0].GetDataType() != schemapb.DataType_Int64 {
+		return errors.New("TextChunking function output field must be an Int64 field")
+	}
+	return nil
+}
+
+// TextChunking function
+// Input: string, the document, which is replaced by its chunks in place
+// Output: int64, the parent id shared by all the chunks of the same document
+// Every inserted document is expanded into one row per chunk, the other fields of the row are copied to every chunk.
+// The chunking runs before the other functions, so the embedding functions on the document field embed the chunks.
+// The insert returns the parent ids of the documents instead of the primary keys of the chunks.
+type TextChunkingFunction struct {
+	FunctionBase
+
+	inputField   *schemapb.FieldSchema
+	chunkSize    int
+	chunkOverlap int
+	strategy     string
+}
+
+func NewTextChunkingFunction(coll *schemapb.CollectionSchema, functionSchema *schemapb.FunctionSchema) (*TextChunkingFunction, error) {
+	if len(functionSchema.GetInputFieldNames()) != 1 || len(functionSchema.GetOutputFieldNames()) != 1 {
+		return nil, fmt.Errorf("TextChunking function should only have one input and one output field, but now is %d and %d",
+			len(functionSchema.GetInputFieldNames()), len(functionSchema.GetOutputFieldNames()))
+	}
+
+	base := FunctionBase{
+		schema:           functionSchema,
+		collectionName:   coll.GetName(),
+		functionTypeName: functionSchema.GetType().String(),
+		functionName:     functionSchema.GetName(),
+		provider:         textChunkingProvider,
+	}
+	var inputField *schemapb.FieldSchema
+	for _, field := range coll.GetFields() {
+		if field.GetName() == functionSchema.GetOutputFieldNames()[0] {
+			base.outputFields = append(base.outputFields, field)
+		}
+		if field.GetName() == functionSchema.GetInputFieldNames()[0] {
+			inputField = field
+		}
+	}
+	if inputField == nil || len(base.outputFields) != 1 {
+		return nil, fmt.Errorf("The collection [%s]'s information is wrong, function [%s]'s inputs or outputs does not match the schema",
+			coll.GetName(), functionSchema.GetName())
+	}
+	if !isValidInputDataType(inputField.GetDataType()) {
+		return nil, fmt.Errorf("TextChunking function only supports varchar or text field as input field, but got %s", inputField.GetDataType().String())
+	}
+	if err := TextChunkingOutputsCheck(base.outputFields); err != nil {
+		return nil, err
+	}
+
+	runner := &TextChunkingFunction{
+		FunctionBase: base,
+		inputField:   inputField,
+		chunkSize:    defaultTextChunkingChunkSize,
+		strategy:     textChunkingStrategyFixed,
+	}
+	for _, param := range functionSchema.GetParams() {
+		switch strings.ToLower(param.GetKey()) {
+		case textChunkingChunkSizeKey:
+			chunkSize, err := strconv.Atoi(param.GetValue())
+			if err != nil || chunkSize <= 0 {
+				return nil, fmt.Errorf("TextChunking function param [%s] must be a positive integer, got [%s]", textChunkingChunkSizeKey, param.GetValue())
+			}
+			runner.chunkSize = chunkSize
+		case textChunkingChunkOverlapKey:
+			chunkOverlap, err := strconv.Atoi(param.GetValue())
+			if err != nil || chunkOverlap < 0 {
+				return nil, fmt.Errorf("TextChunking function param [%s] must be a non-negative integer, got [%s]", textChunkingChunkOverlapKey, param.GetValue())
+			}
+			runner.chunkOverlap = chunkOverlap
+		case textChunkingStrategyKey:
+			strategy := strings.ToLower(param.GetValue())
+			if strategy != textChunkingStrategyFixed && strategy != textChunkingStrategyPunctuation {
+				return nil, fmt.Errorf("Unsupported TextChunking function strategy [%s], list of supported [%s, %s]",
+					param.GetValue(), textChunkingStrategyFixed, textChunkingStrategyPunctuation)
+			}
+			runner.strategy = strategy
+		default:
+			return nil, fmt.Errorf("Unsupported TextChunking function param [%s]", param.GetKey())
+		}
+	}
+	if runner.chunkOverlap >= runner.chunkSize {
+		return nil, fmt.Errorf("TextChunking function param [%s] must be less than [%s], got %d and %d",
+			textChunkingChunkOverlapKey, textChunkingChunkSizeKey, runner.chunkOverlap, runner.chunkSize)
+	}
+	return runner, nil
+}
+
+func (runner *TextChunkingFunction) GetCollectionName() string {
+	return runner.collectionName
+}
+
+func (runner *TextChunkingFunction) GetFunctionProvider() string {
+	return runner.provider
+}
+
+func (runner *TextChunkingFunction) GetFunctionTypeName() string {
+	return runner.functionTypeName
+}
+
+func (runner *TextChunkingFunction) GetFunctionName() string {
+	return runner.functionName
+}
+
+// fixedChunks splits the runes into windows of chunkSize, the adjacent windows share chunkOverlap runes.
+func (runner *TextChunkingFunction) fixedChunks(runes []rune) []string {
+	chunks := make([]string, 0, len(runes)/(runner.chunkSize-runner.chunkOverlap)+1)
+	for start := 0; ; start += runner.chunkSize - runner.chunkOverlap {
+		end := start + runner.chunkSize
+		if end > len(runes) {
+			end = len(runes)
+		}
+		if chunk := strings.TrimSpace(string(runes[start:end])); chunk != "" {
+			chunks = append(chunks, chunk)
+		}
+		if end == len(runes) {
+			return chunks
+		}
+	}
+}
+
+// isTerminalPunctuation reports whether r ends a segment, only the common english and chinese punctuations
+// and the line break are recognized.
+func isTerminalPunctuation(r rune) bool {
+	switch r {
+	case '.', '!', '?', ';', '\n', '。', '！', '？', '；':
+		return true
+	}
+	return false
+}
+
+// splitSegments splits the runes after every terminal punctuation, the following spaces belong to the segment.
+func splitSegments(runes []rune) [][]rune {
+	segments := make([][]rune, 0)
+	start := 0
+	for i := 0; i < len(runes); i++ {
+		if !isTerminalPunctuation(runes[i]) {
+			continue
+		}
+		for i+1 < len(runes) && unicode.IsSpace(runes[i+1]) {
+			i++
+		}
+		segments = append(segments, runes[start:i+1])
+		start = i + 1
+	}
+	if start < len(runes) {
+		segments = append(segments, runes[start:])
+	}
+	return segments
+}
+
+// punctuationChunks packs the segments into chunks of at most chunkSize runes, a chunk starts with the
+// trailing segments of the previous chunk that fit in chunkOverlap runes.
+// The segments longer than chunkSize are split by fixedChunks.
+func (runner *TextChunkingFunction) punctuationChunks(runes []rune) []string {
+	chunks := make([]string, 0)
+	current := make([][]rune, 0)
+	currentLen := 0
+	flush := func() {
+		var sb strings.Builder
+		for _, segment := range current {
+			sb.WriteString(string(segment))
+		}
+		if chunk := strings.TrimSpace(sb.String()); chunk != "" {
+			chunks = append(chunks, chunk)
+		}
+		// keep the overlapped segments for the next chunk
+		overlapLen := 0
+		keep := len(current)
+		for keep > 0 && overlapLen+len(current[keep-1]) <= runner.chunkOverlap {
+			keep--
+			overlapLen += len(current[keep])
+		}
+		current = append(current[:0:0], current[keep:]...)
+		currentLen = overlapLen
+	}
+
+	for _, segment := range splitSegments(runes) {
+		if len(segment) > runner.chunkSize {
+			if currentLen > 0 {
+				flush()
+			}
+			chunks = append(chunks, runner.fixedChunks(segment)...)
+			current = current[:0]
+			currentLen = 0
+			continue
+		}
+		if currentLen+len(segment) > runner.chunkSize {
+			flush()
+			// drop the overlapped segments if there is no room for the new segment
+			for len(current) > 0 && currentLen+len(segment) > runner.chunkSize {
+				currentLen -= len(current[0])
+				current = current[1:]
+			}
+		}
+		current = append(current, segment)
+		currentLen += len(segment)
+	}
+	if len(current) > 0 {
+		flush()
+	}
+	return chunks
+}
+
+// Chunk splits every document into chunks, and returns the chunks together with the index of the document of every chunk.
+func (runner *TextChunkingFunction) Chunk(docs []string) ([]string, []int, error) {
+	chunks := make([]string, 0, len(docs))
+	parents := make([]int, 0, len(docs))
+	for i, doc := range docs {
+		if strings.TrimSpace(doc) == "" {
+			return nil, nil, errors.New("There is an empty document in the input data, TextChunking function does not support empty document")
+		}
+		runes := []rune(doc)
+		var docChunks []string
+		if runner.strategy == textChunkingStrategyPunctuation {
+			docChunks = runner.punctuationChunks(runes)
+		} else {
+			docChunks = runner.fixedChunks(runes)
+		}
+		for _, chunk := range docChunks {
+			chunks = append(chunks, chunk)
+			parents = append(parents, i)
+		}
+	}
+	return chunks, parents, nil
+}
+
+// GetDocuments returns the documents in the input field of fieldsData.
+func (runner *TextChunkingFunction) GetDocuments(fieldsData []*schemapb.FieldData) ([]string, error) {
+	for _, field := range fieldsData {
+		if field.GetFieldName() == runner.inputField.GetName() {
+			if !isValidInputDataType(field.GetType()) {
+				return nil, fmt.Errorf("TextChunking function only supports varchar or text field as input field, but got %s", field.GetType().String())
+			}
+			return field.GetScalars().GetStringData().GetData(), nil
+		}
+	}
+	return nil, fmt.Errorf("Can not find input field: [%s]", runner.inputField.GetName())
+}
+
+// Expand chunks the documents in the input field of fieldsData, and returns the expanded fields data, every chunk is
+// a row which copies the other fields of its document and takes parentIDBegin + the index of its document as the parent id.
+func (runner *TextChunkingFunction) Expand(fieldsData []*schemapb.FieldData, parentIDBegin int64) ([]*schemapb.FieldData, int, error) {
+	docs, err := runner.GetDocuments(fieldsData)
+	if err != nil {
+		return nil, 0, err
+	}
+	chunks, parents, err := runner.Chunk(docs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	outputField := runner.GetOutputFields()[0]
+	expanded := make([]*schemapb.FieldData, 0, len(fieldsData)+1)
+	for _, field := range fieldsData {
+		switch field.GetFieldName() {
+		case runner.inputField.GetName():
+			expanded = append(expanded, &schemapb.FieldData{
+				Type:      field.GetType(),
+				FieldName: field.GetFieldName(),
+				FieldId:   field.GetFieldId(),
+				IsDynamic: field.GetIsDynamic(),
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_StringData{
+							StringData: &schemapb.StringArray{Data: chunks},
+						},
+					},
+				},
+			})
+		case outputField.GetName():
+			// the output field is generated by the function
+		default:
+			expandedField, err := expandFieldData(field, parents, len(docs))
+			if err != nil {
+				return nil, 0, err
+			}
+			expanded = append(expanded, expandedField)
+		}
+	}
+
+	parentIDs := make([]int64, 0, len(parents))
+	for _, parent := range parents {
+		parentIDs = append(parentIDs, parentIDBegin+int64(parent))
+	}
+	expanded = append(expanded, &schemapb.FieldData{
+		Type:      outputField.GetDataType(),
+		FieldName: outputField.GetName(),
+		FieldId:   outputField.GetFieldID(),
+		IsDynamic: outputField.GetIsDynamic(),
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{
+					LongData: &schemapb.LongArray{Data: parentIDs},
+				},
+			},
+		},
+	})
+	return expanded, len(chunks), nil
+}
+
+// expandFieldData copies the row rows[i] of the field to the row i of the returned field.
+// The data of the nullable field may only contain the valid rows, the null rows are kept null and
+// the returned data only contains the valid rows, as the insert request does.
+func expandFieldData(field *schemapb.FieldData, rows []int, numDocs int) (*schemapb.FieldData, error) {
+	numData, err := funcutil.GetNumRowOfFieldData(field)
+	if err != nil {
+		return nil, err
+	}
+	validData := field.GetValidData()
+	if len(validData) == 0 && int(numData) != numDocs {
+		return nil, fmt.Errorf("the num_rows(%d) of field %s is not equal to the number of documents(%d)", numData, field.GetFieldName(), numDocs)
+	}
+	if len(validData) != 0 && len(validData) != numDocs {
+		return nil, fmt.Errorf("the length of valid_data(%d) of field %s is not equal to the number of documents(%d)", len(validData), field.GetFieldName(), numDocs)
+	}
+	// dataIdx maps the row to the index in the data
+	dataIdx := make([]int64, len(validData))
+	if len(validData) != 0 && int(numData) != len(validData) {
+		next := int64(0)
+		for i, valid := range validData {
+			dataIdx[i] = next
+			if valid {
+				next++
+			}
+		}
+		if int(next) != int(numData) {
+			return nil, fmt.Errorf("the num_rows(%d) of field %s is not equal to the number of valid rows(%d)", numData, field.GetFieldName(), next)
+		}
+	} else {
+		for i := range validData {
+			dataIdx[i] = int64(i)
+		}
+	}
+
+	// the valid data is filled separately
+	src := []*schemapb.FieldData{{
+		Type:      field.GetType(),
+		FieldName: field.GetFieldName(),
+		FieldId:   field.GetFieldId(),
+		IsDynamic: field.GetIsDynamic(),
+		Field:     field.GetField(),
+	}}
+	dst := make([]*schemapb.FieldData, 1)
+	expandedValidData := make([]bool, 0)
+	for _, row := range rows {
+		if len(validData) == 0 {
+			typeutil.AppendFieldData(dst, src, int64(row))
+			continue
+		}
+		expandedValidData = append(expandedValidData, validData[row])
+		if validData[row] {
+			typeutil.AppendFieldData(dst, src, dataIdx[row])
+		}
+	}
+	if dst[0] == nil {
+		// all the rows are null
+		dst[0] = src[0]
+	}
+	if len(validData) != 0 {
+		dst[0].ValidData = expandedValidData
+	}
+	return dst[0], nil
+}
@@ -0,0 +1,331 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestTextChunkingFunction(t *testing.T) {
+	suite.Run(t, new(TextChunkingFunctionSuite))
+}
+
+type TextChunkingFunctionSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+type mockIDAllocator struct {
+	next int64
+	err  error
+}
+
+func (alloc *mockIDAllocator) Alloc(count uint32) (int64, int64, error) {
+	if alloc.err != nil {
+		return 0, 0, alloc.err
+	}
+	begin := alloc.next
+	alloc.next += int64(count)
+	return begin, alloc.next, nil
+}
+
+func (s *TextChunkingFunctionSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, AutoID: true},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "parent", DataType: schemapb.DataType_Int64, IsFunctionOutput: true},
+			{FieldID: 103, Name: "tag", DataType: schemapb.DataType_Int64, Nullable: true},
+		},
+		Functions: []*schemapb.FunctionSchema{
+			{
+				Name:             "chunking",
+				Type:             schemapb.FunctionType_TextChunking,
+				InputFieldIds:    []int64{101},
+				InputFieldNames:  []string{"text"},
+				OutputFieldIds:   []int64{102},
+				OutputFieldNames: []string{"parent"},
+				Params: []*commonpb.KeyValuePair{
+					{Key: "chunk_size", Value: "10"},
+					{Key: "chunk_overlap", Value: "2"},
+				},
+			},
+		},
+	}
+}
+
+func (s *TextChunkingFunctionSuite) createMsg(texts []string, tags []int64, validData []bool) *msgstream.InsertMsg {
+	return &msgstream.InsertMsg{
+		InsertRequest: &msgpb.InsertRequest{
+			NumRows: uint64(len(texts)),
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_VarChar,
+					FieldName: "text",
+					FieldId:   101,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: texts}},
+						},
+					},
+				},
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "tag",
+					FieldId:   103,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: tags}},
+						},
+					},
+					ValidData: validData,
+				},
+			},
+		},
+	}
+}
+
+func (s *TextChunkingFunctionSuite) TestFunctionType() {
+	s.Equal("TextChunking", schemapb.FunctionType_TextChunking.String())
+	s.Equal(int32(schemapb.FunctionType_TextChunking), schemapb.FunctionType_value["TextChunking"])
+
+	s.True(HasNonBM25Functions(s.schema.Functions, []int64{}))
+	s.Equal(s.schema.Functions[0], GetTextChunkingFunction(s.schema.Functions))
+	s.Nil(GetTextChunkingFunction(nil))
+	runner, err := NewFunctionRunner(s.schema, s.schema.Functions[0])
+	s.NoError(err)
+	s.Nil(runner)
+	s.NoError(ValidateFunctions(s.schema))
+}
+
+func (s *TextChunkingFunctionSuite) TestNewTextChunkingFunction() {
+	{
+		f, err := NewTextChunkingFunction(s.schema, s.schema.Functions[0])
+		s.NoError(err)
+		s.Equal(10, f.chunkSize)
+		s.Equal(2, f.chunkOverlap)
+		s.Equal(textChunkingStrategyFixed, f.strategy)
+		s.Equal("TextChunking", f.GetFunctionTypeName())
+		s.Equal("chunking", f.GetFunctionName())
+		s.Equal("test", f.GetCollectionName())
+		s.Equal(textChunkingProvider, f.GetFunctionProvider())
+	}
+	for _, params := range [][]*commonpb.KeyValuePair{
+		{{Key: "chunk_size", Value: "0"}},
+		{{Key: "chunk_overlap", Value: "-1"}},
+		{{Key: "chunk_size", Value: "4"}, {Key: "chunk_overlap", Value: "4"}},
+		{{Key: "strategy", Value: "paragraph"}},
+		{{Key: "strategy", Value: "sentence"}},
+		{{Key: "unknown", Value: "1"}},
+	} {
+		fSchema := *s.schema.Functions[0]
+		fSchema.Params = params
+		_, err := NewTextChunkingFunction(s.schema, &fSchema)
+		s.Error(err)
+	}
+	{
+		schema := &schemapb.CollectionSchema{
+			Name: "test",
+			Fields: []*schemapb.FieldSchema{
+				s.schema.Fields[0], s.schema.Fields[1],
+				{FieldID: 102, Name: "parent", DataType: schemapb.DataType_VarChar},
+			},
+		}
+		_, err := NewTextChunkingFunction(schema, s.schema.Functions[0])
+		s.Error(err)
+		s.Error(ValidateFunctions(&schemapb.CollectionSchema{Fields: schema.Fields, Functions: s.schema.Functions}))
+	}
+}
+
+func (s *TextChunkingFunctionSuite) TestChunk() {
+	fSchema := *s.schema.Functions[0]
+	fSchema.Params = []*commonpb.KeyValuePair{{Key: "chunk_size", Value: "4"}, {Key: "chunk_overlap", Value: "1"}}
+	f, err := NewTextChunkingFunction(s.schema, &fSchema)
+	s.NoError(err)
+	chunks, parents, err := f.Chunk([]string{"abcdefghij", "xy", "你好世界再见"})
+	s.NoError(err)
+	s.Equal([]string{"abcd", "defg", "ghij", "xy", "你好世界", "界再见"}, chunks)
+	s.Equal([]int{0, 0, 0, 1, 2, 2}, parents)
+
+	_, _, err = f.Chunk([]string{"abc", " "})
+	s.Error(err)
+
+	fSchema.Params = []*commonpb.KeyValuePair{
+		{Key: "chunk_size", Value: "20"},
+		{Key: "chunk_overlap", Value: "10"},
+		{Key: "strategy", Value: "punctuation"},
+	}
+	f, err = NewTextChunkingFunction(s.schema, &fSchema)
+	s.NoError(err)
+	chunks, parents, err = f.Chunk([]string{
+		"One two. Three four! Five six? Seven.",
+		"A sentence that is much longer than twenty. Short.",
+	})
+	s.NoError(err)
+	// the segment longer than chunk_size is split by the fixed size
+	s.Equal([]string{
+		"One two.", "Three four!", "Five six? Seven.",
+		"A sentence that is m", "that is much longer", "uch longer than twen", "than twenty.", "Short.",
+	}, chunks)
+	s.Equal([]int{0, 0, 0, 1, 1, 1, 1, 1}, parents)
+
+	// the overlapped segments start the next chunk
+	chunks, _, err = f.Chunk([]string{"Aaaa. Bbbbbbb. Cccccccc. Dd."})
+	s.NoError(err)
+	s.Equal([]string{"Aaaa. Bbbbbbb.", "Bbbbbbb. Cccccccc.", "Cccccccc. Dd."}, chunks)
+}
+
+func (s *TextChunkingFunctionSuite) TestProcessInsert() {
+	exec, err := NewFunctionExecutor(s.schema)
+	s.NoError(err)
+
+	// the allocator is required
+	s.Error(exec.ProcessInsert(context.Background(), s.createMsg([]string{"abc"}, []int64{1}, nil)))
+
+	exec.SetIDAllocator(&mockIDAllocator{err: errors.New("mock")})
+	s.Error(exec.ProcessInsert(context.Background(), s.createMsg([]string{"abc"}, []int64{1}, nil)))
+
+	{
+		// no id is allocated if the documents do not match the num rows
+		alloc := &mockIDAllocator{next: 1000}
+		exec.SetIDAllocator(alloc)
+		msg := s.createMsg([]string{"abc", "def"}, []int64{1, 2}, nil)
+		msg.NumRows = 3
+		err := exec.ProcessInsert(context.Background(), msg)
+		s.True(errors.Is(err, merr.ErrParameterInvalid))
+		s.Equal(int64(1000), alloc.next)
+		s.Empty(exec.GetParentIDs())
+	}
+
+	exec.SetIDAllocator(&mockIDAllocator{next: 1000})
+	{
+		msg := s.createMsg([]string{"abcdefghijklmnop", "short"}, []int64{1, 2}, nil)
+		s.NoError(exec.ProcessInsert(context.Background(), msg))
+		s.Equal(uint64(3), msg.NumRows)
+		s.Len(msg.FieldsData, 3)
+		s.Equal([]string{"abcdefghij", "ijklmnop", "short"}, msg.FieldsData[0].GetScalars().GetStringData().GetData())
+		s.Equal([]int64{1, 1, 2}, msg.FieldsData[1].GetScalars().GetLongData().GetData())
+		s.Equal(int64(102), msg.FieldsData[2].GetFieldId())
+		s.Equal([]int64{1000, 1000, 1001}, msg.FieldsData[2].GetScalars().GetLongData().GetData())
+		s.Equal([]int64{1000, 1001}, exec.GetParentIDs())
+	}
+	{
+		// the size of the expanded msg is checked
+		exec.SetMaxInsertSize(s.createMsg([]string{"abcdefghijklmnop"}, []int64{1}, nil).Size())
+		err := exec.ProcessInsert(context.Background(), s.createMsg([]string{"abcdefghijklmnop"}, []int64{1}, nil))
+		s.True(errors.Is(err, merr.ErrParameterTooLarge))
+		exec.SetMaxInsertSize(-1)
+	}
+	{
+		// the data of the nullable field only contains the valid rows
+		msg := s.createMsg([]string{"short", "abcdefghijklmnop", "tail"}, []int64{3}, []bool{false, true, false})
+		s.NoError(exec.ProcessInsert(context.Background(), msg))
+		s.Equal(uint64(4), msg.NumRows)
+		s.Equal([]int64{3, 3}, msg.FieldsData[1].GetScalars().GetLongData().GetData())
+		s.Equal([]bool{false, true, true, false}, msg.FieldsData[1].GetValidData())
+		s.Equal([]int64{1002, 1003, 1003, 1004}, msg.FieldsData[2].GetScalars().GetLongData().GetData())
+	}
+	{
+		// the data of the nullable field contains all the rows
+		msg := s.createMsg([]string{"abcdefghijklmnop", "short"}, []int64{5, 0}, []bool{true, false})
+		s.NoError(exec.ProcessInsert(context.Background(), msg))
+		s.Equal([]int64{5, 5}, msg.FieldsData[1].GetScalars().GetLongData().GetData())
+		s.Equal([]bool{true, true, false}, msg.FieldsData[1].GetValidData())
+	}
+	{
+		// all the rows are null
+		msg := s.createMsg([]string{"abcdefghijklmnop"}, []int64{}, []bool{false})
+		s.NoError(exec.ProcessInsert(context.Background(), msg))
+		s.Empty(msg.FieldsData[1].GetScalars().GetLongData().GetData())
+		s.Equal([]bool{false, false}, msg.FieldsData[1].GetValidData())
+	}
+	{
+		// the num rows of the fields does not match
+		s.Error(exec.ProcessInsert(context.Background(), s.createMsg([]string{"abc", "def"}, []int64{1}, nil)))
+		s.Error(exec.ProcessInsert(context.Background(), s.createMsg([]string{"abc", "def"}, []int64{1, 2}, []bool{true, true, true})))
+		s.Error(exec.ProcessInsert(context.Background(), s.createMsg([]string{"abc", "def"}, []int64{1, 2, 3}, []bool{true, false})))
+	}
+}
+
+func (s *TextChunkingFunctionSuite) TestProcessInsertWithEmbedding() {
+	paramtable.Init()
+	paramtable.Get().CredentialCfg.Credential.GetFunc = func() map[string]string {
+		return map[string]string{
+			"mock.apikey": "mock",
+		}
+	}
+	ts := CreateOpenAIEmbeddingServer()
+	defer ts.Close()
+	paramtable.Get().FunctionCfg.TextEmbeddingProviders.GetFunc = func() map[string]string {
+		return map[string]string{
+			openAIProvider + "." + embeddingURLParamKey: ts.URL,
+		}
+	}
+
+	s.schema.Fields = append(s.schema.Fields, &schemapb.FieldSchema{
+		FieldID: 104, Name: "vector", DataType: schemapb.DataType_FloatVector, IsFunctionOutput: true,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}},
+	})
+	s.schema.Functions = append(s.schema.Functions, &schemapb.FunctionSchema{
+		Name:             "embedding",
+		Type:             schemapb.FunctionType_TextEmbedding,
+		InputFieldIds:    []int64{101},
+		InputFieldNames:  []string{"text"},
+		OutputFieldIds:   []int64{104},
+		OutputFieldNames: []string{"vector"},
+		Params: []*commonpb.KeyValuePair{
+			{Key: Provider, Value: openAIProvider},
+			{Key: modelNameParamKey, Value: "text-embedding-ada-002"},
+			{Key: credentialParamKey, Value: "mock"},
+			{Key: dimParamKey, Value: "4"},
+		},
+	})
+	exec, err := NewFunctionExecutor(s.schema)
+	s.NoError(err)
+	exec.SetIDAllocator(&mockIDAllocator{})
+
+	// the chunks are embedded
+	msg := s.createMsg([]string{"abcdefghijklmnop", "short"}, []int64{1, 2}, nil)
+	s.NoError(exec.ProcessInsert(context.Background(), msg))
+	s.Equal(uint64(3), msg.NumRows)
+	s.Len(msg.FieldsData, 4)
+	s.Equal(int64(104), msg.FieldsData[3].GetFieldId())
+	s.Len(msg.FieldsData[3].GetVectors().GetFloatVector().GetData(), 3*4)
+}
+
+func (s *TextChunkingFunctionSuite) TestProcessBulkInsert() {
+	exec, err := NewFunctionExecutor(s.schema)
+	s.NoError(err)
+	data, err := storage.NewInsertDataWithFunctionOutputField(s.schema)
+	s.NoError(err)
+	s.Error(exec.ProcessBulkInsert(data))
+}
@@ -47,6 +47,9 @@ const (
 	cohereProvider       string = "cohere"
 	siliconflowProvider  string = "siliconflow"
 	teiProvider          string = "tei"
+
+	openAICompatibleProvider string = "openai_compatible"
+	ollamaProvider           string = "ollama"
 )
 
 func hasEmptyString(texts []string) bool {
@@ -59,8 +62,8 @@ func hasEmptyString(texts []string) bool {
 }
 
 func TextEmbeddingOutputsCheck(fields []*schemapb.FieldSchema) error {
-	if len(fields) != 1 || (fields[0].DataType != schemapb.DataType_FloatVector && fields[0].DataType != schemapb.DataType_Int8Vector) {
-		return errors.New("TextEmbedding function output field must be a FloatVector or Int8Vector field")
+	if len(fields) != 1 || (fields[0].DataType != schemapb.DataType_FloatVector && fields[0].DataType != schemapb.DataType_Int8Vector && fields[0].DataType != schemapb.DataType_SparseFloatVector) {
+		return errors.New("TextEmbedding function output field must be a FloatVector, Int8Vector or SparseFloatVector field")
 	}
 	return nil
 }
@@ -68,7 +71,7 @@ func TextEmbeddingOutputsCheck(fields []*schemapb.FieldSchema) error {
 // Text embedding for retrieval task
 type textEmbeddingProvider interface {
 	MaxBatch() int
-	CallEmbedding(texts []string, mode TextEmbeddingMode) (any, error)
+	CallEmbedding(ctx context.Context, texts []string, mode TextEmbeddingMode) (any, error)
 	FieldDim() int64
 }
 
@@ -96,6 +99,11 @@ func NewTextEmbeddingFunction(coll *schemapb.CollectionSchema, functionSchema *s
 		return nil, err
 	}
 
+	// only the learned sparse models served by TEI produce sparse embeddings
+	if base.outputFields[0].DataType == schemapb.DataType_SparseFloatVector && base.provider != teiProvider {
+		return nil, fmt.Errorf("TextEmbedding function with SparseFloatVector output field only supports the [%s] provider, got [%s]", teiProvider, base.provider)
+	}
+
 	var embP textEmbeddingProvider
 	var newProviderErr error
 	conf := paramtable.Get().FunctionCfg.GetTextEmbeddingProviderConfig(base.provider)
@@ -119,13 +127,32 @@ func NewTextEmbeddingFunction(coll *schemapb.CollectionSchema, functionSchema *s
 		embP, newProviderErr = NewSiliconflowEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
 	case teiProvider:
 		embP, newProviderErr = NewTEIEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
+	case openAICompatibleProvider:
+		embP, newProviderErr = NewOpenAICompatibleEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
+	case ollamaProvider:
+		embP, newProviderErr = NewOllamaEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
 	default:
-		return nil, fmt.Errorf("Unsupported text embedding service provider: [%s] , list of supported [%s, %s, %s, %s, %s, %s, %s, %s, %s]", base.provider, openAIProvider, azureOpenAIProvider, aliDashScopeProvider, bedrockProvider, vertexAIProvider, voyageAIProvider, cohereProvider, siliconflowProvider, teiProvider)
+		return nil, fmt.Errorf("Unsupported text embedding service provider: [%s] , list of supported [%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s]", base.provider, openAIProvider, azureOpenAIProvider, aliDashScopeProvider, bedrockProvider, vertexAIProvider, voyageAIProvider, cohereProvider, siliconflowProvider, teiProvider, openAICompatibleProvider, ollamaProvider)
 	}
 
 	if newProviderErr != nil {
 		return nil, newProviderErr
 	}
+
+	// the cache is consulted before the guard, so the cached embeddings don't consume the rate limit
+	embP, err = newGuardedEmbeddingProvider(embP, base.provider, functionSchema.Params, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	enableCache, err := parseEnableCache(functionSchema.Params)
+	if err != nil {
+		return nil, err
+	}
+	if enableCache {
+		namespace := embeddingCacheNamespace(base.provider, base.outputFields[0], functionSchema.Params)
+		embP = newCachedEmbeddingProvider(embP, getEmbeddingCache(), namespace, base)
+	}
 	return &TextEmbeddingFunction{
 		FunctionBase: *base,
 		embProvider:  embP,
@@ -133,7 +160,7 @@ func NewTextEmbeddingFunction(coll *schemapb.CollectionSchema, functionSchema *s
 }
 
 func (runner *TextEmbeddingFunction) Check() error {
-	embds, err := runner.embProvider.CallEmbedding([]string{"check"}, InsertMode)
+	embds, err := runner.embProvider.CallEmbedding(context.Background(), []string{"check"}, InsertMode)
 	if err != nil {
 		return err
 	}
@@ -143,6 +170,9 @@ func (runner *TextEmbeddingFunction) Check() error {
 		dim = len(embds[0])
 	case [][]int8:
 		dim = len(embds[0])
+	case *schemapb.SparseFloatArray:
+		// sparse embeddings have no fixed dim
+		return nil
 	default:
 		return fmt.Errorf("Unsupport embedding type: %s", reflect.TypeOf(embds).String())
 	}
@@ -211,6 +241,15 @@ func (runner *TextEmbeddingFunction) packToFieldData(embds any) ([]*schemapb.Fie
 				Dim: runner.embProvider.FieldDim(),
 			},
 		}
+	case *schemapb.SparseFloatArray:
+		outputField.Field = &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Data: &schemapb.VectorField_SparseFloatVector{
+					SparseFloatVector: embds,
+				},
+				Dim: embds.GetDim(),
+			},
+		}
 	}
 	return []*schemapb.FieldData{&outputField}, nil
 }
@@ -238,7 +277,7 @@ func (runner *TextEmbeddingFunction) ProcessInsert(ctx context.Context, inputs [
 		return nil, fmt.Errorf("Embedding supports up to [%d] pieces of data at a time, got [%d]", runner.MaxBatch(), numRows)
 	}
 
-	embds, err := runner.embProvider.CallEmbedding(texts, InsertMode)
+	embds, err := runner.embProvider.CallEmbedding(ctx, texts, InsertMode)
 	if err != nil {
 		return nil, err
 	}
@@ -256,7 +295,7 @@ func (runner *TextEmbeddingFunction) ProcessSearch(ctx context.Context, placehol
 	if hasEmptyString(texts) {
 		return nil, errors.New("There is an empty string in the queries, TextEmbedding function does not support empty text")
 	}
-	embds, err := runner.embProvider.CallEmbedding(texts, SearchMode)
+	embds, err := runner.embProvider.CallEmbedding(ctx, texts, SearchMode)
 	if err != nil {
 		return nil, err
 	}
@@ -264,6 +303,8 @@ func (runner *TextEmbeddingFunction) ProcessSearch(ctx context.Context, placehol
 		return funcutil.Float32VectorsToPlaceholderGroup(embds.([][]float32)), nil
 	} else if runner.GetOutputFields()[0].DataType == schemapb.DataType_Int8Vector {
 		return funcutil.Int8VectorsToPlaceholderGroup(embds.([][]int8)), nil
+	} else if runner.GetOutputFields()[0].DataType == schemapb.DataType_SparseFloatVector {
+		return funcutil.SparseFloatVectorsToPlaceholderGroup(embds.(*schemapb.SparseFloatArray).GetContents()), nil
 	}
 	return nil, fmt.Errorf("Text embedding function doesn't support % vector", schemapb.DataType_name[int32(runner.GetOutputFields()[0].DataType)])
 }
@@ -287,7 +328,7 @@ func (runner *TextEmbeddingFunction) ProcessBulkInsert(inputs []storage.FieldDat
 	if hasEmptyString(texts) {
 		return nil, errors.New("There is an empty string in the input data, TextEmbedding function does not support empty text")
 	}
-	embds, err := runner.embProvider.CallEmbedding(texts, InsertMode)
+	embds, err := runner.embProvider.CallEmbedding(context.Background(), texts, InsertMode)
 	if err != nil {
 		return nil, err
 	}
@@ -319,6 +360,16 @@ func (runner *TextEmbeddingFunction) ProcessBulkInsert(inputs []storage.FieldDat
 		return map[storage.FieldID]storage.FieldData{
 			runner.outputFields[0].FieldID: field,
 		}, nil
+	case *schemapb.SparseFloatArray:
+		field := &storage.SparseFloatVectorFieldData{
+			SparseFloatArray: schemapb.SparseFloatArray{
+				Contents: embds.GetContents(),
+				Dim:      embds.GetDim(),
+			},
+		}
+		return map[storage.FieldID]storage.FieldData{
+			runner.outputFields[0].FieldID: field,
+		}, nil
 	}
 	return nil, errors.New("Unknow embedding type")
 }
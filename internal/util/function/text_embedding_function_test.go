@@ -998,3 +998,85 @@ func (s *TextEmbeddingFunctionSuite) TestProcessBulkInsertInt8() {
 		s.NoError(err)
 	}
 }
+
+func (s *TextEmbeddingFunctionSuite) TestSparseEmbedding() {
+	ts := CreateTEISparseEmbeddingServer()
+	defer ts.Close()
+
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "sparse", DataType: schemapb.DataType_SparseFloatVector},
+		},
+	}
+	functionSchema := &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             schemapb.FunctionType_TextEmbedding,
+		InputFieldNames:  []string{"text"},
+		OutputFieldNames: []string{"sparse"},
+		InputFieldIds:    []int64{101},
+		OutputFieldIds:   []int64{102},
+		Params: []*commonpb.KeyValuePair{
+			{Key: Provider, Value: teiProvider},
+			{Key: EndpointParamKey, Value: ts.URL},
+		},
+	}
+	runner, err := NewTextEmbeddingFunction(s.schema, functionSchema)
+	s.NoError(err)
+	s.NoError(runner.Check())
+
+	// insert
+	{
+		ret, err := runner.ProcessInsert(context.Background(), createData([]string{"sentence 1", "sentence 2"}))
+		s.NoError(err)
+		s.Equal(1, len(ret))
+		s.Equal(schemapb.DataType_SparseFloatVector, ret[0].Type)
+		s.Equal(2, len(ret[0].GetVectors().GetSparseFloatVector().GetContents()))
+		s.Equal(int64(101), ret[0].GetVectors().GetDim())
+	}
+
+	// search
+	{
+		f := &schemapb.FieldData{
+			Type:    schemapb.DataType_VarChar,
+			FieldId: 101,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{Data: []string{"query 1", "query 2", "query 3"}},
+					},
+				},
+			},
+		}
+		placeholderGroupBytes, err := funcutil.FieldDataToPlaceholderGroupBytes(f)
+		s.NoError(err)
+		placeholderGroup := commonpb.PlaceholderGroup{}
+		proto.Unmarshal(placeholderGroupBytes, &placeholderGroup)
+		ret, err := runner.ProcessSearch(context.Background(), &placeholderGroup)
+		s.NoError(err)
+		s.Equal(commonpb.PlaceholderType_SparseFloatVector, ret.Placeholders[0].Type)
+		s.Equal(3, len(ret.Placeholders[0].Values))
+	}
+
+	// bulk insert
+	{
+		data, err := testutil.CreateInsertData(s.schema, 10)
+		s.NoError(err)
+		ret, err := runner.ProcessBulkInsert([]storage.FieldData{data.Data[101]})
+		s.NoError(err)
+		s.Equal(10, ret[102].RowNum())
+	}
+
+	// only tei supports sparse output
+	{
+		functionSchema.Params = []*commonpb.KeyValuePair{
+			{Key: Provider, Value: openAIProvider},
+			{Key: modelNameParamKey, Value: TestModel},
+			{Key: credentialParamKey, Value: "mock"},
+		}
+		_, err := NewTextEmbeddingFunction(s.schema, functionSchema)
+		s.Error(err)
+	}
+}
@@ -19,6 +19,7 @@
 package function
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -218,7 +219,7 @@ func (provider *VertexAIEmbeddingProvider) getTaskType(mode TextEmbeddingMode) s
 	return ""
 }
 
-func (provider *VertexAIEmbeddingProvider) CallEmbedding(texts []string, mode TextEmbeddingMode) (any, error) {
+func (provider *VertexAIEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, mode TextEmbeddingMode) (any, error) {
 	numRows := len(texts)
 	taskType := provider.getTaskType(mode)
 	data := make([][]float32, 0, numRows)
@@ -227,7 +228,7 @@ func (provider *VertexAIEmbeddingProvider) CallEmbedding(texts []string, mode Te
 		if end > numRows {
 			end = numRows
 		}
-		resp, err := provider.client.Embedding(provider.modelName, texts[i:end], provider.embedDimParam, taskType, provider.timeoutSec)
+		resp, err := provider.client.Embedding(ctx, provider.modelName, texts[i:end], provider.embedDimParam, taskType, provider.timeoutSec)
 		if err != nil {
 			return nil, err
 		}
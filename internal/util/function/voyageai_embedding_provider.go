@@ -19,6 +19,7 @@
 package function
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -45,7 +46,7 @@ type VoyageAIEmbeddingProvider struct {
 
 func createVoyageAIEmbeddingClient(apiKey string, url string) (*voyageai.VoyageAIEmbedding, error) {
 	if apiKey == "" {
-		return nil, fmt.Errorf("Missing credentials config or configure the %s environment variable in the Milvus service.", voyageAIAKEnvStr)
+		return nil, fmt.Errorf("Missing credentials config or configure the %s environment variable in the Milvus service.", VoyageAIAKEnvStr)
 	}
 
 	if url == "" {
@@ -61,7 +62,7 @@ func NewVoyageAIEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSch
 	if err != nil {
 		return nil, err
 	}
-	apiKey, url, err := parseAKAndURL(credentials, functionSchema.Params, params, voyageAIAKEnvStr)
+	apiKey, url, err := parseAKAndURL(credentials, functionSchema.Params, params, VoyageAIAKEnvStr)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +127,7 @@ func (provider *VoyageAIEmbeddingProvider) FieldDim() int64 {
 	return provider.fieldDim
 }
 
-func (provider *VoyageAIEmbeddingProvider) CallEmbedding(texts []string, mode TextEmbeddingMode) (any, error) {
+func (provider *VoyageAIEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, mode TextEmbeddingMode) (any, error) {
 	numRows := len(texts)
 	var textType string
 	if mode == InsertMode {
@@ -141,7 +142,7 @@ func (provider *VoyageAIEmbeddingProvider) CallEmbedding(texts []string, mode Te
 		if end > numRows {
 			end = numRows
 		}
-		r, err := provider.client.Embedding(provider.modelName, texts[i:end], int(provider.embedDimParam), textType, provider.outputType, provider.truncate, provider.timeoutSec)
+		r, err := provider.client.Embedding(ctx, provider.modelName, texts[i:end], int(provider.embedDimParam), textType, provider.outputType, provider.truncate, provider.timeoutSec)
 		if err != nil {
 			return nil, err
 		}
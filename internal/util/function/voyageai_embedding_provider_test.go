@@ -19,6 +19,7 @@
 package function
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -93,7 +94,7 @@ func (s *VoyageAITextEmbeddingProviderSuite) TestEmbedding() {
 		s.NoError(err)
 		{
 			data := []string{"sentence"}
-			r, err2 := provider.CallEmbedding(data, InsertMode)
+			r, err2 := provider.CallEmbedding(context.Background(), data, InsertMode)
 			ret := r.([][]float32)
 			s.NoError(err2)
 			s.Equal(1, len(ret))
@@ -101,7 +102,7 @@ func (s *VoyageAITextEmbeddingProviderSuite) TestEmbedding() {
 		}
 		{
 			data := []string{"sentence 1", "sentence 2", "sentence 3"}
-			_, err := provider.CallEmbedding(data, SearchMode)
+			_, err := provider.CallEmbedding(context.Background(), data, SearchMode)
 			s.NoError(err)
 		}
 	}
@@ -123,7 +124,7 @@ func (s *VoyageAITextEmbeddingProviderSuite) TestEmbeddingIn8() {
 		s.Equal("int8", provider.(*VoyageAIEmbeddingProvider).outputType)
 		{
 			data := []string{"sentence"}
-			r, err2 := provider.CallEmbedding(data, InsertMode)
+			r, err2 := provider.CallEmbedding(context.Background(), data, InsertMode)
 			ret := r.([][]int8)
 			s.NoError(err2)
 			s.Equal(1, len(ret))
@@ -131,7 +132,7 @@ func (s *VoyageAITextEmbeddingProviderSuite) TestEmbeddingIn8() {
 		}
 		{
 			data := []string{"sentence 1", "sentence 2", "sentence 3"}
-			_, err := provider.CallEmbedding(data, SearchMode)
+			_, err := provider.CallEmbedding(context.Background(), data, SearchMode)
 			s.NoError(err)
 		}
 	}
@@ -167,7 +168,7 @@ func (s *VoyageAITextEmbeddingProviderSuite) TestEmbeddingDimNotMatch() {
 
 			// embedding dim not match
 			data := []string{"sentence", "sentence"}
-			_, err2 := provider.CallEmbedding(data, InsertMode)
+			_, err2 := provider.CallEmbedding(context.Background(), data, InsertMode)
 			s.Error(err2)
 		}
 	}
@@ -208,7 +209,7 @@ func (s *VoyageAITextEmbeddingProviderSuite) TestEmbeddingDimNotMatch() {
 
 		// embedding dim not match
 		data := []string{"sentence", "sentence"}
-		_, err2 := provider.CallEmbedding(data, InsertMode)
+		_, err2 := provider.CallEmbedding(context.Background(), data, InsertMode)
 		s.Error(err2)
 	}
 }
@@ -238,7 +239,7 @@ func (s *VoyageAITextEmbeddingProviderSuite) TestEmbeddingNumberNotMatch() {
 
 			// embedding dim not match
 			data := []string{"sentence", "sentence2"}
-			_, err2 := provider.CallEmbedding(data, InsertMode)
+			_, err2 := provider.CallEmbedding(context.Background(), data, InsertMode)
 			s.Error(err2)
 		}
 	}
@@ -273,7 +274,7 @@ func (s *VoyageAITextEmbeddingProviderSuite) TestEmbeddingNumberNotMatch() {
 
 		// embedding dim not match
 		data := []string{"sentence", "sentence2"}
-		_, err2 := provider.CallEmbedding(data, InsertMode)
+		_, err2 := provider.CallEmbedding(context.Background(), data, InsertMode)
 		s.Error(err2)
 	}
 }
@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reduce
+
+import (
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+)
+
+// Coverage describes how much sealed data is served by a request in serve partial mode.
+type Coverage struct {
+	LoadedRows int64
+	TotalRows  int64
+}
+
+// Add accumulates the coverage of another shard.
+func (c Coverage) Add(other Coverage) Coverage {
+	return Coverage{
+		LoadedRows: c.LoadedRows + other.LoadedRows,
+		TotalRows:  c.TotalRows + other.TotalRows,
+	}
+}
+
+// IsServePartial returns whether the request accepts to be served by the loaded segments only.
+func IsServePartial(base *commonpb.MsgBase) bool {
+	v, ok := base.GetProperties()[common.ServePartialKey]
+	if !ok {
+		return false
+	}
+	servePartial, _ := strconv.ParseBool(v)
+	return servePartial
+}
+
+// SetServePartial marks the request to be served by the loaded segments only.
+func SetServePartial(base *commonpb.MsgBase) {
+	if base.Properties == nil {
+		base.Properties = make(map[string]string)
+	}
+	base.Properties[common.ServePartialKey] = strconv.FormatBool(true)
+}
+
+// GetCoverage parses the coverage from the extra info of status.
+func GetCoverage(status *commonpb.Status) (Coverage, bool) {
+	loaded, ok1 := status.GetExtraInfo()[common.LoadedRowsKey]
+	total, ok2 := status.GetExtraInfo()[common.TotalRowsKey]
+	if !ok1 || !ok2 {
+		return Coverage{}, false
+	}
+	loadedRows, err := strconv.ParseInt(loaded, 10, 64)
+	if err != nil {
+		return Coverage{}, false
+	}
+	totalRows, err := strconv.ParseInt(total, 10, 64)
+	if err != nil {
+		return Coverage{}, false
+	}
+	return Coverage{LoadedRows: loadedRows, TotalRows: totalRows}, true
+}
+
+// SetCoverage puts the coverage into the extra info of status, status must not be nil.
+func SetCoverage(status *commonpb.Status, coverage Coverage) {
+	if status.ExtraInfo == nil {
+		status.ExtraInfo = make(map[string]string)
+	}
+	status.ExtraInfo[common.LoadedRowsKey] = strconv.FormatInt(coverage.LoadedRows, 10)
+	status.ExtraInfo[common.TotalRowsKey] = strconv.FormatInt(coverage.TotalRows, 10)
+}
+
+// MergeCoverage sums up the coverage of all shard results, returns false if none of them carries coverage.
+func MergeCoverage[R interface{ GetStatus() *commonpb.Status }](results ...R) (Coverage, bool) {
+	var merged Coverage
+	found := false
+	for _, result := range results {
+		if coverage, ok := GetCoverage(result.GetStatus()); ok {
+			merged = merged.Add(coverage)
+			found = true
+		}
+	}
+	return merged, found
+}
@@ -0,0 +1,68 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reduce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+)
+
+func TestServePartial(t *testing.T) {
+	base := &commonpb.MsgBase{}
+	assert.False(t, IsServePartial(base))
+	assert.False(t, IsServePartial(nil))
+
+	SetServePartial(base)
+	assert.True(t, IsServePartial(base))
+
+	base.Properties[common.ServePartialKey] = "invalid"
+	assert.False(t, IsServePartial(base))
+}
+
+func TestCoverage(t *testing.T) {
+	_, ok := GetCoverage(&commonpb.Status{})
+	assert.False(t, ok)
+
+	status := &commonpb.Status{}
+	SetCoverage(status, Coverage{LoadedRows: 10, TotalRows: 100})
+	coverage, ok := GetCoverage(status)
+	assert.True(t, ok)
+	assert.Equal(t, Coverage{LoadedRows: 10, TotalRows: 100}, coverage)
+
+	status.ExtraInfo[common.LoadedRowsKey] = "invalid"
+	_, ok = GetCoverage(status)
+	assert.False(t, ok)
+
+	results := []*internalpb.SearchResults{
+		{Status: &commonpb.Status{}},
+		{Status: &commonpb.Status{}},
+		{Status: &commonpb.Status{}},
+	}
+	_, ok = MergeCoverage(results...)
+	assert.False(t, ok)
+
+	SetCoverage(results[0].Status, Coverage{LoadedRows: 10, TotalRows: 100})
+	SetCoverage(results[2].Status, Coverage{LoadedRows: 50, TotalRows: 50})
+	coverage, ok = MergeCoverage(results...)
+	assert.True(t, ok)
+	assert.Equal(t, Coverage{LoadedRows: 60, TotalRows: 150}, coverage)
+}
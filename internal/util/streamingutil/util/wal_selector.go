@@ -13,6 +13,7 @@ const (
 	WALTypeKafka      = "kafka"
 	WALTypePulsar     = "pulsar"
 	WALTypeWoodpecker = "woodpecker"
+	WALTypeNats       = "nats"
 )
 
 type walEnable struct {
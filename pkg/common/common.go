@@ -186,6 +186,14 @@ const (
 
 	PartitionDiskQuotaKey = "partition.diskProtection.diskQuota.mb"
 
+	// segment seal properties, override the global segment seal configs of streaming node.
+	CollectionSegmentMaxSizeKey     = "collection.segment.maxSize.mb"
+	CollectionSegmentMaxIdleTimeKey = "collection.segment.maxIdleTime.seconds"
+	CollectionSegmentMaxLifetimeKey = "collection.segment.maxLifetime.seconds"
+	// CollectionSegmentSealPolicyKey selects the seal policy of collection,
+	// the only supported value is SegmentSealPolicyPartition now.
+	CollectionSegmentSealPolicyKey = "collection.segment.sealPolicy"
+
 	// database level properties
 	DatabaseReplicaNumber       = "database.replica.number"
 	DatabaseResourceGroups      = "database.resource_groups"
@@ -219,6 +227,25 @@ const (
 	IndexNonEncoding           = "index.nonEncoding"
 )
 
+// partial serving
+const (
+	// ServePartialKey is the request property to serve a search/query with the loaded segments only
+	// when the shard is not fully loaded.
+	ServePartialKey = "serve_partial"
+	// LoadedRowsKey and TotalRowsKey are carried in the extra info of the response status to describe
+	// how much sealed data was covered by a partial served request.
+	LoadedRowsKey = "loaded_rows"
+	TotalRowsKey  = "total_rows"
+)
+
+// SegmentSealPolicyPartition seals all growing segments of a partition together
+// once any of them should be sealed.
+const SegmentSealPolicyPartition = "partition"
+
+// FollowerNodesKey is carried in the extra info of the GetShardLeaders response status,
+// lists the shard leaders belonging to read-only follower replicas, separated by comma.
+const FollowerNodesKey = "follower_nodes"
+
 const (
 	PropertiesKey string = "properties"
 	TraceIDKey    string = "uber-trace-id"
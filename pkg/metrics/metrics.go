@@ -131,6 +131,7 @@ const (
 	// model function/UDF labels
 	functionTypeName = "function_type_name"
 	functionProvider = "function_provider"
+	credentialName   = "credential_name"
 	functionName     = "function_name"
 
 	// entities label
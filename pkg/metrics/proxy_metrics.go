@@ -454,6 +454,24 @@ var (
 			Help:      "latency of function call",
 			Buckets:   buckets,
 		}, []string{nodeIDLabelName, collectionName, functionTypeName, functionProvider, functionName})
+
+	// ProxyFunctionEmbeddingCacheCounter records the number of texts whose embeddings hit or miss the embedding cache
+	ProxyFunctionEmbeddingCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "function_embedding_cache_count",
+			Help:      "the number of texts whose embeddings hit or miss the embedding cache",
+		}, []string{nodeIDLabelName, collectionName, functionProvider, functionName, cacheStateLabelName})
+
+	// ProxyFunctionCircuitBreakerState records the circuit breaker state of the embedding providers, 0 closed, 1 open, 2 half open
+	ProxyFunctionCircuitBreakerState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "function_circuit_breaker_state",
+			Help:      "circuit breaker state of the embedding provider, 0 closed, 1 open, 2 half open",
+		}, []string{nodeIDLabelName, functionProvider, credentialName})
 )
 
 // RegisterProxy registers Proxy metrics
@@ -522,6 +540,8 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(ProxyParseExpressionLatency)
 
 	registry.MustRegister(ProxyFunctionlatency)
+	registry.MustRegister(ProxyFunctionEmbeddingCacheCounter)
+	registry.MustRegister(ProxyFunctionCircuitBreakerState)
 
 	RegisterStreamingServiceClient(registry)
 }
@@ -582,6 +602,10 @@ func CleanupProxyCollectionMetrics(nodeID int64, collection string) {
 		nodeIDLabelName: strconv.FormatInt(nodeID, 10),
 		collectionName:  collection,
 	})
+	ProxyFunctionEmbeddingCacheCounter.DeletePartialMatch(prometheus.Labels{
+		nodeIDLabelName: strconv.FormatInt(nodeID, 10),
+		collectionName:  collection,
+	})
 
 	ProxyCollectionSQLatency.Delete(prometheus.Labels{
 		nodeIDLabelName:    strconv.FormatInt(nodeID, 10),
@@ -33,6 +33,7 @@ const (
 	WALRecoveryStorageStateLabelName  = "state"
 	WALStateLabelName                 = "state"
 	WALChannelLabelName               = channelNameLabelName
+	WALVChannelLabelName              = "vchannel"
 	WALSegmentSealPolicyNameLabelName = "policy"
 	WALMessageTypeLabelName           = "message_type"
 	WALChannelTermLabelName           = "term"
@@ -458,6 +459,21 @@ var (
 		Name: "truncate_time_tick",
 		Help: "the final timetick tick of truncator seen",
 	}, WALChannelLabelName, WALChannelTermLabelName)
+
+	WALRetentionBacklogBytes = newWALGaugeVec(prometheus.GaugeOpts{
+		Name: "retention_backlog_bytes",
+		Help: "the estimated bytes of wal retained after the last truncate point",
+	}, WALChannelLabelName, WALChannelTermLabelName)
+
+	WALVChannelRetentionBacklogBytes = newWALGaugeVec(prometheus.GaugeOpts{
+		Name: "vchannel_retention_backlog_bytes",
+		Help: "the estimated bytes of vchannel retained after the last truncate point",
+	}, WALChannelLabelName, WALChannelTermLabelName, WALVChannelLabelName)
+
+	WALVChannelCheckpointLagSeconds = newWALGaugeVec(prometheus.GaugeOpts{
+		Name: "vchannel_checkpoint_lag_seconds",
+		Help: "the lag between the latest timetick of wal and the flush checkpoint of vchannel",
+	}, WALChannelLabelName, WALChannelTermLabelName, WALVChannelLabelName)
 )
 
 // RegisterStreamingServiceClient registers streaming service client metrics
@@ -558,6 +574,9 @@ func registerWAL(registry *prometheus.Registry) {
 	registry.MustRegister(WALRecoveryInconsistentEventTotal)
 	registry.MustRegister(WALRecoveryIsOnPersisting)
 	registry.MustRegister(WALTruncateTimeTick)
+	registry.MustRegister(WALRetentionBacklogBytes)
+	registry.MustRegister(WALVChannelRetentionBacklogBytes)
+	registry.MustRegister(WALVChannelCheckpointLagSeconds)
 }
 
 func newStreamingCoordGaugeVec(opts prometheus.GaugeOpts, extra ...string) *prometheus.GaugeVec {
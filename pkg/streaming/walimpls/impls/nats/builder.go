@@ -0,0 +1,57 @@
+package nats
+
+import (
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	walName = "nats"
+)
+
+func init() {
+	// register the builder to the wal registry.
+	registry.RegisterBuilder(&builderImpl{})
+	// register the unmarshaler to the message registry.
+	message.RegisterMessageIDUnmsarshaler(walName, UnmarshalMessageID)
+}
+
+// builderImpl is the builder for nats jetstream wal.
+type builderImpl struct{}
+
+// Name returns the name of the wal.
+func (b *builderImpl) Name() string {
+	return walName
+}
+
+// Build build a wal instance.
+func (b *builderImpl) Build() (walimpls.OpenerImpls, error) {
+	config := &paramtable.Get().NatsCfg
+	options := []nats.Option{
+		nats.Name("milvus-streaming"),
+		// the wal should never give up the connection.
+		nats.MaxReconnects(-1),
+	}
+	if config.Username.GetValue() != "" {
+		options = append(options, nats.UserInfo(config.Username.GetValue(), config.Password.GetValue()))
+	}
+	nc, err := nats.Connect(config.ServerURL.GetValue(), options...)
+	if err != nil {
+		return nil, err
+	}
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, err
+	}
+	return &openerImpl{
+		nc:       nc,
+		js:       js,
+		replicas: config.Replicas.GetAsInt(),
+	}, nil
+}
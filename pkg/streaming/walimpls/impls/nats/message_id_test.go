@@ -0,0 +1,27 @@
+package nats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageID(t *testing.T) {
+	assert.Equal(t, walName, natsID(1).WALName())
+
+	assert.True(t, natsID(1).LT(natsID(2)))
+	assert.True(t, natsID(1).EQ(natsID(1)))
+	assert.True(t, natsID(1).LTE(natsID(1)))
+	assert.True(t, natsID(1).LTE(natsID(2)))
+	assert.False(t, natsID(2).LT(natsID(1)))
+	assert.False(t, natsID(2).EQ(natsID(1)))
+	assert.False(t, natsID(2).LTE(natsID(1)))
+	assert.True(t, natsID(2).LTE(natsID(2)))
+
+	msgID, err := UnmarshalMessageID(natsID(1).Marshal())
+	assert.NoError(t, err)
+	assert.Equal(t, natsID(1), msgID)
+
+	_, err = UnmarshalMessageID(string([]byte{0x01, 0x02, 0x03, 0x04}))
+	assert.Error(t, err)
+}
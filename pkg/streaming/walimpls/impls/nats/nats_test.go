@@ -0,0 +1,86 @@
+package nats
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	tmpPath, err := os.MkdirTemp("", "nats_test")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmpPath)
+
+	// start an embedded nats server with jetstream, so the test needs no external service.
+	s, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  tmpPath,
+		NoSigs:    true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	go s.Start()
+	if !s.ReadyForConnections(10 * time.Second) {
+		panic("embedded nats server is not ready")
+	}
+	defer s.Shutdown()
+	paramtable.Get().Save(paramtable.Get().NatsCfg.ServerURL.Key, s.ClientURL())
+	m.Run()
+}
+
+func TestRegistry(t *testing.T) {
+	registeredB := registry.MustGetBuilder(walName)
+	assert.NotNil(t, registeredB)
+	assert.Equal(t, walName, registeredB.Name())
+
+	id, err := message.UnmarshalMessageID(walName, natsID(123).Marshal())
+	assert.NoError(t, err)
+	assert.True(t, id.EQ(natsID(123)))
+}
+
+func TestWAL(t *testing.T) {
+	walimpls.NewWALImplsTestFramework(t, 1000, &builderImpl{}).Run()
+}
+
+func TestReadOnlyOpen(t *testing.T) {
+	ctx := context.Background()
+	opener, err := (&builderImpl{}).Build()
+	assert.NoError(t, err)
+	defer opener.Close()
+
+	// the read-only wal doesn't create the stream.
+	_, err = opener.Open(ctx, &walimpls.OpenOption{
+		Channel: types.PChannelInfo{Name: "test_read_only", Term: 1, AccessMode: types.AccessModeRO},
+	})
+	assert.ErrorIs(t, err, jetstream.ErrStreamNotFound)
+
+	rw, err := opener.Open(ctx, &walimpls.OpenOption{
+		Channel: types.PChannelInfo{Name: "test_read_only", Term: 1, AccessMode: types.AccessModeRW},
+	})
+	assert.NoError(t, err)
+	defer rw.Close()
+
+	ro, err := opener.Open(ctx, &walimpls.OpenOption{
+		Channel: types.PChannelInfo{Name: "test_read_only", Term: 1, AccessMode: types.AccessModeRO},
+	})
+	assert.NoError(t, err)
+	defer ro.Close()
+	assert.Equal(t, types.AccessModeRO, ro.Channel().AccessMode)
+}
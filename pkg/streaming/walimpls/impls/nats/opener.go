@@ -0,0 +1,57 @@
+package nats
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/helper"
+)
+
+var _ walimpls.OpenerImpls = (*openerImpl)(nil)
+
+// openerImpl is the opener implementation for nats jetstream wal.
+type openerImpl struct {
+	nc       *nats.Conn
+	js       jetstream.JetStream
+	replicas int
+}
+
+// Open opens a wal on the jetstream stream of the pchannel.
+// The stream is created if not exist when the wal is opened in read-write mode,
+// the read-only wal only looks up the stream, so it never changes the stream created by the writer.
+func (o *openerImpl) Open(ctx context.Context, opt *walimpls.OpenOption) (walimpls.WALImpls, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+	var stream jetstream.Stream
+	var err error
+	if opt.Channel.AccessMode == types.AccessModeRW {
+		// every pchannel is mapped into a stream with only one subject of the same name.
+		stream, err = o.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+			Name:      opt.Channel.Name,
+			Subjects:  []string{opt.Channel.Name},
+			Retention: jetstream.LimitsPolicy,
+			Storage:   jetstream.FileStorage,
+			Replicas:  o.replicas,
+		})
+	} else {
+		stream, err = o.js.Stream(ctx, opt.Channel.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &walImpl{
+		WALHelper: helper.NewWALHelper(opt),
+		js:        o.js,
+		stream:    stream,
+	}, nil
+}
+
+// Close closes the opener resources.
+func (o *openerImpl) Close() {
+	o.nc.Close()
+}
@@ -0,0 +1,83 @@
+package nats
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/helper"
+)
+
+var _ walimpls.ScannerImpls = (*scannerImpl)(nil)
+
+// newScanner creates a new scanner.
+func newScanner(scannerName string, iter jetstream.MessagesContext) *scannerImpl {
+	s := &scannerImpl{
+		ScannerHelper: helper.NewScannerHelper(scannerName),
+		iter:          iter,
+		msgChannel:    make(chan message.ImmutableMessage, 1),
+	}
+	go s.executeConsume()
+	return s
+}
+
+// scannerImpl is the implementation of ScannerImpls for nats.
+type scannerImpl struct {
+	*helper.ScannerHelper
+	iter       jetstream.MessagesContext
+	msgChannel chan message.ImmutableMessage
+}
+
+// Chan returns the channel of message.
+func (s *scannerImpl) Chan() <-chan message.ImmutableMessage {
+	return s.msgChannel
+}
+
+// Close the scanner, release the underlying resources.
+// Return the error same with `Error`
+func (s *scannerImpl) Close() error {
+	// stop the iterator first to wake up the blocking Next.
+	s.iter.Stop()
+	return s.ScannerHelper.Close()
+}
+
+func (s *scannerImpl) executeConsume() {
+	defer close(s.msgChannel)
+	for {
+		msg, err := s.iter.Next()
+		if err != nil {
+			if s.Context().Err() != nil || errors.Is(err, jetstream.ErrMsgIteratorClosed) {
+				// iterator stopped, means the the scanner is closed.
+				s.Finish(nil)
+				return
+			}
+			s.Finish(err)
+			return
+		}
+		metadata, err := msg.Metadata()
+		if err != nil {
+			s.Finish(err)
+			return
+		}
+
+		properties := make(map[string]string, len(msg.Headers()))
+		for key, values := range msg.Headers() {
+			if len(values) > 0 {
+				properties[key] = values[0]
+			}
+		}
+
+		newImmutableMessage := message.NewImmutableMesasge(
+			natsID(metadata.Sequence.Stream),
+			msg.Data(),
+			properties,
+		)
+		select {
+		case <-s.Context().Done():
+			s.Finish(nil)
+			return
+		case s.msgChannel <- newImmutableMessage:
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package nats
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/helper"
+)
+
+var _ walimpls.WALImpls = (*walImpl)(nil)
+
+type walImpl struct {
+	*helper.WALHelper
+	js     jetstream.JetStream
+	stream jetstream.Stream
+}
+
+func (w *walImpl) WALName() string {
+	return walName
+}
+
+func (w *walImpl) Append(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+	if w.Channel().AccessMode != types.AccessModeRW {
+		panic("write on a wal that is not in read-write mode")
+	}
+
+	properties := msg.Properties().ToRawMap()
+	header := make(nats.Header, len(properties))
+	for key, value := range properties {
+		// assign directly to keep the case of the property key.
+		header[key] = []string{value}
+	}
+	ack, err := w.js.PublishMsg(ctx, &nats.Msg{
+		Subject: w.Channel().Name,
+		Data:    msg.Payload(),
+		Header:  header,
+	})
+	if err != nil {
+		w.Log().RatedWarn(1, "publish message to nats failed", zap.Error(err))
+		return nil, err
+	}
+	return natsID(ack.Sequence), nil
+}
+
+func (w *walImpl) Read(ctx context.Context, opt walimpls.ReadOption) (s walimpls.ScannerImpls, err error) {
+	// The scanner is stateless, so an ordered consumer which is ephemeral and never acked is used.
+	consumerConfig := jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{w.Channel().Name},
+	}
+	switch t := opt.DeliverPolicy.GetPolicy().(type) {
+	case *streamingpb.DeliverPolicy_All:
+		consumerConfig.DeliverPolicy = jetstream.DeliverAllPolicy
+	case *streamingpb.DeliverPolicy_Latest:
+		consumerConfig.DeliverPolicy = jetstream.DeliverNewPolicy
+	case *streamingpb.DeliverPolicy_StartFrom:
+		id, err := unmarshalMessageID(t.StartFrom.GetId())
+		if err != nil {
+			return nil, err
+		}
+		consumerConfig.DeliverPolicy = jetstream.DeliverByStartSequencePolicy
+		consumerConfig.OptStartSeq = uint64(id)
+	case *streamingpb.DeliverPolicy_StartAfter:
+		id, err := unmarshalMessageID(t.StartAfter.GetId())
+		if err != nil {
+			return nil, err
+		}
+		// the stream sequence is continuous, so StartAfter is just a seek to the next sequence.
+		consumerConfig.DeliverPolicy = jetstream.DeliverByStartSequencePolicy
+		consumerConfig.OptStartSeq = uint64(id) + 1
+	default:
+		panic("unknown deliver policy")
+	}
+
+	consumer, err := w.stream.OrderedConsumer(ctx, consumerConfig)
+	if err != nil {
+		return nil, err
+	}
+	var messagesOpts []jetstream.PullMessagesOpt
+	if opt.ReadAheadBufferSize > 0 {
+		messagesOpts = append(messagesOpts, jetstream.PullMaxMessages(opt.ReadAheadBufferSize))
+	}
+	iter, err := consumer.Messages(messagesOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return newScanner(opt.Name, iter), nil
+}
+
+// Truncate purges the messages before the given id from the stream, the message of id itself is kept.
+func (w *walImpl) Truncate(ctx context.Context, id message.MessageID) error {
+	if w.Channel().AccessMode != types.AccessModeRW {
+		panic("truncate on a wal that is not in read-write mode")
+	}
+	return w.stream.Purge(ctx, jetstream.WithPurgeSequence(uint64(id.(natsID))))
+}
+
+func (w *walImpl) Close() {
+	// The nats connection is shared by all wals, so it's closed by the opener.
+}
@@ -70,6 +70,8 @@ const (
 
 	HeaderUserAgent = "user-agent"
 	HeaderDBName    = "dbName"
+	// HeaderTransactionID binds the dml requests to a user transaction.
+	HeaderTransactionID = "transactionID"
 
 	RoleConfigPrivileges = "privileges"
 	RoleConfigObjectType = "object_type"
@@ -25,6 +25,16 @@ func SparseVectorDataToPlaceholderGroupBytes(contents [][]byte) []byte {
 	return bytes
 }
 
+func SparseFloatVectorsToPlaceholderGroup(contents [][]byte) *commonpb.PlaceholderGroup {
+	return &commonpb.PlaceholderGroup{
+		Placeholders: []*commonpb.PlaceholderValue{{
+			Tag:    "$0",
+			Type:   commonpb.PlaceholderType_SparseFloatVector,
+			Values: contents,
+		}},
+	}
+}
+
 func Float32VectorsToPlaceholderGroup(embs [][]float32) *commonpb.PlaceholderGroup {
 	result := make([][]byte, 0, len(embs))
 	for _, floatVector := range embs {
@@ -58,6 +68,17 @@ func Int8VectorsToPlaceholderGroup(embs [][]int8) *commonpb.PlaceholderGroup {
 	return placeholderGroup
 }
 
+func BinaryVectorsToPlaceholderGroup(embs [][]byte) *commonpb.PlaceholderGroup {
+	placeholderGroup := &commonpb.PlaceholderGroup{
+		Placeholders: []*commonpb.PlaceholderValue{{
+			Tag:    "$0",
+			Type:   commonpb.PlaceholderType_BinaryVector,
+			Values: embs,
+		}},
+	}
+	return placeholderGroup
+}
+
 func FieldDataToPlaceholderGroupBytes(fieldData *schemapb.FieldData) ([]byte, error) {
 	placeholderValue, err := fieldDataToPlaceholderValue(fieldData)
 	if err != nil {
@@ -82,6 +82,9 @@ const (
 	// SyncTaskKey request for get sync tasks from the datanode
 	SyncTaskKey = "sync_tasks"
 
+	// BalancePlanKey request for simulating a balance round on the querycoord
+	BalancePlanKey = "qc_balance_plan"
+
 	// MetricRequestParamVerboseKey as a request parameter decide to whether return verbose value
 	MetricRequestParamVerboseKey = "verbose"
 
@@ -89,6 +92,8 @@ const (
 
 	MetricRequestParamCollectionIDKey = "collection_id"
 
+	MetricRequestParamBalancerKey = "balancer"
+
 	MetricRequestParamINKey  = "in"
 	MetricsRequestParamsInDC = "dc"
 	MetricsRequestParamsInQC = "qc"
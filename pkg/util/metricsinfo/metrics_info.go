@@ -174,6 +174,7 @@ type QueryCoordTarget struct {
 
 type QueryCoordTask struct {
 	TaskName     string   `json:"task_name,omitempty"`
+	DatabaseID   int64    `json:"database_id,omitempty,string"`
 	CollectionID int64    `json:"collection_id,omitempty,string"`
 	Replica      int64    `json:"replica_id,omitempty,string"`
 	TaskType     string   `json:"task_type,omitempty"`
@@ -203,6 +204,55 @@ type QueryCoordDist struct {
 	LeaderViews []*LeaderView `json:"leader_views,omitempty"`
 }
 
+// BalanceSegmentPlan is a segment move generated by a simulated balance round.
+type BalanceSegmentPlan struct {
+	SegmentID    int64  `json:"segment_id,omitempty,string"`
+	CollectionID int64  `json:"collection_id,omitempty,string"`
+	ReplicaID    int64  `json:"replica_id,omitempty,string"`
+	Channel      string `json:"channel,omitempty"`
+	NumOfRows    int64  `json:"num_of_rows,omitempty,string"`
+	MemSize      int64  `json:"mem_size,omitempty,string"`
+	From         int64  `json:"from"`
+	To           int64  `json:"to"`
+	FromScore    int64  `json:"from_score"`
+	ToScore      int64  `json:"to_score"`
+	SegmentScore int64  `json:"segment_score"`
+}
+
+// BalanceChannelPlan is a channel move generated by a simulated balance round.
+type BalanceChannelPlan struct {
+	CollectionID int64  `json:"collection_id,omitempty,string"`
+	ReplicaID    int64  `json:"replica_id,omitempty,string"`
+	ChannelName  string `json:"channel_name,omitempty"`
+	From         int64  `json:"from"`
+	To           int64  `json:"to"`
+	FromScore    int64  `json:"from_score"`
+	ToScore      int64  `json:"to_score"`
+	ChannelScore int64  `json:"channel_score"`
+}
+
+// BalanceNodeDist is the distribution of one querynode before and after applying the balance plans.
+type BalanceNodeDist struct {
+	NodeID            int64 `json:"node_id"`
+	SegmentCount      int   `json:"segment_count"`
+	ChannelCount      int   `json:"channel_count"`
+	RowCount          int64 `json:"row_count,string"`
+	MemSize           int64 `json:"mem_size,string"`
+	SegmentCountAfter int   `json:"segment_count_after"`
+	ChannelCountAfter int   `json:"channel_count_after"`
+	RowCountAfter     int64 `json:"row_count_after,string"`
+	MemSizeAfter      int64 `json:"mem_size_after,string"`
+	ScoreDelta        int64 `json:"score_delta"`
+}
+
+// BalancePlan is the result of a balance round which is simulated without submitting any task.
+type BalancePlan struct {
+	Balancer     string                `json:"balancer,omitempty"`
+	SegmentPlans []*BalanceSegmentPlan `json:"segment_plans,omitempty"`
+	ChannelPlans []*BalanceChannelPlan `json:"channel_plans,omitempty"`
+	Nodes        []*BalanceNodeDist    `json:"nodes,omitempty"`
+}
+
 type ResourceGroup struct {
 	Name  string                    `json:"name,omitempty"`
 	Nodes []int64                   `json:"nodes,omitempty"`
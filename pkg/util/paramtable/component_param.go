@@ -1588,6 +1588,13 @@ type proxyConfig struct {
 	SlowQuerySpanInSeconds ParamItem `refreshable:"true"`
 	SlowLogSpanInSeconds   ParamItem `refreshable:"true"`
 	QueryNodePoolingSize   ParamItem `refreshable:"false"`
+
+	TransactionTimeout       ParamItem `refreshable:"true"`
+	TransactionMaxBufferSize ParamItem `refreshable:"true"`
+	TransactionSweepInterval ParamItem `refreshable:"false"`
+
+	IngestionEnabled      ParamItem `refreshable:"false"`
+	IngestionSyncInterval ParamItem `refreshable:"false"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -2078,6 +2085,51 @@ Disabled if the value is less or equal to 0.`,
 		Export:       true,
 	}
 	p.QueryNodePoolingSize.Init(base.mgr)
+
+	p.TransactionTimeout = ParamItem{
+		Key:          "proxy.transaction.timeout",
+		Version:      "2.6.0",
+		DefaultValue: "60",
+		Doc:          "seconds, the max lifetime of a user transaction, the transaction which is not committed in time is rolled back",
+		Export:       true,
+	}
+	p.TransactionTimeout.Init(base.mgr)
+
+	p.TransactionMaxBufferSize = ParamItem{
+		Key:          "proxy.transaction.maxBufferSize",
+		Version:      "2.6.0",
+		DefaultValue: "67108864",
+		Doc:          "bytes, the max size of the mutations buffered by a user transaction before it's committed",
+		Export:       true,
+	}
+	p.TransactionMaxBufferSize.Init(base.mgr)
+
+	p.TransactionSweepInterval = ParamItem{
+		Key:          "proxy.transaction.sweepInterval",
+		Version:      "2.6.0",
+		DefaultValue: "5s",
+		Doc:          "the interval to roll back the expired user transactions and to complete the interrupted commits",
+		Export:       true,
+	}
+	p.TransactionSweepInterval.Init(base.mgr)
+
+	p.IngestionEnabled = ParamItem{
+		Key:          "proxy.ingestion.enabled",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc:          "whether to run the kafka ingestion connectors on proxy, which requires the streaming service, the connectors are managed by the management api of proxy",
+		Export:       true,
+	}
+	p.IngestionEnabled.Init(base.mgr)
+
+	p.IngestionSyncInterval = ParamItem{
+		Key:          "proxy.ingestion.syncInterval",
+		Version:      "2.6.0",
+		DefaultValue: "10s",
+		Doc:          "the interval to sync the kafka ingestion connectors created or dropped by other proxies",
+		Export:       true,
+	}
+	p.IngestionSyncInterval.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -2171,6 +2223,15 @@ type queryCoordConfig struct {
 
 	// query node task parallelism factor
 	QueryNodeTaskParallelismFactor ParamItem `refreshable:"true"`
+
+	// load admission control
+	LoadAdmissionEnabled     ParamItem `refreshable:"true"`
+	LoadAdmissionWaitTimeout ParamItem `refreshable:"true"`
+
+	MaxConcurrentLoadJobs   ParamItem `refreshable:"true"`
+	DBMaxConcurrentLoadJobs ParamItem `refreshable:"true"`
+
+	FollowerResourceGroups ParamItem `refreshable:"true"`
 }
 
 func (p *queryCoordConfig) init(base *BaseTable) {
@@ -2803,6 +2864,55 @@ If this parameter is set false, Milvus simply searches the growing segments with
 		Export:       false,
 	}
 	p.QueryNodeTaskParallelismFactor.Init(base.mgr)
+
+	p.LoadAdmissionEnabled = ParamItem{
+		Key:          "queryCoord.loadAdmission.enabled",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc: `whether to estimate the memory and disk required by a load request before accepting it,
+the request will be rejected if the free capacity of the target resource groups is not enough`,
+		Export: true,
+	}
+	p.LoadAdmissionEnabled.Init(base.mgr)
+
+	p.LoadAdmissionWaitTimeout = ParamItem{
+		Key:          "queryCoord.loadAdmission.waitTimeout",
+		Version:      "2.6.0",
+		DefaultValue: "0",
+		Doc:          "seconds, the max duration that a load request waits in queue for enough free capacity before it's rejected, 0 means reject immediately",
+		Export:       true,
+	}
+	p.LoadAdmissionWaitTimeout.Init(base.mgr)
+
+	p.MaxConcurrentLoadJobs = ParamItem{
+		Key:          "queryCoord.jobScheduler.maxConcurrentLoadJobs",
+		Version:      "2.6.0",
+		DefaultValue: "0",
+		Doc: `the max number of load jobs executed concurrently in the cluster, 0 means no limit,
+pending jobs are scheduled by load priority first, so a high priority load could jump ahead of the low priority ones`,
+		Export: true,
+	}
+	p.MaxConcurrentLoadJobs.Init(base.mgr)
+
+	p.DBMaxConcurrentLoadJobs = ParamItem{
+		Key:          "queryCoord.jobScheduler.dbMaxConcurrentLoadJobs",
+		Version:      "2.6.0",
+		DefaultValue: "0",
+		Doc:          "the max number of load jobs executed concurrently within one database, 0 means no limit",
+		Export:       true,
+	}
+	p.DBMaxConcurrentLoadJobs.Init(base.mgr)
+
+	p.FollowerResourceGroups = ParamItem{
+		Key:          "queryCoord.followerResourceGroups",
+		Version:      "2.6.0",
+		DefaultValue: "",
+		Doc: `the resource groups whose replicas are read-only followers, separated by comma.
+follower replicas are loaded the same as the other replicas and serve the growing segments as well, they only differ in routing:
+proxy selects them only for the requests with Eventually or Bounded consistency`,
+		Export: true,
+	}
+	p.FollowerResourceGroups.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -5745,6 +5855,7 @@ type streamingConfig struct {
 
 	WALTruncateSampleInterval    ParamItem `refreshable:"true"`
 	WALTruncateRetentionInterval ParamItem `refreshable:"true"`
+	WALTruncateMinRetentionSize  ParamItem `refreshable:"true"`
 }
 
 func (p *streamingConfig) init(base *BaseTable) {
@@ -6032,6 +6143,19 @@ because the wal truncate operation is implemented by pulsar consumer.`,
 		Export:       true,
 	}
 	p.WALTruncateRetentionInterval.Init(base.mgr)
+
+	p.WALTruncateMinRetentionSize = ParamItem{
+		Key:     "streaming.walTruncate.minRetentionSize",
+		Version: "2.6.0",
+		Doc: `The minimum retained size of wal after truncate, 0 by default (disabled).
+The sampled checkpoint will not be used to truncate wal if the retained wal data after it is less than this size,
+even if the checkpoint is older than the retentionInterval.
+It's used to keep enough history for the lagging consumers such as cdc and replicate stream.
+The size is estimated by the messages observed by the current streaming node.`,
+		DefaultValue: "0",
+		Export:       true,
+	}
+	p.WALTruncateMinRetentionSize.Init(base.mgr)
 }
 
 // runtimeConfig is just a private environment value table.
@@ -236,6 +236,9 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 72, Params.MaxPasswordLength.GetAsInt())
 		params.Save("proxy.maxPasswordLength", "-10")
 		assert.Equal(t, 72, Params.MaxPasswordLength.GetAsInt())
+
+		assert.False(t, Params.IngestionEnabled.GetAsBool())
+		assert.Equal(t, 10*time.Second, Params.IngestionSyncInterval.GetAsDurationByParse())
 	})
 
 	// t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -669,6 +672,7 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, float64(0.1), params.StreamingCfg.FlushGrowingSegmentBytesLwmThreshold.GetAsFloat())
 		assert.Equal(t, 30*time.Minute, params.StreamingCfg.WALTruncateSampleInterval.GetAsDurationByParse())
 		assert.Equal(t, 72*time.Hour, params.StreamingCfg.WALTruncateRetentionInterval.GetAsDurationByParse())
+		assert.Equal(t, int64(0), params.StreamingCfg.WALTruncateMinRetentionSize.GetAsSize())
 
 		params.Save(params.StreamingCfg.WALBalancerTriggerInterval.Key, "50s")
 		params.Save(params.StreamingCfg.WALBalancerBackoffInitialInterval.Key, "50s")
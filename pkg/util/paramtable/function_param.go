@@ -22,7 +22,19 @@ import (
 
 type functionConfig struct {
 	TextEmbeddingProviders ParamGroup `refreshable:"true"`
-	RerankModelProviders   ParamGroup `refreshable:"true"`
+
+	EmbeddingCacheEnabled       ParamItem `refreshable:"true"`
+	EmbeddingCacheCapacity      ParamItem `refreshable:"false"`
+	EmbeddingCacheSpillDir      ParamItem `refreshable:"false"`
+	EmbeddingCacheSpillCapacity ParamItem `refreshable:"false"`
+
+	CircuitBreakerFailureThreshold ParamItem `refreshable:"true"`
+	CircuitBreakerOpenTimeout      ParamItem `refreshable:"true"`
+	RateLimitMaxWait               ParamItem `refreshable:"true"`
+
+	RerankModelProviders ParamGroup `refreshable:"true"`
+
+	MultimodalEmbeddingProviders ParamGroup `refreshable:"true"`
 }
 
 func (p *functionConfig) init(base *BaseTable) {
@@ -68,6 +80,14 @@ func (p *functionConfig) init(base *BaseTable) {
 				return "Your VertexAI embedding url"
 			case "vertexai.credential":
 				return "The name in the crendential configuration item"
+			case "openai_compatible.credential":
+				return "The name in the crendential configuration item, optional for the self-hosted service"
+			case "openai_compatible.url":
+				return "The base url of your OpenAI compatible embedding service, such as vLLM, LocalAI and LM Studio, e.g. http://localhost:8000/v1"
+			case "ollama.credential":
+				return "The name in the crendential configuration item, optional for the self-hosted service"
+			case "ollama.url":
+				return "The base url of your Ollama server, default is http://localhost:11434"
 			default:
 				return ""
 			}
@@ -75,6 +95,69 @@ func (p *functionConfig) init(base *BaseTable) {
 	}
 	p.TextEmbeddingProviders.Init(base.mgr)
 
+	p.EmbeddingCacheEnabled = ParamItem{
+		Key:          "function.textEmbedding.cache.enable",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc:          "Whether to cache the embeddings of the text embedding functions by default, the function param enable_cache overrides it",
+		Export:       true,
+	}
+	p.EmbeddingCacheEnabled.Init(base.mgr)
+
+	p.EmbeddingCacheCapacity = ParamItem{
+		Key:          "function.textEmbedding.cache.capacity",
+		Version:      "2.6.0",
+		DefaultValue: "100000",
+		Doc:          "The max number of embeddings kept in memory, shared by all the text embedding functions of the node",
+		Export:       true,
+	}
+	p.EmbeddingCacheCapacity.Init(base.mgr)
+
+	p.EmbeddingCacheSpillDir = ParamItem{
+		Key:          "function.textEmbedding.cache.spillDir",
+		Version:      "2.6.0",
+		DefaultValue: "",
+		Doc:          "The local directory the embeddings evicted from memory are spilled to, empty means disk spill is disabled. Every process spills to its own sub directory, the sub directories of the exited processes are removed at startup",
+		Export:       true,
+	}
+	p.EmbeddingCacheSpillDir.Init(base.mgr)
+
+	p.EmbeddingCacheSpillCapacity = ParamItem{
+		Key:          "function.textEmbedding.cache.spillCapacity",
+		Version:      "2.6.0",
+		DefaultValue: "1000000",
+		Doc:          "The max number of embeddings kept in the spill directory",
+		Export:       true,
+	}
+	p.EmbeddingCacheSpillCapacity.Init(base.mgr)
+
+	p.CircuitBreakerFailureThreshold = ParamItem{
+		Key:          "function.textEmbedding.circuitBreaker.failureThreshold",
+		Version:      "2.6.0",
+		DefaultValue: "5",
+		Doc:          "The number of consecutive failed calls to an embedding provider that opens its circuit breaker, the calls fail fast while the breaker is open",
+		Export:       true,
+	}
+	p.CircuitBreakerFailureThreshold.Init(base.mgr)
+
+	p.CircuitBreakerOpenTimeout = ParamItem{
+		Key:          "function.textEmbedding.circuitBreaker.openTimeout",
+		Version:      "2.6.0",
+		DefaultValue: "30",
+		Doc:          "The seconds an open circuit breaker waits before letting a probe call through",
+		Export:       true,
+	}
+	p.CircuitBreakerOpenTimeout.Init(base.mgr)
+
+	p.RateLimitMaxWait = ParamItem{
+		Key:          "function.textEmbedding.rateLimit.maxWait",
+		Version:      "2.6.0",
+		DefaultValue: "30",
+		Doc:          "The max seconds an http request to the provider waits for the rate limiter, the retries take tokens as well, the rate is set by function.textEmbedding.providers.<provider>.max_rps",
+		Export:       true,
+	}
+	p.RateLimitMaxWait.Init(base.mgr)
+
 	p.RerankModelProviders = ParamGroup{
 		KeyPrefix: "function.rerank.model.providers.",
 		Version:   "2.6.0",
@@ -85,12 +168,45 @@ func (p *functionConfig) init(base *BaseTable) {
 				return "Whether to enable TEI rerank service"
 			case "vllm.enable":
 				return "Whether to enable vllm rerank service"
+			case "cohere.credential":
+				return "The name in the crendential configuration item"
+			case "cohere.url":
+				return "Your cohere rerank url, Default is the official rerank url"
+			case "voyageai.credential":
+				return "The name in the crendential configuration item"
+			case "voyageai.url":
+				return "Your voyageai rerank url, Default is the official rerank url"
+			case "jina.credential":
+				return "The name in the crendential configuration item"
+			case "jina.url":
+				return "Your jina rerank url, Default is the official rerank url"
 			default:
 				return ""
 			}
 		},
 	}
 	p.RerankModelProviders.Init(base.mgr)
+
+	p.MultimodalEmbeddingProviders = ParamGroup{
+		KeyPrefix: "function.multimodalEmbedding.providers.",
+		Version:   "2.6.0",
+		Export:    true,
+		DocFunc: func(key string) string {
+			switch key {
+			case "clip.credential":
+				return "The name in the crendential configuration item, optional for the self-hosted service"
+			case "clip.url":
+				return "The url of your CLIP server, e.g. http://localhost:51000/post"
+			case "vertexai.credential":
+				return "The name in the crendential configuration item"
+			case "vertexai.url":
+				return "Your VertexAI multimodal embedding url"
+			default:
+				return ""
+			}
+		},
+	}
+	p.MultimodalEmbeddingProviders.Init(base.mgr)
 }
 
 const (
@@ -124,3 +240,17 @@ func (p *functionConfig) GetRerankModelProviders(providerName string) map[string
 	}
 	return matchedParam
 }
+
+func (p *functionConfig) GetMultimodalEmbeddingProviderConfig(providerName string) map[string]string {
+	matchedParam := make(map[string]string)
+
+	params := p.MultimodalEmbeddingProviders.GetValue()
+	prefix := providerName + "."
+
+	for k, v := range params {
+		if strings.HasPrefix(k, prefix) {
+			matchedParam[strings.TrimPrefix(k, prefix)] = v
+		}
+	}
+	return matchedParam
+}
@@ -18,6 +18,7 @@ package paramtable
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -56,4 +57,17 @@ func TestFunctionConfig(t *testing.T) {
 		assert.True(t, cfg.TextEmbeddingProviders.GetDoc(key) != "")
 	}
 	assert.True(t, cfg.TextEmbeddingProviders.GetDoc("Unknow") == "")
+
+	assert.False(t, cfg.EmbeddingCacheEnabled.GetAsBool())
+	assert.Equal(t, 100000, cfg.EmbeddingCacheCapacity.GetAsInt())
+	assert.Equal(t, "", cfg.EmbeddingCacheSpillDir.GetValue())
+	assert.Equal(t, 1000000, cfg.EmbeddingCacheSpillCapacity.GetAsInt())
+	assert.Equal(t, 5, cfg.CircuitBreakerFailureThreshold.GetAsInt())
+	assert.Equal(t, 30*time.Second, cfg.CircuitBreakerOpenTimeout.GetAsDuration(time.Second))
+	assert.Equal(t, 30*time.Second, cfg.RateLimitMaxWait.GetAsDuration(time.Second))
+
+	for _, key := range []string{"clip.credential", "clip.url", "vertexai.credential", "vertexai.url"} {
+		assert.True(t, cfg.MultimodalEmbeddingProviders.GetDoc(key) != "")
+	}
+	assert.Equal(t, "", cfg.GetMultimodalEmbeddingProviderConfig("clip")["url"])
 }
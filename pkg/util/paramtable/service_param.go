@@ -49,6 +49,7 @@ type ServiceParam struct {
 	WoodpeckerCfg   WoodpeckerConfig
 	PulsarCfg       PulsarConfig
 	KafkaCfg        KafkaConfig
+	NatsCfg         NatsConfig
 	RocksmqCfg      RocksmqConfig
 	MinioCfg        MinioConfig
 	ProfileCfg      ProfileConfig
@@ -63,6 +64,7 @@ func (p *ServiceParam) init(bt *BaseTable) {
 	p.WoodpeckerCfg.Init(bt)
 	p.PulsarCfg.Init(bt)
 	p.KafkaCfg.Init(bt)
+	p.NatsCfg.Init(bt)
 	p.RocksmqCfg.Init(bt)
 	p.MinioCfg.Init(bt)
 	p.ProfileCfg.Init(bt)
@@ -557,7 +559,7 @@ func (p *MQConfig) Init(base *BaseTable) {
 		Version:      "2.3.0",
 		DefaultValue: "default",
 		Doc: `Default value: "default"
-Valid values: [default, pulsar, kafka, rocksmq, woodpecker]`,
+Valid values: [default, pulsar, kafka, rocksmq, woodpecker, nats]`,
 		Export: true,
 	}
 	p.Type.Init(base.mgr)
@@ -1149,6 +1151,51 @@ func (k *KafkaConfig) Init(base *BaseTable) {
 	k.ReadTimeout.Init(base.mgr)
 }
 
+// /////////////////////////////////////////////////////////////////////////////
+// --- nats ---
+type NatsConfig struct {
+	ServerURL ParamItem `refreshable:"false"`
+	Username  ParamItem `refreshable:"false"`
+	Password  ParamItem `refreshable:"false"`
+	Replicas  ParamItem `refreshable:"false"`
+}
+
+func (n *NatsConfig) Init(base *BaseTable) {
+	n.ServerURL = ParamItem{
+		Key:          "nats.serverURL",
+		DefaultValue: "nats://localhost:4222",
+		Version:      "2.6.0",
+		Doc:          "URL of the NATS server with JetStream enabled, multiple URLs are separated by comma",
+		Export:       true,
+	}
+	n.ServerURL.Init(base.mgr)
+
+	n.Username = ParamItem{
+		Key:          "nats.username",
+		DefaultValue: "",
+		Version:      "2.6.0",
+		Export:       true,
+	}
+	n.Username.Init(base.mgr)
+
+	n.Password = ParamItem{
+		Key:          "nats.password",
+		DefaultValue: "",
+		Version:      "2.6.0",
+		Export:       true,
+	}
+	n.Password.Init(base.mgr)
+
+	n.Replicas = ParamItem{
+		Key:          "nats.replicas",
+		DefaultValue: "1",
+		Version:      "2.6.0",
+		Doc:          "replica number of the JetStream stream of every pchannel",
+		Export:       true,
+	}
+	n.Replicas.Init(base.mgr)
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 // --- rocksmq ---
 type RocksmqConfig struct {
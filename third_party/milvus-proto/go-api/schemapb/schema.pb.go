@@ -0,0 +1,3819 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.21.4
+// source: schema.proto
+
+package schemapb
+
+import (
+	commonpb "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// *
+// @brief Field data type
+type DataType int32
+
+const (
+	DataType_None              DataType = 0
+	DataType_Bool              DataType = 1
+	DataType_Int8              DataType = 2
+	DataType_Int16             DataType = 3
+	DataType_Int32             DataType = 4
+	DataType_Int64             DataType = 5
+	DataType_Float             DataType = 10
+	DataType_Double            DataType = 11
+	DataType_String            DataType = 20
+	DataType_VarChar           DataType = 21 // variable-length strings with a specified maximum length
+	DataType_Array             DataType = 22
+	DataType_JSON              DataType = 23
+	DataType_Geometry          DataType = 24
+	DataType_Text              DataType = 25
+	DataType_BinaryVector      DataType = 100
+	DataType_FloatVector       DataType = 101
+	DataType_Float16Vector     DataType = 102
+	DataType_BFloat16Vector    DataType = 103
+	DataType_SparseFloatVector DataType = 104
+	DataType_Int8Vector        DataType = 105
+	DataType_ArrayOfVector     DataType = 106
+	DataType_ArrayOfStruct     DataType = 200
+)
+
+// Enum value maps for DataType.
+var (
+	DataType_name = map[int32]string{
+		0:   "None",
+		1:   "Bool",
+		2:   "Int8",
+		3:   "Int16",
+		4:   "Int32",
+		5:   "Int64",
+		10:  "Float",
+		11:  "Double",
+		20:  "String",
+		21:  "VarChar",
+		22:  "Array",
+		23:  "JSON",
+		24:  "Geometry",
+		25:  "Text",
+		100: "BinaryVector",
+		101: "FloatVector",
+		102: "Float16Vector",
+		103: "BFloat16Vector",
+		104: "SparseFloatVector",
+		105: "Int8Vector",
+		106: "ArrayOfVector",
+		200: "ArrayOfStruct",
+	}
+	DataType_value = map[string]int32{
+		"None":              0,
+		"Bool":              1,
+		"Int8":              2,
+		"Int16":             3,
+		"Int32":             4,
+		"Int64":             5,
+		"Float":             10,
+		"Double":            11,
+		"String":            20,
+		"VarChar":           21,
+		"Array":             22,
+		"JSON":              23,
+		"Geometry":          24,
+		"Text":              25,
+		"BinaryVector":      100,
+		"FloatVector":       101,
+		"Float16Vector":     102,
+		"BFloat16Vector":    103,
+		"SparseFloatVector": 104,
+		"Int8Vector":        105,
+		"ArrayOfVector":     106,
+		"ArrayOfStruct":     200,
+	}
+)
+
+func (x DataType) Enum() *DataType {
+	p := new(DataType)
+	*p = x
+	return p
+}
+
+func (x DataType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataType) Descriptor() protoreflect.EnumDescriptor {
+	return file_schema_proto_enumTypes[0].Descriptor()
+}
+
+func (DataType) Type() protoreflect.EnumType {
+	return &file_schema_proto_enumTypes[0]
+}
+
+func (x DataType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataType.Descriptor instead.
+func (DataType) EnumDescriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{0}
+}
+
+type FunctionType int32
+
+const (
+	FunctionType_Unknown       FunctionType = 0
+	FunctionType_BM25          FunctionType = 1
+	FunctionType_TextEmbedding FunctionType = 2
+	FunctionType_Rerank        FunctionType = 3
+	// MinHash derives the binary vector signature from the text
+	FunctionType_MinHash FunctionType = 4
+	// MultimodalEmbedding embeds the text or image into a dense vector
+	FunctionType_MultimodalEmbedding FunctionType = 5
+	// TextChunking splits the text into chunks, each chunk is inserted as a row
+	FunctionType_TextChunking FunctionType = 6
+)
+
+// Enum value maps for FunctionType.
+var (
+	FunctionType_name = map[int32]string{
+		0: "Unknown",
+		1: "BM25",
+		2: "TextEmbedding",
+		3: "Rerank",
+		4: "MinHash",
+		5: "MultimodalEmbedding",
+		6: "TextChunking",
+	}
+	FunctionType_value = map[string]int32{
+		"Unknown":             0,
+		"BM25":                1,
+		"TextEmbedding":       2,
+		"Rerank":              3,
+		"MinHash":             4,
+		"MultimodalEmbedding": 5,
+		"TextChunking":        6,
+	}
+)
+
+func (x FunctionType) Enum() *FunctionType {
+	p := new(FunctionType)
+	*p = x
+	return p
+}
+
+func (x FunctionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FunctionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_schema_proto_enumTypes[1].Descriptor()
+}
+
+func (FunctionType) Type() protoreflect.EnumType {
+	return &file_schema_proto_enumTypes[1]
+}
+
+func (x FunctionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FunctionType.Descriptor instead.
+func (FunctionType) EnumDescriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{1}
+}
+
+type FieldState int32
+
+const (
+	FieldState_FieldCreated  FieldState = 0
+	FieldState_FieldCreating FieldState = 1
+	FieldState_FieldDropping FieldState = 2
+	FieldState_FieldDropped  FieldState = 3
+)
+
+// Enum value maps for FieldState.
+var (
+	FieldState_name = map[int32]string{
+		0: "FieldCreated",
+		1: "FieldCreating",
+		2: "FieldDropping",
+		3: "FieldDropped",
+	}
+	FieldState_value = map[string]int32{
+		"FieldCreated":  0,
+		"FieldCreating": 1,
+		"FieldDropping": 2,
+		"FieldDropped":  3,
+	}
+)
+
+func (x FieldState) Enum() *FieldState {
+	p := new(FieldState)
+	*p = x
+	return p
+}
+
+func (x FieldState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FieldState) Descriptor() protoreflect.EnumDescriptor {
+	return file_schema_proto_enumTypes[2].Descriptor()
+}
+
+func (FieldState) Type() protoreflect.EnumType {
+	return &file_schema_proto_enumTypes[2]
+}
+
+func (x FieldState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FieldState.Descriptor instead.
+func (FieldState) EnumDescriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{2}
+}
+
+// *
+// @brief Field schema
+type FieldSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldID      int64                    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	Name         string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsPrimaryKey bool                     `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
+	Description  string                   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	DataType     DataType                 `protobuf:"varint,5,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
+	TypeParams   []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	IndexParams  []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	AutoID       bool                     `protobuf:"varint,8,opt,name=autoID,proto3" json:"autoID,omitempty"`
+	State        FieldState               `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.schema.FieldState" json:"state,omitempty"` // To keep compatible with older version, the default
+	// state is `Created`.
+	ElementType      DataType    `protobuf:"varint,10,opt,name=element_type,json=elementType,proto3,enum=milvus.proto.schema.DataType" json:"element_type,omitempty"` // For array type, the element type is stored here
+	DefaultValue     *ValueField `protobuf:"bytes,11,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`                                 // default_value only support scalars except array and json for now
+	IsDynamic        bool        `protobuf:"varint,12,opt,name=is_dynamic,json=isDynamic,proto3" json:"is_dynamic,omitempty"`                                         // mark whether this field is the dynamic field
+	IsPartitionKey   bool        `protobuf:"varint,13,opt,name=is_partition_key,json=isPartitionKey,proto3" json:"is_partition_key,omitempty"`                        // enable logic partitions
+	IsClusteringKey  bool        `protobuf:"varint,14,opt,name=is_clustering_key,json=isClusteringKey,proto3" json:"is_clustering_key,omitempty"`
+	Nullable         bool        `protobuf:"varint,15,opt,name=nullable,proto3" json:"nullable,omitempty"` // enable set null as field value
+	IsFunctionOutput bool        `protobuf:"varint,16,opt,name=is_function_output,json=isFunctionOutput,proto3" json:"is_function_output,omitempty"`
+}
+
+func (x *FieldSchema) Reset() {
+	*x = FieldSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldSchema) ProtoMessage() {}
+
+func (x *FieldSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldSchema.ProtoReflect.Descriptor instead.
+func (*FieldSchema) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FieldSchema) GetFieldID() int64 {
+	if x != nil {
+		return x.FieldID
+	}
+	return 0
+}
+
+func (x *FieldSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FieldSchema) GetIsPrimaryKey() bool {
+	if x != nil {
+		return x.IsPrimaryKey
+	}
+	return false
+}
+
+func (x *FieldSchema) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FieldSchema) GetDataType() DataType {
+	if x != nil {
+		return x.DataType
+	}
+	return DataType_None
+}
+
+func (x *FieldSchema) GetTypeParams() []*commonpb.KeyValuePair {
+	if x != nil {
+		return x.TypeParams
+	}
+	return nil
+}
+
+func (x *FieldSchema) GetIndexParams() []*commonpb.KeyValuePair {
+	if x != nil {
+		return x.IndexParams
+	}
+	return nil
+}
+
+func (x *FieldSchema) GetAutoID() bool {
+	if x != nil {
+		return x.AutoID
+	}
+	return false
+}
+
+func (x *FieldSchema) GetState() FieldState {
+	if x != nil {
+		return x.State
+	}
+	return FieldState_FieldCreated
+}
+
+func (x *FieldSchema) GetElementType() DataType {
+	if x != nil {
+		return x.ElementType
+	}
+	return DataType_None
+}
+
+func (x *FieldSchema) GetDefaultValue() *ValueField {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return nil
+}
+
+func (x *FieldSchema) GetIsDynamic() bool {
+	if x != nil {
+		return x.IsDynamic
+	}
+	return false
+}
+
+func (x *FieldSchema) GetIsPartitionKey() bool {
+	if x != nil {
+		return x.IsPartitionKey
+	}
+	return false
+}
+
+func (x *FieldSchema) GetIsClusteringKey() bool {
+	if x != nil {
+		return x.IsClusteringKey
+	}
+	return false
+}
+
+func (x *FieldSchema) GetNullable() bool {
+	if x != nil {
+		return x.Nullable
+	}
+	return false
+}
+
+func (x *FieldSchema) GetIsFunctionOutput() bool {
+	if x != nil {
+		return x.IsFunctionOutput
+	}
+	return false
+}
+
+type FunctionSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id               int64                    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Description      string                   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Type             FunctionType             `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.schema.FunctionType" json:"type,omitempty"`
+	InputFieldNames  []string                 `protobuf:"bytes,5,rep,name=input_field_names,json=inputFieldNames,proto3" json:"input_field_names,omitempty"`
+	InputFieldIds    []int64                  `protobuf:"varint,6,rep,packed,name=input_field_ids,json=inputFieldIds,proto3" json:"input_field_ids,omitempty"`
+	OutputFieldNames []string                 `protobuf:"bytes,7,rep,name=output_field_names,json=outputFieldNames,proto3" json:"output_field_names,omitempty"`
+	OutputFieldIds   []int64                  `protobuf:"varint,8,rep,packed,name=output_field_ids,json=outputFieldIds,proto3" json:"output_field_ids,omitempty"`
+	Params           []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *FunctionSchema) Reset() {
+	*x = FunctionSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FunctionSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunctionSchema) ProtoMessage() {}
+
+func (x *FunctionSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FunctionSchema.ProtoReflect.Descriptor instead.
+func (*FunctionSchema) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FunctionSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FunctionSchema) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FunctionSchema) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FunctionSchema) GetType() FunctionType {
+	if x != nil {
+		return x.Type
+	}
+	return FunctionType_Unknown
+}
+
+func (x *FunctionSchema) GetInputFieldNames() []string {
+	if x != nil {
+		return x.InputFieldNames
+	}
+	return nil
+}
+
+func (x *FunctionSchema) GetInputFieldIds() []int64 {
+	if x != nil {
+		return x.InputFieldIds
+	}
+	return nil
+}
+
+func (x *FunctionSchema) GetOutputFieldNames() []string {
+	if x != nil {
+		return x.OutputFieldNames
+	}
+	return nil
+}
+
+func (x *FunctionSchema) GetOutputFieldIds() []int64 {
+	if x != nil {
+		return x.OutputFieldIds
+	}
+	return nil
+}
+
+func (x *FunctionSchema) GetParams() []*commonpb.KeyValuePair {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type FunctionScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Functions []*FunctionSchema `protobuf:"bytes,1,rep,name=functions,proto3" json:"functions,omitempty"` // Currently only a single function is supported, and multiple functions
+	// may be supported in the future, so repeated is used here.
+	Params []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *FunctionScore) Reset() {
+	*x = FunctionScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FunctionScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunctionScore) ProtoMessage() {}
+
+func (x *FunctionScore) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FunctionScore.ProtoReflect.Descriptor instead.
+func (*FunctionScore) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FunctionScore) GetFunctions() []*FunctionSchema {
+	if x != nil {
+		return x.Functions
+	}
+	return nil
+}
+
+func (x *FunctionScore) GetParams() []*commonpb.KeyValuePair {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// *
+// @brief Collection schema
+type CollectionSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: Marked as deprecated in schema.proto.
+	AutoID             bool                      `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"` // deprecated later, keep compatible with c++ part now
+	Fields             []*FieldSchema            `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	EnableDynamicField bool                      `protobuf:"varint,5,opt,name=enable_dynamic_field,json=enableDynamicField,proto3" json:"enable_dynamic_field,omitempty"` // mark whether this table has the dynamic field function enabled.
+	Properties         []*commonpb.KeyValuePair  `protobuf:"bytes,6,rep,name=properties,proto3" json:"properties,omitempty"`
+	Functions          []*FunctionSchema         `protobuf:"bytes,7,rep,name=functions,proto3" json:"functions,omitempty"`
+	DbName             string                    `protobuf:"bytes,8,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	StructArrayFields  []*StructArrayFieldSchema `protobuf:"bytes,9,rep,name=struct_array_fields,json=structArrayFields,proto3" json:"struct_array_fields,omitempty"`
+}
+
+func (x *CollectionSchema) Reset() {
+	*x = CollectionSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionSchema) ProtoMessage() {}
+
+func (x *CollectionSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionSchema.ProtoReflect.Descriptor instead.
+func (*CollectionSchema) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CollectionSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectionSchema) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in schema.proto.
+func (x *CollectionSchema) GetAutoID() bool {
+	if x != nil {
+		return x.AutoID
+	}
+	return false
+}
+
+func (x *CollectionSchema) GetFields() []*FieldSchema {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *CollectionSchema) GetEnableDynamicField() bool {
+	if x != nil {
+		return x.EnableDynamicField
+	}
+	return false
+}
+
+func (x *CollectionSchema) GetProperties() []*commonpb.KeyValuePair {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *CollectionSchema) GetFunctions() []*FunctionSchema {
+	if x != nil {
+		return x.Functions
+	}
+	return nil
+}
+
+func (x *CollectionSchema) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *CollectionSchema) GetStructArrayFields() []*StructArrayFieldSchema {
+	if x != nil {
+		return x.StructArrayFields
+	}
+	return nil
+}
+
+type StructArrayFieldSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldID     int64          `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	Name        string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Fields      []*FieldSchema `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *StructArrayFieldSchema) Reset() {
+	*x = StructArrayFieldSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StructArrayFieldSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StructArrayFieldSchema) ProtoMessage() {}
+
+func (x *StructArrayFieldSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StructArrayFieldSchema.ProtoReflect.Descriptor instead.
+func (*StructArrayFieldSchema) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StructArrayFieldSchema) GetFieldID() int64 {
+	if x != nil {
+		return x.FieldID
+	}
+	return 0
+}
+
+func (x *StructArrayFieldSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StructArrayFieldSchema) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StructArrayFieldSchema) GetFields() []*FieldSchema {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type BoolArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []bool `protobuf:"varint,1,rep,packed,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BoolArray) Reset() {
+	*x = BoolArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoolArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoolArray) ProtoMessage() {}
+
+func (x *BoolArray) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoolArray.ProtoReflect.Descriptor instead.
+func (*BoolArray) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BoolArray) GetData() []bool {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type IntArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []int32 `protobuf:"varint,1,rep,packed,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *IntArray) Reset() {
+	*x = IntArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntArray) ProtoMessage() {}
+
+func (x *IntArray) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntArray.ProtoReflect.Descriptor instead.
+func (*IntArray) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *IntArray) GetData() []int32 {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type LongArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []int64 `protobuf:"varint,1,rep,packed,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *LongArray) Reset() {
+	*x = LongArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LongArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LongArray) ProtoMessage() {}
+
+func (x *LongArray) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LongArray.ProtoReflect.Descriptor instead.
+func (*LongArray) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LongArray) GetData() []int64 {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type FloatArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []float32 `protobuf:"fixed32,1,rep,packed,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *FloatArray) Reset() {
+	*x = FloatArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FloatArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatArray) ProtoMessage() {}
+
+func (x *FloatArray) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatArray.ProtoReflect.Descriptor instead.
+func (*FloatArray) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FloatArray) GetData() []float32 {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DoubleArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []float64 `protobuf:"fixed64,1,rep,packed,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DoubleArray) Reset() {
+	*x = DoubleArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoubleArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleArray) ProtoMessage() {}
+
+func (x *DoubleArray) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleArray.ProtoReflect.Descriptor instead.
+func (*DoubleArray) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DoubleArray) GetData() []float64 {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// For special fields such as bigdecimal, array...
+type BytesArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data [][]byte `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BytesArray) Reset() {
+	*x = BytesArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesArray) ProtoMessage() {}
+
+func (x *BytesArray) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesArray.ProtoReflect.Descriptor instead.
+func (*BytesArray) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BytesArray) GetData() [][]byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type StringArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *StringArray) Reset() {
+	*x = StringArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringArray) ProtoMessage() {}
+
+func (x *StringArray) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringArray.ProtoReflect.Descriptor instead.
+func (*StringArray) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StringArray) GetData() []string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ArrayArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []*ScalarField `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	ElementType DataType       `protobuf:"varint,2,opt,name=element_type,json=elementType,proto3,enum=milvus.proto.schema.DataType" json:"element_type,omitempty"`
+}
+
+func (x *ArrayArray) Reset() {
+	*x = ArrayArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArrayArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArrayArray) ProtoMessage() {}
+
+func (x *ArrayArray) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArrayArray.ProtoReflect.Descriptor instead.
+func (*ArrayArray) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ArrayArray) GetData() []*ScalarField {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ArrayArray) GetElementType() DataType {
+	if x != nil {
+		return x.ElementType
+	}
+	return DataType_None
+}
+
+type JSONArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data [][]byte `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *JSONArray) Reset() {
+	*x = JSONArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONArray) ProtoMessage() {}
+
+func (x *JSONArray) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONArray.ProtoReflect.Descriptor instead.
+func (*JSONArray) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *JSONArray) GetData() [][]byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GeometryArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data [][]byte `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GeometryArray) Reset() {
+	*x = GeometryArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeometryArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeometryArray) ProtoMessage() {}
+
+func (x *GeometryArray) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeometryArray.ProtoReflect.Descriptor instead.
+func (*GeometryArray) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GeometryArray) GetData() [][]byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ValueField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//
+	//	*ValueField_BoolData
+	//	*ValueField_IntData
+	//	*ValueField_LongData
+	//	*ValueField_FloatData
+	//	*ValueField_DoubleData
+	//	*ValueField_StringData
+	//	*ValueField_BytesData
+	Data isValueField_Data `protobuf_oneof:"data"`
+}
+
+func (x *ValueField) Reset() {
+	*x = ValueField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValueField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueField) ProtoMessage() {}
+
+func (x *ValueField) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueField.ProtoReflect.Descriptor instead.
+func (*ValueField) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{15}
+}
+
+func (m *ValueField) GetData() isValueField_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *ValueField) GetBoolData() bool {
+	if x, ok := x.GetData().(*ValueField_BoolData); ok {
+		return x.BoolData
+	}
+	return false
+}
+
+func (x *ValueField) GetIntData() int32 {
+	if x, ok := x.GetData().(*ValueField_IntData); ok {
+		return x.IntData
+	}
+	return 0
+}
+
+func (x *ValueField) GetLongData() int64 {
+	if x, ok := x.GetData().(*ValueField_LongData); ok {
+		return x.LongData
+	}
+	return 0
+}
+
+func (x *ValueField) GetFloatData() float32 {
+	if x, ok := x.GetData().(*ValueField_FloatData); ok {
+		return x.FloatData
+	}
+	return 0
+}
+
+func (x *ValueField) GetDoubleData() float64 {
+	if x, ok := x.GetData().(*ValueField_DoubleData); ok {
+		return x.DoubleData
+	}
+	return 0
+}
+
+func (x *ValueField) GetStringData() string {
+	if x, ok := x.GetData().(*ValueField_StringData); ok {
+		return x.StringData
+	}
+	return ""
+}
+
+func (x *ValueField) GetBytesData() []byte {
+	if x, ok := x.GetData().(*ValueField_BytesData); ok {
+		return x.BytesData
+	}
+	return nil
+}
+
+type isValueField_Data interface {
+	isValueField_Data()
+}
+
+type ValueField_BoolData struct {
+	BoolData bool `protobuf:"varint,1,opt,name=bool_data,json=boolData,proto3,oneof"`
+}
+
+type ValueField_IntData struct {
+	IntData int32 `protobuf:"varint,2,opt,name=int_data,json=intData,proto3,oneof"`
+}
+
+type ValueField_LongData struct {
+	LongData int64 `protobuf:"varint,3,opt,name=long_data,json=longData,proto3,oneof"`
+}
+
+type ValueField_FloatData struct {
+	FloatData float32 `protobuf:"fixed32,4,opt,name=float_data,json=floatData,proto3,oneof"`
+}
+
+type ValueField_DoubleData struct {
+	DoubleData float64 `protobuf:"fixed64,5,opt,name=double_data,json=doubleData,proto3,oneof"`
+}
+
+type ValueField_StringData struct {
+	StringData string `protobuf:"bytes,6,opt,name=string_data,json=stringData,proto3,oneof"`
+}
+
+type ValueField_BytesData struct {
+	BytesData []byte `protobuf:"bytes,7,opt,name=bytes_data,json=bytesData,proto3,oneof"`
+}
+
+func (*ValueField_BoolData) isValueField_Data() {}
+
+func (*ValueField_IntData) isValueField_Data() {}
+
+func (*ValueField_LongData) isValueField_Data() {}
+
+func (*ValueField_FloatData) isValueField_Data() {}
+
+func (*ValueField_DoubleData) isValueField_Data() {}
+
+func (*ValueField_StringData) isValueField_Data() {}
+
+func (*ValueField_BytesData) isValueField_Data() {}
+
+type ScalarField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//
+	//	*ScalarField_BoolData
+	//	*ScalarField_IntData
+	//	*ScalarField_LongData
+	//	*ScalarField_FloatData
+	//	*ScalarField_DoubleData
+	//	*ScalarField_StringData
+	//	*ScalarField_BytesData
+	//	*ScalarField_ArrayData
+	//	*ScalarField_JsonData
+	//	*ScalarField_GeometryData
+	Data isScalarField_Data `protobuf_oneof:"data"`
+}
+
+func (x *ScalarField) Reset() {
+	*x = ScalarField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScalarField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalarField) ProtoMessage() {}
+
+func (x *ScalarField) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalarField.ProtoReflect.Descriptor instead.
+func (*ScalarField) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{16}
+}
+
+func (m *ScalarField) GetData() isScalarField_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *ScalarField) GetBoolData() *BoolArray {
+	if x, ok := x.GetData().(*ScalarField_BoolData); ok {
+		return x.BoolData
+	}
+	return nil
+}
+
+func (x *ScalarField) GetIntData() *IntArray {
+	if x, ok := x.GetData().(*ScalarField_IntData); ok {
+		return x.IntData
+	}
+	return nil
+}
+
+func (x *ScalarField) GetLongData() *LongArray {
+	if x, ok := x.GetData().(*ScalarField_LongData); ok {
+		return x.LongData
+	}
+	return nil
+}
+
+func (x *ScalarField) GetFloatData() *FloatArray {
+	if x, ok := x.GetData().(*ScalarField_FloatData); ok {
+		return x.FloatData
+	}
+	return nil
+}
+
+func (x *ScalarField) GetDoubleData() *DoubleArray {
+	if x, ok := x.GetData().(*ScalarField_DoubleData); ok {
+		return x.DoubleData
+	}
+	return nil
+}
+
+func (x *ScalarField) GetStringData() *StringArray {
+	if x, ok := x.GetData().(*ScalarField_StringData); ok {
+		return x.StringData
+	}
+	return nil
+}
+
+func (x *ScalarField) GetBytesData() *BytesArray {
+	if x, ok := x.GetData().(*ScalarField_BytesData); ok {
+		return x.BytesData
+	}
+	return nil
+}
+
+func (x *ScalarField) GetArrayData() *ArrayArray {
+	if x, ok := x.GetData().(*ScalarField_ArrayData); ok {
+		return x.ArrayData
+	}
+	return nil
+}
+
+func (x *ScalarField) GetJsonData() *JSONArray {
+	if x, ok := x.GetData().(*ScalarField_JsonData); ok {
+		return x.JsonData
+	}
+	return nil
+}
+
+func (x *ScalarField) GetGeometryData() *GeometryArray {
+	if x, ok := x.GetData().(*ScalarField_GeometryData); ok {
+		return x.GeometryData
+	}
+	return nil
+}
+
+type isScalarField_Data interface {
+	isScalarField_Data()
+}
+
+type ScalarField_BoolData struct {
+	BoolData *BoolArray `protobuf:"bytes,1,opt,name=bool_data,json=boolData,proto3,oneof"`
+}
+
+type ScalarField_IntData struct {
+	IntData *IntArray `protobuf:"bytes,2,opt,name=int_data,json=intData,proto3,oneof"`
+}
+
+type ScalarField_LongData struct {
+	LongData *LongArray `protobuf:"bytes,3,opt,name=long_data,json=longData,proto3,oneof"`
+}
+
+type ScalarField_FloatData struct {
+	FloatData *FloatArray `protobuf:"bytes,4,opt,name=float_data,json=floatData,proto3,oneof"`
+}
+
+type ScalarField_DoubleData struct {
+	DoubleData *DoubleArray `protobuf:"bytes,5,opt,name=double_data,json=doubleData,proto3,oneof"`
+}
+
+type ScalarField_StringData struct {
+	StringData *StringArray `protobuf:"bytes,6,opt,name=string_data,json=stringData,proto3,oneof"`
+}
+
+type ScalarField_BytesData struct {
+	BytesData *BytesArray `protobuf:"bytes,7,opt,name=bytes_data,json=bytesData,proto3,oneof"`
+}
+
+type ScalarField_ArrayData struct {
+	ArrayData *ArrayArray `protobuf:"bytes,8,opt,name=array_data,json=arrayData,proto3,oneof"`
+}
+
+type ScalarField_JsonData struct {
+	JsonData *JSONArray `protobuf:"bytes,9,opt,name=json_data,json=jsonData,proto3,oneof"`
+}
+
+type ScalarField_GeometryData struct {
+	GeometryData *GeometryArray `protobuf:"bytes,10,opt,name=geometry_data,json=geometryData,proto3,oneof"`
+}
+
+func (*ScalarField_BoolData) isScalarField_Data() {}
+
+func (*ScalarField_IntData) isScalarField_Data() {}
+
+func (*ScalarField_LongData) isScalarField_Data() {}
+
+func (*ScalarField_FloatData) isScalarField_Data() {}
+
+func (*ScalarField_DoubleData) isScalarField_Data() {}
+
+func (*ScalarField_StringData) isScalarField_Data() {}
+
+func (*ScalarField_BytesData) isScalarField_Data() {}
+
+func (*ScalarField_ArrayData) isScalarField_Data() {}
+
+func (*ScalarField_JsonData) isScalarField_Data() {}
+
+func (*ScalarField_GeometryData) isScalarField_Data() {}
+
+// beta, api may change
+type SparseFloatArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contents [][]byte `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
+	// dim is the max dimension of the current batch of vectors
+	Dim int64 `protobuf:"varint,2,opt,name=dim,proto3" json:"dim,omitempty"`
+}
+
+func (x *SparseFloatArray) Reset() {
+	*x = SparseFloatArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SparseFloatArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SparseFloatArray) ProtoMessage() {}
+
+func (x *SparseFloatArray) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SparseFloatArray.ProtoReflect.Descriptor instead.
+func (*SparseFloatArray) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SparseFloatArray) GetContents() [][]byte {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+func (x *SparseFloatArray) GetDim() int64 {
+	if x != nil {
+		return x.Dim
+	}
+	return 0
+}
+
+type VectorField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// For sparse vector, dim is the max dimension of the current batch of vectors
+	Dim int64 `protobuf:"varint,1,opt,name=dim,proto3" json:"dim,omitempty"`
+	// Types that are assignable to Data:
+	//
+	//	*VectorField_FloatVector
+	//	*VectorField_BinaryVector
+	//	*VectorField_Float16Vector
+	//	*VectorField_Bfloat16Vector
+	//	*VectorField_SparseFloatVector
+	//	*VectorField_Int8Vector
+	//	*VectorField_VectorArray
+	Data isVectorField_Data `protobuf_oneof:"data"`
+}
+
+func (x *VectorField) Reset() {
+	*x = VectorField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VectorField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VectorField) ProtoMessage() {}
+
+func (x *VectorField) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VectorField.ProtoReflect.Descriptor instead.
+func (*VectorField) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *VectorField) GetDim() int64 {
+	if x != nil {
+		return x.Dim
+	}
+	return 0
+}
+
+func (m *VectorField) GetData() isVectorField_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *VectorField) GetFloatVector() *FloatArray {
+	if x, ok := x.GetData().(*VectorField_FloatVector); ok {
+		return x.FloatVector
+	}
+	return nil
+}
+
+func (x *VectorField) GetBinaryVector() []byte {
+	if x, ok := x.GetData().(*VectorField_BinaryVector); ok {
+		return x.BinaryVector
+	}
+	return nil
+}
+
+func (x *VectorField) GetFloat16Vector() []byte {
+	if x, ok := x.GetData().(*VectorField_Float16Vector); ok {
+		return x.Float16Vector
+	}
+	return nil
+}
+
+func (x *VectorField) GetBfloat16Vector() []byte {
+	if x, ok := x.GetData().(*VectorField_Bfloat16Vector); ok {
+		return x.Bfloat16Vector
+	}
+	return nil
+}
+
+func (x *VectorField) GetSparseFloatVector() *SparseFloatArray {
+	if x, ok := x.GetData().(*VectorField_SparseFloatVector); ok {
+		return x.SparseFloatVector
+	}
+	return nil
+}
+
+func (x *VectorField) GetInt8Vector() []byte {
+	if x, ok := x.GetData().(*VectorField_Int8Vector); ok {
+		return x.Int8Vector
+	}
+	return nil
+}
+
+func (x *VectorField) GetVectorArray() *VectorArray {
+	if x, ok := x.GetData().(*VectorField_VectorArray); ok {
+		return x.VectorArray
+	}
+	return nil
+}
+
+type isVectorField_Data interface {
+	isVectorField_Data()
+}
+
+type VectorField_FloatVector struct {
+	FloatVector *FloatArray `protobuf:"bytes,2,opt,name=float_vector,json=floatVector,proto3,oneof"`
+}
+
+type VectorField_BinaryVector struct {
+	BinaryVector []byte `protobuf:"bytes,3,opt,name=binary_vector,json=binaryVector,proto3,oneof"`
+}
+
+type VectorField_Float16Vector struct {
+	Float16Vector []byte `protobuf:"bytes,4,opt,name=float16_vector,json=float16Vector,proto3,oneof"`
+}
+
+type VectorField_Bfloat16Vector struct {
+	Bfloat16Vector []byte `protobuf:"bytes,5,opt,name=bfloat16_vector,json=bfloat16Vector,proto3,oneof"`
+}
+
+type VectorField_SparseFloatVector struct {
+	SparseFloatVector *SparseFloatArray `protobuf:"bytes,6,opt,name=sparse_float_vector,json=sparseFloatVector,proto3,oneof"`
+}
+
+type VectorField_Int8Vector struct {
+	Int8Vector []byte `protobuf:"bytes,7,opt,name=int8_vector,json=int8Vector,proto3,oneof"`
+}
+
+type VectorField_VectorArray struct {
+	VectorArray *VectorArray `protobuf:"bytes,8,opt,name=vector_array,json=vectorArray,proto3,oneof"`
+}
+
+func (*VectorField_FloatVector) isVectorField_Data() {}
+
+func (*VectorField_BinaryVector) isVectorField_Data() {}
+
+func (*VectorField_Float16Vector) isVectorField_Data() {}
+
+func (*VectorField_Bfloat16Vector) isVectorField_Data() {}
+
+func (*VectorField_SparseFloatVector) isVectorField_Data() {}
+
+func (*VectorField_Int8Vector) isVectorField_Data() {}
+
+func (*VectorField_VectorArray) isVectorField_Data() {}
+
+type VectorArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dim         int64          `protobuf:"varint,1,opt,name=dim,proto3" json:"dim,omitempty"`
+	Data        []*VectorField `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	ElementType DataType       `protobuf:"varint,3,opt,name=element_type,json=elementType,proto3,enum=milvus.proto.schema.DataType" json:"element_type,omitempty"`
+}
+
+func (x *VectorArray) Reset() {
+	*x = VectorArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VectorArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VectorArray) ProtoMessage() {}
+
+func (x *VectorArray) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VectorArray.ProtoReflect.Descriptor instead.
+func (*VectorArray) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *VectorArray) GetDim() int64 {
+	if x != nil {
+		return x.Dim
+	}
+	return 0
+}
+
+func (x *VectorArray) GetData() []*VectorField {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *VectorArray) GetElementType() DataType {
+	if x != nil {
+		return x.ElementType
+	}
+	return DataType_None
+}
+
+type StructArrayField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []*FieldData `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *StructArrayField) Reset() {
+	*x = StructArrayField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StructArrayField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StructArrayField) ProtoMessage() {}
+
+func (x *StructArrayField) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StructArrayField.ProtoReflect.Descriptor instead.
+func (*StructArrayField) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StructArrayField) GetFields() []*FieldData {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type FieldData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      DataType `protobuf:"varint,1,opt,name=type,proto3,enum=milvus.proto.schema.DataType" json:"type,omitempty"`
+	FieldName string   `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// Types that are assignable to Field:
+	//
+	//	*FieldData_Scalars
+	//	*FieldData_Vectors
+	//	*FieldData_StructArrays
+	Field     isFieldData_Field `protobuf_oneof:"field"`
+	FieldId   int64             `protobuf:"varint,5,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	IsDynamic bool              `protobuf:"varint,6,opt,name=is_dynamic,json=isDynamic,proto3" json:"is_dynamic,omitempty"`
+	ValidData []bool            `protobuf:"varint,7,rep,packed,name=valid_data,json=validData,proto3" json:"valid_data,omitempty"`
+}
+
+func (x *FieldData) Reset() {
+	*x = FieldData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldData) ProtoMessage() {}
+
+func (x *FieldData) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldData.ProtoReflect.Descriptor instead.
+func (*FieldData) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FieldData) GetType() DataType {
+	if x != nil {
+		return x.Type
+	}
+	return DataType_None
+}
+
+func (x *FieldData) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (m *FieldData) GetField() isFieldData_Field {
+	if m != nil {
+		return m.Field
+	}
+	return nil
+}
+
+func (x *FieldData) GetScalars() *ScalarField {
+	if x, ok := x.GetField().(*FieldData_Scalars); ok {
+		return x.Scalars
+	}
+	return nil
+}
+
+func (x *FieldData) GetVectors() *VectorField {
+	if x, ok := x.GetField().(*FieldData_Vectors); ok {
+		return x.Vectors
+	}
+	return nil
+}
+
+func (x *FieldData) GetStructArrays() *StructArrayField {
+	if x, ok := x.GetField().(*FieldData_StructArrays); ok {
+		return x.StructArrays
+	}
+	return nil
+}
+
+func (x *FieldData) GetFieldId() int64 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *FieldData) GetIsDynamic() bool {
+	if x != nil {
+		return x.IsDynamic
+	}
+	return false
+}
+
+func (x *FieldData) GetValidData() []bool {
+	if x != nil {
+		return x.ValidData
+	}
+	return nil
+}
+
+type isFieldData_Field interface {
+	isFieldData_Field()
+}
+
+type FieldData_Scalars struct {
+	Scalars *ScalarField `protobuf:"bytes,3,opt,name=scalars,proto3,oneof"`
+}
+
+type FieldData_Vectors struct {
+	Vectors *VectorField `protobuf:"bytes,4,opt,name=vectors,proto3,oneof"`
+}
+
+type FieldData_StructArrays struct {
+	StructArrays *StructArrayField `protobuf:"bytes,8,opt,name=struct_arrays,json=structArrays,proto3,oneof"`
+}
+
+func (*FieldData_Scalars) isFieldData_Field() {}
+
+func (*FieldData_Vectors) isFieldData_Field() {}
+
+func (*FieldData_StructArrays) isFieldData_Field() {}
+
+type IDs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to IdField:
+	//
+	//	*IDs_IntId
+	//	*IDs_StrId
+	IdField isIDs_IdField `protobuf_oneof:"id_field"`
+}
+
+func (x *IDs) Reset() {
+	*x = IDs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IDs) ProtoMessage() {}
+
+func (x *IDs) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IDs.ProtoReflect.Descriptor instead.
+func (*IDs) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{22}
+}
+
+func (m *IDs) GetIdField() isIDs_IdField {
+	if m != nil {
+		return m.IdField
+	}
+	return nil
+}
+
+func (x *IDs) GetIntId() *LongArray {
+	if x, ok := x.GetIdField().(*IDs_IntId); ok {
+		return x.IntId
+	}
+	return nil
+}
+
+func (x *IDs) GetStrId() *StringArray {
+	if x, ok := x.GetIdField().(*IDs_StrId); ok {
+		return x.StrId
+	}
+	return nil
+}
+
+type isIDs_IdField interface {
+	isIDs_IdField()
+}
+
+type IDs_IntId struct {
+	IntId *LongArray `protobuf:"bytes,1,opt,name=int_id,json=intId,proto3,oneof"`
+}
+
+type IDs_StrId struct {
+	StrId *StringArray `protobuf:"bytes,2,opt,name=str_id,json=strId,proto3,oneof"`
+}
+
+func (*IDs_IntId) isIDs_IdField() {}
+
+func (*IDs_StrId) isIDs_IdField() {}
+
+type SearchIteratorV2Results struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token     string  `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	LastBound float32 `protobuf:"fixed32,2,opt,name=last_bound,json=lastBound,proto3" json:"last_bound,omitempty"`
+}
+
+func (x *SearchIteratorV2Results) Reset() {
+	*x = SearchIteratorV2Results{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchIteratorV2Results) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchIteratorV2Results) ProtoMessage() {}
+
+func (x *SearchIteratorV2Results) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchIteratorV2Results.ProtoReflect.Descriptor instead.
+func (*SearchIteratorV2Results) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchIteratorV2Results) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SearchIteratorV2Results) GetLastBound() float32 {
+	if x != nil {
+		return x.LastBound
+	}
+	return 0
+}
+
+type SearchResultData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumQueries              int64                    `protobuf:"varint,1,opt,name=num_queries,json=numQueries,proto3" json:"num_queries,omitempty"`
+	TopK                    int64                    `protobuf:"varint,2,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
+	FieldsData              []*FieldData             `protobuf:"bytes,3,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	Scores                  []float32                `protobuf:"fixed32,4,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	Ids                     *IDs                     `protobuf:"bytes,5,opt,name=ids,proto3" json:"ids,omitempty"`
+	Topks                   []int64                  `protobuf:"varint,6,rep,packed,name=topks,proto3" json:"topks,omitempty"`
+	OutputFields            []string                 `protobuf:"bytes,7,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	GroupByFieldValue       *FieldData               `protobuf:"bytes,8,opt,name=group_by_field_value,json=groupByFieldValue,proto3" json:"group_by_field_value,omitempty"`
+	AllSearchCount          int64                    `protobuf:"varint,9,opt,name=all_search_count,json=allSearchCount,proto3" json:"all_search_count,omitempty"`
+	Distances               []float32                `protobuf:"fixed32,10,rep,packed,name=distances,proto3" json:"distances,omitempty"`
+	SearchIteratorV2Results *SearchIteratorV2Results `protobuf:"bytes,11,opt,name=search_iterator_v2_results,json=searchIteratorV2Results,proto3,oneof" json:"search_iterator_v2_results,omitempty"`
+	Recalls                 []float32                `protobuf:"fixed32,12,rep,packed,name=recalls,proto3" json:"recalls,omitempty"`
+	PrimaryFieldName        string                   `protobuf:"bytes,13,opt,name=primary_field_name,json=primaryFieldName,proto3" json:"primary_field_name,omitempty"`
+}
+
+func (x *SearchResultData) Reset() {
+	*x = SearchResultData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResultData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResultData) ProtoMessage() {}
+
+func (x *SearchResultData) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResultData.ProtoReflect.Descriptor instead.
+func (*SearchResultData) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchResultData) GetNumQueries() int64 {
+	if x != nil {
+		return x.NumQueries
+	}
+	return 0
+}
+
+func (x *SearchResultData) GetTopK() int64 {
+	if x != nil {
+		return x.TopK
+	}
+	return 0
+}
+
+func (x *SearchResultData) GetFieldsData() []*FieldData {
+	if x != nil {
+		return x.FieldsData
+	}
+	return nil
+}
+
+func (x *SearchResultData) GetScores() []float32 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *SearchResultData) GetIds() *IDs {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *SearchResultData) GetTopks() []int64 {
+	if x != nil {
+		return x.Topks
+	}
+	return nil
+}
+
+func (x *SearchResultData) GetOutputFields() []string {
+	if x != nil {
+		return x.OutputFields
+	}
+	return nil
+}
+
+func (x *SearchResultData) GetGroupByFieldValue() *FieldData {
+	if x != nil {
+		return x.GroupByFieldValue
+	}
+	return nil
+}
+
+func (x *SearchResultData) GetAllSearchCount() int64 {
+	if x != nil {
+		return x.AllSearchCount
+	}
+	return 0
+}
+
+func (x *SearchResultData) GetDistances() []float32 {
+	if x != nil {
+		return x.Distances
+	}
+	return nil
+}
+
+func (x *SearchResultData) GetSearchIteratorV2Results() *SearchIteratorV2Results {
+	if x != nil {
+		return x.SearchIteratorV2Results
+	}
+	return nil
+}
+
+func (x *SearchResultData) GetRecalls() []float32 {
+	if x != nil {
+		return x.Recalls
+	}
+	return nil
+}
+
+func (x *SearchResultData) GetPrimaryFieldName() string {
+	if x != nil {
+		return x.PrimaryFieldName
+	}
+	return ""
+}
+
+// vector field clustering info
+type VectorClusteringInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// for multi vectors
+	Field    string       `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Centroid *VectorField `protobuf:"bytes,2,opt,name=centroid,proto3" json:"centroid,omitempty"`
+}
+
+func (x *VectorClusteringInfo) Reset() {
+	*x = VectorClusteringInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VectorClusteringInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VectorClusteringInfo) ProtoMessage() {}
+
+func (x *VectorClusteringInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VectorClusteringInfo.ProtoReflect.Descriptor instead.
+func (*VectorClusteringInfo) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VectorClusteringInfo) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *VectorClusteringInfo) GetCentroid() *VectorField {
+	if x != nil {
+		return x.Centroid
+	}
+	return nil
+}
+
+// Scalar field clustering info
+// todo more definitions: min/max, etc
+type ScalarClusteringInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (x *ScalarClusteringInfo) Reset() {
+	*x = ScalarClusteringInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScalarClusteringInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalarClusteringInfo) ProtoMessage() {}
+
+func (x *ScalarClusteringInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalarClusteringInfo.ProtoReflect.Descriptor instead.
+func (*ScalarClusteringInfo) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ScalarClusteringInfo) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+// clustering distribution info of a certain data unit, it can be segment, partition, etc.
+type ClusteringInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VectorClusteringInfos []*VectorClusteringInfo `protobuf:"bytes,1,rep,name=vector_clustering_infos,json=vectorClusteringInfos,proto3" json:"vector_clustering_infos,omitempty"`
+	ScalarClusteringInfos []*ScalarClusteringInfo `protobuf:"bytes,2,rep,name=scalar_clustering_infos,json=scalarClusteringInfos,proto3" json:"scalar_clustering_infos,omitempty"`
+}
+
+func (x *ClusteringInfo) Reset() {
+	*x = ClusteringInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusteringInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusteringInfo) ProtoMessage() {}
+
+func (x *ClusteringInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusteringInfo.ProtoReflect.Descriptor instead.
+func (*ClusteringInfo) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ClusteringInfo) GetVectorClusteringInfos() []*VectorClusteringInfo {
+	if x != nil {
+		return x.VectorClusteringInfos
+	}
+	return nil
+}
+
+func (x *ClusteringInfo) GetScalarClusteringInfos() []*ScalarClusteringInfo {
+	if x != nil {
+		return x.ScalarClusteringInfos
+	}
+	return nil
+}
+
+type TemplateValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Val:
+	//
+	//	*TemplateValue_BoolVal
+	//	*TemplateValue_Int64Val
+	//	*TemplateValue_FloatVal
+	//	*TemplateValue_StringVal
+	//	*TemplateValue_ArrayVal
+	Val isTemplateValue_Val `protobuf_oneof:"val"`
+}
+
+func (x *TemplateValue) Reset() {
+	*x = TemplateValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateValue) ProtoMessage() {}
+
+func (x *TemplateValue) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateValue.ProtoReflect.Descriptor instead.
+func (*TemplateValue) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{28}
+}
+
+func (m *TemplateValue) GetVal() isTemplateValue_Val {
+	if m != nil {
+		return m.Val
+	}
+	return nil
+}
+
+func (x *TemplateValue) GetBoolVal() bool {
+	if x, ok := x.GetVal().(*TemplateValue_BoolVal); ok {
+		return x.BoolVal
+	}
+	return false
+}
+
+func (x *TemplateValue) GetInt64Val() int64 {
+	if x, ok := x.GetVal().(*TemplateValue_Int64Val); ok {
+		return x.Int64Val
+	}
+	return 0
+}
+
+func (x *TemplateValue) GetFloatVal() float64 {
+	if x, ok := x.GetVal().(*TemplateValue_FloatVal); ok {
+		return x.FloatVal
+	}
+	return 0
+}
+
+func (x *TemplateValue) GetStringVal() string {
+	if x, ok := x.GetVal().(*TemplateValue_StringVal); ok {
+		return x.StringVal
+	}
+	return ""
+}
+
+func (x *TemplateValue) GetArrayVal() *TemplateArrayValue {
+	if x, ok := x.GetVal().(*TemplateValue_ArrayVal); ok {
+		return x.ArrayVal
+	}
+	return nil
+}
+
+type isTemplateValue_Val interface {
+	isTemplateValue_Val()
+}
+
+type TemplateValue_BoolVal struct {
+	BoolVal bool `protobuf:"varint,1,opt,name=bool_val,json=boolVal,proto3,oneof"`
+}
+
+type TemplateValue_Int64Val struct {
+	Int64Val int64 `protobuf:"varint,2,opt,name=int64_val,json=int64Val,proto3,oneof"`
+}
+
+type TemplateValue_FloatVal struct {
+	FloatVal float64 `protobuf:"fixed64,3,opt,name=float_val,json=floatVal,proto3,oneof"`
+}
+
+type TemplateValue_StringVal struct {
+	StringVal string `protobuf:"bytes,4,opt,name=string_val,json=stringVal,proto3,oneof"`
+}
+
+type TemplateValue_ArrayVal struct {
+	ArrayVal *TemplateArrayValue `protobuf:"bytes,5,opt,name=array_val,json=arrayVal,proto3,oneof"`
+}
+
+func (*TemplateValue_BoolVal) isTemplateValue_Val() {}
+
+func (*TemplateValue_Int64Val) isTemplateValue_Val() {}
+
+func (*TemplateValue_FloatVal) isTemplateValue_Val() {}
+
+func (*TemplateValue_StringVal) isTemplateValue_Val() {}
+
+func (*TemplateValue_ArrayVal) isTemplateValue_Val() {}
+
+type TemplateArrayValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//
+	//	*TemplateArrayValue_BoolData
+	//	*TemplateArrayValue_LongData
+	//	*TemplateArrayValue_DoubleData
+	//	*TemplateArrayValue_StringData
+	//	*TemplateArrayValue_ArrayData
+	//	*TemplateArrayValue_JsonData
+	Data isTemplateArrayValue_Data `protobuf_oneof:"data"`
+}
+
+func (x *TemplateArrayValue) Reset() {
+	*x = TemplateArrayValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateArrayValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateArrayValue) ProtoMessage() {}
+
+func (x *TemplateArrayValue) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateArrayValue.ProtoReflect.Descriptor instead.
+func (*TemplateArrayValue) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{29}
+}
+
+func (m *TemplateArrayValue) GetData() isTemplateArrayValue_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *TemplateArrayValue) GetBoolData() *BoolArray {
+	if x, ok := x.GetData().(*TemplateArrayValue_BoolData); ok {
+		return x.BoolData
+	}
+	return nil
+}
+
+func (x *TemplateArrayValue) GetLongData() *LongArray {
+	if x, ok := x.GetData().(*TemplateArrayValue_LongData); ok {
+		return x.LongData
+	}
+	return nil
+}
+
+func (x *TemplateArrayValue) GetDoubleData() *DoubleArray {
+	if x, ok := x.GetData().(*TemplateArrayValue_DoubleData); ok {
+		return x.DoubleData
+	}
+	return nil
+}
+
+func (x *TemplateArrayValue) GetStringData() *StringArray {
+	if x, ok := x.GetData().(*TemplateArrayValue_StringData); ok {
+		return x.StringData
+	}
+	return nil
+}
+
+func (x *TemplateArrayValue) GetArrayData() *TemplateArrayValueArray {
+	if x, ok := x.GetData().(*TemplateArrayValue_ArrayData); ok {
+		return x.ArrayData
+	}
+	return nil
+}
+
+func (x *TemplateArrayValue) GetJsonData() *JSONArray {
+	if x, ok := x.GetData().(*TemplateArrayValue_JsonData); ok {
+		return x.JsonData
+	}
+	return nil
+}
+
+type isTemplateArrayValue_Data interface {
+	isTemplateArrayValue_Data()
+}
+
+type TemplateArrayValue_BoolData struct {
+	BoolData *BoolArray `protobuf:"bytes,1,opt,name=bool_data,json=boolData,proto3,oneof"`
+}
+
+type TemplateArrayValue_LongData struct {
+	LongData *LongArray `protobuf:"bytes,2,opt,name=long_data,json=longData,proto3,oneof"`
+}
+
+type TemplateArrayValue_DoubleData struct {
+	DoubleData *DoubleArray `protobuf:"bytes,3,opt,name=double_data,json=doubleData,proto3,oneof"`
+}
+
+type TemplateArrayValue_StringData struct {
+	StringData *StringArray `protobuf:"bytes,4,opt,name=string_data,json=stringData,proto3,oneof"`
+}
+
+type TemplateArrayValue_ArrayData struct {
+	ArrayData *TemplateArrayValueArray `protobuf:"bytes,5,opt,name=array_data,json=arrayData,proto3,oneof"`
+}
+
+type TemplateArrayValue_JsonData struct {
+	JsonData *JSONArray `protobuf:"bytes,6,opt,name=json_data,json=jsonData,proto3,oneof"`
+}
+
+func (*TemplateArrayValue_BoolData) isTemplateArrayValue_Data() {}
+
+func (*TemplateArrayValue_LongData) isTemplateArrayValue_Data() {}
+
+func (*TemplateArrayValue_DoubleData) isTemplateArrayValue_Data() {}
+
+func (*TemplateArrayValue_StringData) isTemplateArrayValue_Data() {}
+
+func (*TemplateArrayValue_ArrayData) isTemplateArrayValue_Data() {}
+
+func (*TemplateArrayValue_JsonData) isTemplateArrayValue_Data() {}
+
+type TemplateArrayValueArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*TemplateArrayValue `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *TemplateArrayValueArray) Reset() {
+	*x = TemplateArrayValueArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateArrayValueArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateArrayValueArray) ProtoMessage() {}
+
+func (x *TemplateArrayValueArray) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateArrayValueArray.ProtoReflect.Descriptor instead.
+func (*TemplateArrayValueArray) Descriptor() ([]byte, []int) {
+	return file_schema_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TemplateArrayValueArray) GetData() []*TemplateArrayValue {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_schema_proto protoreflect.FileDescriptor
+
+var file_schema_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xdf, 0x05, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x50, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x09, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x64, 0x61, 0x74,
+	0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0a, 0x74,
+	0x79, 0x70, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x44, 0x0a, 0x0c, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x0b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x6f, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x75, 0x74, 0x6f, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x40,
+	0x0a, 0x0c, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0b, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x44, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x79, 0x6e,
+	0x61, 0x6d, 0x69, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x69, 0x73, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12,
+	0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e,
+	0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x73, 0x5f, 0x66, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xf4, 0x02, 0x0a, 0x0e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64,
+	0x73, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x8d, 0x01, 0x0a,
+	0x0d, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x41,
+	0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xcb, 0x03, 0x0a,
+	0x10, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x6f, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x61, 0x75, 0x74,
+	0x6f, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x41, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x41, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x09, 0x66, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5b, 0x0a,
+	0x13, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x11, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x16, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22,
+	0x1f, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x6c, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x1e, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x1f, 0x0a, 0x09, 0x4c, 0x6f, 0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x20, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x02, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x21, 0x0a, 0x0b, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x41, 0x72, 0x72,
+	0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x20, 0x0a, 0x0a, 0x42, 0x79, 0x74, 0x65, 0x73, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x21, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x84, 0x01, 0x0a, 0x0a,
+	0x41, 0x72, 0x72, 0x61, 0x79, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x34, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53,
+	0x63, 0x61, 0x6c, 0x61, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x40, 0x0a, 0x0c, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x1f, 0x0a, 0x09, 0x4a, 0x53, 0x4f, 0x4e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x23, 0x0a, 0x0d, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf7, 0x01, 0x0a, 0x0a, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6f,
+	0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x69, 0x6e, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x6f, 0x6e, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x09, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xa9, 0x05, 0x0a, 0x0b, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x3d, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x3a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x41, 0x72, 0x72,
+	0x61, 0x79, 0x48, 0x00, 0x52, 0x07, 0x69, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4c, 0x6f, 0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79,
+	0x48, 0x00, 0x52, 0x08, 0x6c, 0x6f, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0a,
+	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x41, 0x72, 0x72, 0x61,
+	0x79, 0x48, 0x00, 0x52, 0x09, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x43,
+	0x0a, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x43, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52,
+	0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0a, 0x61, 0x72,
+	0x72, 0x61, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48,
+	0x00, 0x52, 0x09, 0x61, 0x72, 0x72, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x09,
+	0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48,
+	0x00, 0x52, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x49, 0x0a, 0x0d, 0x67,
+	0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x40,
+	0x0a, 0x10, 0x53, 0x70, 0x61, 0x72, 0x73, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x41, 0x72, 0x72,
+	0x61, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x69, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x64, 0x69, 0x6d,
+	0x22, 0xab, 0x03, 0x0a, 0x0b, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x64,
+	0x69, 0x6d, 0x12, 0x44, 0x0a, 0x0c, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x46,
+	0x6c, 0x6f, 0x61, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x66, 0x6c, 0x6f,
+	0x61, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0d, 0x62, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x27, 0x0a, 0x0e, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x36, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0d, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x31, 0x36, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x0f, 0x62, 0x66, 0x6c, 0x6f,
+	0x61, 0x74, 0x31, 0x36, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x0e, 0x62, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x36, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x57, 0x0a, 0x13, 0x73, 0x70, 0x61, 0x72, 0x73, 0x65, 0x5f, 0x66, 0x6c,
+	0x6f, 0x61, 0x74, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x70, 0x61, 0x72, 0x73, 0x65, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x11, 0x73, 0x70, 0x61, 0x72, 0x73,
+	0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0b,
+	0x69, 0x6e, 0x74, 0x38, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x38, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x45, 0x0a, 0x0c, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x41, 0x72, 0x72, 0x61, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x97,
+	0x01, 0x0a, 0x0b, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x64, 0x69, 0x6d,
+	0x12, 0x34, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0c, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x4a, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x22, 0x89, 0x03, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x63, 0x61, 0x6c,
+	0x61, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6c, 0x61,
+	0x72, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x4c, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00,
+	0x52, 0x0c, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x08, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x44, 0x61, 0x74, 0x61, 0x42, 0x07, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x22, 0x85, 0x01, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x69, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4c,
+	0x6f, 0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x72,
+	0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x72, 0x49, 0x64, 0x42, 0x0a, 0x0a, 0x08,
+	0x69, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x4e, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x56, 0x32, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0xf8, 0x04, 0x0a, 0x10, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x13,
+	0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
+	0x6f, 0x70, 0x4b, 0x12, 0x3f, 0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
+	0x49, 0x44, 0x73, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x6b,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x6b, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x4f, 0x0a, 0x14, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x61, 0x6c, 0x6c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x02, 0x52, 0x09, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x6e, 0x0a, 0x1a,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x76, 0x32, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x74, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x56, 0x32, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x48, 0x00,
+	0x52, 0x17, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x56, 0x32, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x02, 0x52, 0x07, 0x72,
+	0x65, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x76, 0x32, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0x6a, 0x0a, 0x14, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x3c, 0x0a, 0x08, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x08, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x69, 0x64, 0x22,
+	0x2c, 0x0a, 0x14, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xd6, 0x01,
+	0x0a, 0x0e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x61, 0x0a, 0x17, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x15, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x12, 0x61, 0x0a, 0x17, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x5f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61,
+	0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x15, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x0d, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x09, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x56, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x12, 0x46, 0x0a, 0x09, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x76, 0x61,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x72, 0x72, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x48, 0x00, 0x52, 0x08, 0x61, 0x72, 0x72, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x42, 0x05, 0x0a, 0x03,
+	0x76, 0x61, 0x6c, 0x22, 0xb2, 0x03, 0x0a, 0x12, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x41, 0x72, 0x72, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x62, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52,
+	0x08, 0x62, 0x6f, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x4c, 0x6f, 0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x08,
+	0x6c, 0x6f, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x43, 0x0a, 0x0b, 0x64, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48,
+	0x00, 0x52, 0x0a, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x43, 0x0a,
+	0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x4d, 0x0a, 0x0a, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x72, 0x72, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x09, 0x61, 0x72, 0x72, 0x61, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x3d, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x56, 0x0a, 0x17, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x41, 0x72, 0x72, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x72,
+	0x72, 0x61, 0x79, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x41, 0x72, 0x72, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x2a, 0xbe, 0x02, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x6f, 0x6f, 0x6c, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x6e, 0x74, 0x38, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x49,
+	0x6e, 0x74, 0x31, 0x36, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x10,
+	0x04, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x10, 0x0a, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x10, 0x0b, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x14, 0x12,
+	0x0b, 0x0a, 0x07, 0x56, 0x61, 0x72, 0x43, 0x68, 0x61, 0x72, 0x10, 0x15, 0x12, 0x09, 0x0a, 0x05,
+	0x41, 0x72, 0x72, 0x61, 0x79, 0x10, 0x16, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10,
+	0x17, 0x12, 0x0c, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x10, 0x18, 0x12,
+	0x08, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x10, 0x19, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x10, 0x64, 0x12, 0x0f, 0x0a, 0x0b, 0x46,
+	0x6c, 0x6f, 0x61, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x10, 0x65, 0x12, 0x11, 0x0a, 0x0d,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x36, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x10, 0x66, 0x12,
+	0x12, 0x0a, 0x0e, 0x42, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x36, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x10, 0x67, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x70, 0x61, 0x72, 0x73, 0x65, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x10, 0x68, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x6e,
+	0x74, 0x38, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x10, 0x69, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x72,
+	0x72, 0x61, 0x79, 0x4f, 0x66, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x10, 0x6a, 0x12, 0x12, 0x0a,
+	0x0d, 0x41, 0x72, 0x72, 0x61, 0x79, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x10, 0xc8,
+	0x01, 0x2a, 0x7c, 0x0a, 0x0c, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x42, 0x4d, 0x32, 0x35, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x65, 0x78, 0x74,
+	0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x52,
+	0x65, 0x72, 0x61, 0x6e, 0x6b, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x69, 0x6e, 0x48, 0x61,
+	0x73, 0x68, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x6f, 0x64,
+	0x61, 0x6c, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x05, 0x12, 0x10, 0x0a,
+	0x0c, 0x54, 0x65, 0x78, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x10, 0x06, 0x2a,
+	0x56, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a,
+	0x0c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x10, 0x00, 0x12,
+	0x11, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x72, 0x6f, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x10, 0x03, 0x42, 0x6d, 0x0a, 0x0e, 0x69, 0x6f, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x42, 0x0b, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2d, 0x69, 0x6f, 0x2f, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2d, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x62, 0xa0, 0x01,
+	0x01, 0xaa, 0x02, 0x12, 0x4d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_schema_proto_rawDescOnce sync.Once
+	file_schema_proto_rawDescData = file_schema_proto_rawDesc
+)
+
+func file_schema_proto_rawDescGZIP() []byte {
+	file_schema_proto_rawDescOnce.Do(func() {
+		file_schema_proto_rawDescData = protoimpl.X.CompressGZIP(file_schema_proto_rawDescData)
+	})
+	return file_schema_proto_rawDescData
+}
+
+var file_schema_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_schema_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_schema_proto_goTypes = []interface{}{
+	(DataType)(0),                   // 0: milvus.proto.schema.DataType
+	(FunctionType)(0),               // 1: milvus.proto.schema.FunctionType
+	(FieldState)(0),                 // 2: milvus.proto.schema.FieldState
+	(*FieldSchema)(nil),             // 3: milvus.proto.schema.FieldSchema
+	(*FunctionSchema)(nil),          // 4: milvus.proto.schema.FunctionSchema
+	(*FunctionScore)(nil),           // 5: milvus.proto.schema.FunctionScore
+	(*CollectionSchema)(nil),        // 6: milvus.proto.schema.CollectionSchema
+	(*StructArrayFieldSchema)(nil),  // 7: milvus.proto.schema.StructArrayFieldSchema
+	(*BoolArray)(nil),               // 8: milvus.proto.schema.BoolArray
+	(*IntArray)(nil),                // 9: milvus.proto.schema.IntArray
+	(*LongArray)(nil),               // 10: milvus.proto.schema.LongArray
+	(*FloatArray)(nil),              // 11: milvus.proto.schema.FloatArray
+	(*DoubleArray)(nil),             // 12: milvus.proto.schema.DoubleArray
+	(*BytesArray)(nil),              // 13: milvus.proto.schema.BytesArray
+	(*StringArray)(nil),             // 14: milvus.proto.schema.StringArray
+	(*ArrayArray)(nil),              // 15: milvus.proto.schema.ArrayArray
+	(*JSONArray)(nil),               // 16: milvus.proto.schema.JSONArray
+	(*GeometryArray)(nil),           // 17: milvus.proto.schema.GeometryArray
+	(*ValueField)(nil),              // 18: milvus.proto.schema.ValueField
+	(*ScalarField)(nil),             // 19: milvus.proto.schema.ScalarField
+	(*SparseFloatArray)(nil),        // 20: milvus.proto.schema.SparseFloatArray
+	(*VectorField)(nil),             // 21: milvus.proto.schema.VectorField
+	(*VectorArray)(nil),             // 22: milvus.proto.schema.VectorArray
+	(*StructArrayField)(nil),        // 23: milvus.proto.schema.StructArrayField
+	(*FieldData)(nil),               // 24: milvus.proto.schema.FieldData
+	(*IDs)(nil),                     // 25: milvus.proto.schema.IDs
+	(*SearchIteratorV2Results)(nil), // 26: milvus.proto.schema.SearchIteratorV2Results
+	(*SearchResultData)(nil),        // 27: milvus.proto.schema.SearchResultData
+	(*VectorClusteringInfo)(nil),    // 28: milvus.proto.schema.VectorClusteringInfo
+	(*ScalarClusteringInfo)(nil),    // 29: milvus.proto.schema.ScalarClusteringInfo
+	(*ClusteringInfo)(nil),          // 30: milvus.proto.schema.ClusteringInfo
+	(*TemplateValue)(nil),           // 31: milvus.proto.schema.TemplateValue
+	(*TemplateArrayValue)(nil),      // 32: milvus.proto.schema.TemplateArrayValue
+	(*TemplateArrayValueArray)(nil), // 33: milvus.proto.schema.TemplateArrayValueArray
+	(*commonpb.KeyValuePair)(nil),   // 34: milvus.proto.common.KeyValuePair
+}
+var file_schema_proto_depIdxs = []int32{
+	0,  // 0: milvus.proto.schema.FieldSchema.data_type:type_name -> milvus.proto.schema.DataType
+	34, // 1: milvus.proto.schema.FieldSchema.type_params:type_name -> milvus.proto.common.KeyValuePair
+	34, // 2: milvus.proto.schema.FieldSchema.index_params:type_name -> milvus.proto.common.KeyValuePair
+	2,  // 3: milvus.proto.schema.FieldSchema.state:type_name -> milvus.proto.schema.FieldState
+	0,  // 4: milvus.proto.schema.FieldSchema.element_type:type_name -> milvus.proto.schema.DataType
+	18, // 5: milvus.proto.schema.FieldSchema.default_value:type_name -> milvus.proto.schema.ValueField
+	1,  // 6: milvus.proto.schema.FunctionSchema.type:type_name -> milvus.proto.schema.FunctionType
+	34, // 7: milvus.proto.schema.FunctionSchema.params:type_name -> milvus.proto.common.KeyValuePair
+	4,  // 8: milvus.proto.schema.FunctionScore.functions:type_name -> milvus.proto.schema.FunctionSchema
+	34, // 9: milvus.proto.schema.FunctionScore.params:type_name -> milvus.proto.common.KeyValuePair
+	3,  // 10: milvus.proto.schema.CollectionSchema.fields:type_name -> milvus.proto.schema.FieldSchema
+	34, // 11: milvus.proto.schema.CollectionSchema.properties:type_name -> milvus.proto.common.KeyValuePair
+	4,  // 12: milvus.proto.schema.CollectionSchema.functions:type_name -> milvus.proto.schema.FunctionSchema
+	7,  // 13: milvus.proto.schema.CollectionSchema.struct_array_fields:type_name -> milvus.proto.schema.StructArrayFieldSchema
+	3,  // 14: milvus.proto.schema.StructArrayFieldSchema.fields:type_name -> milvus.proto.schema.FieldSchema
+	19, // 15: milvus.proto.schema.ArrayArray.data:type_name -> milvus.proto.schema.ScalarField
+	0,  // 16: milvus.proto.schema.ArrayArray.element_type:type_name -> milvus.proto.schema.DataType
+	8,  // 17: milvus.proto.schema.ScalarField.bool_data:type_name -> milvus.proto.schema.BoolArray
+	9,  // 18: milvus.proto.schema.ScalarField.int_data:type_name -> milvus.proto.schema.IntArray
+	10, // 19: milvus.proto.schema.ScalarField.long_data:type_name -> milvus.proto.schema.LongArray
+	11, // 20: milvus.proto.schema.ScalarField.float_data:type_name -> milvus.proto.schema.FloatArray
+	12, // 21: milvus.proto.schema.ScalarField.double_data:type_name -> milvus.proto.schema.DoubleArray
+	14, // 22: milvus.proto.schema.ScalarField.string_data:type_name -> milvus.proto.schema.StringArray
+	13, // 23: milvus.proto.schema.ScalarField.bytes_data:type_name -> milvus.proto.schema.BytesArray
+	15, // 24: milvus.proto.schema.ScalarField.array_data:type_name -> milvus.proto.schema.ArrayArray
+	16, // 25: milvus.proto.schema.ScalarField.json_data:type_name -> milvus.proto.schema.JSONArray
+	17, // 26: milvus.proto.schema.ScalarField.geometry_data:type_name -> milvus.proto.schema.GeometryArray
+	11, // 27: milvus.proto.schema.VectorField.float_vector:type_name -> milvus.proto.schema.FloatArray
+	20, // 28: milvus.proto.schema.VectorField.sparse_float_vector:type_name -> milvus.proto.schema.SparseFloatArray
+	22, // 29: milvus.proto.schema.VectorField.vector_array:type_name -> milvus.proto.schema.VectorArray
+	21, // 30: milvus.proto.schema.VectorArray.data:type_name -> milvus.proto.schema.VectorField
+	0,  // 31: milvus.proto.schema.VectorArray.element_type:type_name -> milvus.proto.schema.DataType
+	24, // 32: milvus.proto.schema.StructArrayField.fields:type_name -> milvus.proto.schema.FieldData
+	0,  // 33: milvus.proto.schema.FieldData.type:type_name -> milvus.proto.schema.DataType
+	19, // 34: milvus.proto.schema.FieldData.scalars:type_name -> milvus.proto.schema.ScalarField
+	21, // 35: milvus.proto.schema.FieldData.vectors:type_name -> milvus.proto.schema.VectorField
+	23, // 36: milvus.proto.schema.FieldData.struct_arrays:type_name -> milvus.proto.schema.StructArrayField
+	10, // 37: milvus.proto.schema.IDs.int_id:type_name -> milvus.proto.schema.LongArray
+	14, // 38: milvus.proto.schema.IDs.str_id:type_name -> milvus.proto.schema.StringArray
+	24, // 39: milvus.proto.schema.SearchResultData.fields_data:type_name -> milvus.proto.schema.FieldData
+	25, // 40: milvus.proto.schema.SearchResultData.ids:type_name -> milvus.proto.schema.IDs
+	24, // 41: milvus.proto.schema.SearchResultData.group_by_field_value:type_name -> milvus.proto.schema.FieldData
+	26, // 42: milvus.proto.schema.SearchResultData.search_iterator_v2_results:type_name -> milvus.proto.schema.SearchIteratorV2Results
+	21, // 43: milvus.proto.schema.VectorClusteringInfo.centroid:type_name -> milvus.proto.schema.VectorField
+	28, // 44: milvus.proto.schema.ClusteringInfo.vector_clustering_infos:type_name -> milvus.proto.schema.VectorClusteringInfo
+	29, // 45: milvus.proto.schema.ClusteringInfo.scalar_clustering_infos:type_name -> milvus.proto.schema.ScalarClusteringInfo
+	32, // 46: milvus.proto.schema.TemplateValue.array_val:type_name -> milvus.proto.schema.TemplateArrayValue
+	8,  // 47: milvus.proto.schema.TemplateArrayValue.bool_data:type_name -> milvus.proto.schema.BoolArray
+	10, // 48: milvus.proto.schema.TemplateArrayValue.long_data:type_name -> milvus.proto.schema.LongArray
+	12, // 49: milvus.proto.schema.TemplateArrayValue.double_data:type_name -> milvus.proto.schema.DoubleArray
+	14, // 50: milvus.proto.schema.TemplateArrayValue.string_data:type_name -> milvus.proto.schema.StringArray
+	33, // 51: milvus.proto.schema.TemplateArrayValue.array_data:type_name -> milvus.proto.schema.TemplateArrayValueArray
+	16, // 52: milvus.proto.schema.TemplateArrayValue.json_data:type_name -> milvus.proto.schema.JSONArray
+	32, // 53: milvus.proto.schema.TemplateArrayValueArray.data:type_name -> milvus.proto.schema.TemplateArrayValue
+	54, // [54:54] is the sub-list for method output_type
+	54, // [54:54] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
+}
+
+func init() { file_schema_proto_init() }
+func file_schema_proto_init() {
+	if File_schema_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_schema_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FunctionSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FunctionScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StructArrayFieldSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoolArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LongArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloatArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BytesArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArrayArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeometryArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValueField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScalarField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SparseFloatArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StructArrayField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IDs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchIteratorV2Results); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResultData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorClusteringInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScalarClusteringInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusteringInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateArrayValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateArrayValueArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_schema_proto_msgTypes[15].OneofWrappers = []interface{}{
+		(*ValueField_BoolData)(nil),
+		(*ValueField_IntData)(nil),
+		(*ValueField_LongData)(nil),
+		(*ValueField_FloatData)(nil),
+		(*ValueField_DoubleData)(nil),
+		(*ValueField_StringData)(nil),
+		(*ValueField_BytesData)(nil),
+	}
+	file_schema_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*ScalarField_BoolData)(nil),
+		(*ScalarField_IntData)(nil),
+		(*ScalarField_LongData)(nil),
+		(*ScalarField_FloatData)(nil),
+		(*ScalarField_DoubleData)(nil),
+		(*ScalarField_StringData)(nil),
+		(*ScalarField_BytesData)(nil),
+		(*ScalarField_ArrayData)(nil),
+		(*ScalarField_JsonData)(nil),
+		(*ScalarField_GeometryData)(nil),
+	}
+	file_schema_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*VectorField_FloatVector)(nil),
+		(*VectorField_BinaryVector)(nil),
+		(*VectorField_Float16Vector)(nil),
+		(*VectorField_Bfloat16Vector)(nil),
+		(*VectorField_SparseFloatVector)(nil),
+		(*VectorField_Int8Vector)(nil),
+		(*VectorField_VectorArray)(nil),
+	}
+	file_schema_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*FieldData_Scalars)(nil),
+		(*FieldData_Vectors)(nil),
+		(*FieldData_StructArrays)(nil),
+	}
+	file_schema_proto_msgTypes[22].OneofWrappers = []interface{}{
+		(*IDs_IntId)(nil),
+		(*IDs_StrId)(nil),
+	}
+	file_schema_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_schema_proto_msgTypes[28].OneofWrappers = []interface{}{
+		(*TemplateValue_BoolVal)(nil),
+		(*TemplateValue_Int64Val)(nil),
+		(*TemplateValue_FloatVal)(nil),
+		(*TemplateValue_StringVal)(nil),
+		(*TemplateValue_ArrayVal)(nil),
+	}
+	file_schema_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*TemplateArrayValue_BoolData)(nil),
+		(*TemplateArrayValue_LongData)(nil),
+		(*TemplateArrayValue_DoubleData)(nil),
+		(*TemplateArrayValue_StringData)(nil),
+		(*TemplateArrayValue_ArrayData)(nil),
+		(*TemplateArrayValue_JsonData)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_schema_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   31,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_schema_proto_goTypes,
+		DependencyIndexes: file_schema_proto_depIdxs,
+		EnumInfos:         file_schema_proto_enumTypes,
+		MessageInfos:      file_schema_proto_msgTypes,
+	}.Build()
+	File_schema_proto = out.File
+	file_schema_proto_rawDesc = nil
+	file_schema_proto_goTypes = nil
+	file_schema_proto_depIdxs = nil
+}